@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"lunch_app/backend/internal/audit"
+	"lunch_app/backend/internal/digest"
+	"lunch_app/backend/internal/handlers"
+	"lunch_app/backend/internal/mail"
+	"lunch_app/backend/internal/poll"
+	"lunch_app/backend/internal/retention"
+	"lunch_app/backend/internal/scheduler"
+	"lunch_app/backend/internal/webhook"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// registerJobs API 서버의 예약 작업 등록 (일정은 JOB_<NAME>_SCHEDULE로 바꾸거나 off로 끌 수 있음)
+func registerJobs(s *scheduler.Scheduler, db *gorm.DB, webhookWorker *webhook.Worker, digestSender mail.Sender, digestConfig digest.Config) error {
+	runner := retention.NewRunner(db, retention.ConfigFromEnv())
+	jobs := []scheduler.Job{
+		{
+			Name:        "retention",
+			Description: "보관 기간이 지난 soft delete 맛집·방문 기록 영구 삭제",
+			Spec:        scheduler.SpecFromEnv("retention", "0 4 * * *"),
+			Timeout:     time.Hour,
+			Run: func(ctx context.Context) (string, error) {
+				results, err := runner.Run(ctx)
+				var deleted int64
+				for _, result := range results {
+					deleted += result.Deleted
+				}
+				return fmt.Sprintf("deleted %d", deleted), err
+			},
+		},
+		{
+			Name:        "poll-close",
+			Description: "마감 시각이 지난 점심 투표를 닫고 투표자 방문 기록 생성",
+			Spec:        scheduler.SpecFromEnv("poll-close", "* * * * *"),
+			Timeout:     time.Minute,
+			Run: func(ctx context.Context) (string, error) {
+				ctx = audit.WithActor(ctx, audit.Actor{Name: poll.Actor})
+				closed, err := poll.CloseExpired(db.WithContext(ctx), time.Now())
+				// 마감 결과는 실시간 이벤트와 웹훅으로 전달
+				for _, result := range closed {
+					handlers.PublishPollClosed(result)
+				}
+				return fmt.Sprintf("closed %d", len(closed)), err
+			},
+		},
+	}
+	// 웹훅 발송이 꺼져 있으면 재시도 작업도 등록하지 않음 (새 배달은 워커가 바로 보냄)
+	if webhookWorker != nil {
+		jobs = append(jobs, scheduler.Job{
+			Name:        "webhook-retry",
+			Description: "재시도 시각이 된 웹훅 배달 발송",
+			Spec:        scheduler.SpecFromEnv("webhook-retry", "* * * * *"),
+			Timeout:     5 * time.Minute,
+			Run: func(ctx context.Context) (string, error) {
+				sent, err := webhookWorker.RetryDue(ctx)
+				return fmt.Sprintf("sent %d", sent), err
+			},
+		})
+	}
+	// SMTP가 설정되지 않으면 다이제스트 작업은 등록하지 않음
+	if digestSender != nil {
+		// cron 일정과 발송 시각 확인을 같은 시간대로 (JOB_TIMEZONE=UTC면 둘 다 UTC 08:30)
+		digestConfig.Location = s.Location()
+		jobs = append(jobs, scheduler.Job{
+			Name:        "digest",
+			Description: "평일 아침 점심 다이제스트 메일 발송",
+			Spec:        scheduler.SpecFromEnv("digest", digestConfig.Spec()),
+			Timeout:     30 * time.Minute,
+			Run: func(ctx context.Context) (string, error) {
+				sent, err := digest.SendDue(ctx, db.WithContext(ctx), digestSender, digestConfig, time.Now())
+				return fmt.Sprintf("sent %d", sent), err
+			},
+		})
+	}
+
+	for _, job := range jobs {
+		if err := s.Register(job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
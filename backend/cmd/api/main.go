@@ -1,10 +1,26 @@
 package main
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"log/slog"
 	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/digest"
+	"lunch_app/backend/internal/draw"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/handlers"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/logger"
+	"lunch_app/backend/internal/lunchroom"
+	"lunch_app/backend/internal/mail"
+	"lunch_app/backend/internal/metrics"
+	"lunch_app/backend/internal/middleware"
 	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/places"
 	"lunch_app/backend/internal/routes"
+	"lunch_app/backend/internal/routing"
+	"lunch_app/backend/internal/scheduler"
+	"lunch_app/backend/internal/webhook"
 	"os"
 	"time"
 
@@ -18,6 +34,9 @@ func main() {
 	// .env 파일 로드 (선택적 - 없어도 오류 발생하지 않음)
 	godotenv.Load()
 
+	// JSON 구조화 로거 설정 (LOG_LEVEL 환경변수로 레벨 조정)
+	logger.Init()
+
 	// 데이터베이스 초기화 부분
 	database.Connect()
 	// 마이그레이션 추가
@@ -26,14 +45,76 @@ func main() {
 	// Insert test data
 	insertTestData(database.DB)
 
-	r := gin.Default()
+	// 비즈니스 지표 (맛집 수, 오늘 방문 수) 등록
+	metrics.RegisterBusinessGauges(database.DB)
+
+	// 변경 이벤트를 팀별 웹훅으로 전달 (핸들러는 발행만 하고 발송은 백그라운드 워커가, 재시도는 예약 작업 webhook-retry가 처리)
+	bus := events.NewBus(events.DefaultHistorySize)
+	handlers.SetEventBus(bus)
+	var webhookWorker *webhook.Worker
+	if webhook.EnabledFromEnv() {
+		webhookWorker = webhook.NewWorker(database.DB, bus)
+		webhookWorker.Start(context.Background())
+		handlers.SetWebhookWorker(webhookWorker)
+	} else {
+		slog.Info("웹훅 발송 비활성화")
+	}
+
+	// 길찾기는 OSRM 결과를 캐시하고 OSRM 장애 시 직선 거리로 추정
+	handlers.SetRouter(routing.NewFromEnv())
+
+	// 점심 룰렛 팀별 하루 다시 뽑기 횟수
+	handlers.SetDrawRerollsPerDay(draw.RerollsFromEnv())
+
+	// WebSocket 점심 방 (방 상태는 이 프로세스 메모리에만 있음)
+	handlers.SetRoomManager(lunchroom.NewManager(database.DB))
+
+	// 카카오톡 챗봇 "근처 맛집"의 위치 검색 (KAKAO_REST_API_KEY 또는 PLACES_FIXTURE가 없으면 위치 안내만 응답)
+	if searcher, err := places.NewFromEnv(); err == nil {
+		handlers.SetPlaceSearcher(searcher)
+	} else if !errors.Is(err, places.ErrNotConfigured) {
+		slog.Error("장소 검색기 설정 실패", "error", err)
+	}
+
+	// 평일 아침 점심 다이제스트 메일 (SMTP_HOST나 DIGEST_API_URL이 없으면 자동 발송과 수동 발송 모두 비활성)
+	var digestConfig digest.Config
+	var digestSender mail.Sender
+	if smtpConfig, err := mail.ConfigFromEnv(); err != nil {
+		if !errors.Is(err, mail.ErrNotConfigured) {
+			slog.Error("SMTP 설정 실패", "error", err)
+		}
+	} else if digestConfig, err = digest.ConfigFromEnv(); err != nil {
+		slog.Error("다이제스트 설정 실패, 다이제스트 메일 비활성", "error", err)
+	} else {
+		digestSender = mail.NewSMTPSender(smtpConfig)
+	}
+	handlers.SetDigest(digestSender, digestConfig)
+
+	// 예약 작업 (보관 정책, 투표 자동 마감, 웹훅 재시도, 다이제스트) - cron 일정은 JOB_TIMEZONE(기본 Asia/Seoul) 기준,
+	// job_leases 잠금으로 여러 인스턴스에서도 일정마다 한 번만 실행
+	jobScheduler := scheduler.New(database.DB, scheduler.ConfigFromEnv(kst.Location()))
+	if err := registerJobs(jobScheduler, database.DB, webhookWorker, digestSender, digestConfig); err != nil {
+		slog.Error("예약 작업 등록 실패", "error", err)
+		os.Exit(1)
+	}
+	jobScheduler.Start(context.Background())
+	handlers.SetScheduler(jobScheduler)
+
+	r := gin.New()
+
+	// 요청 ID 부여 → 구조화 요청 로그 → 패닉 복구 → 감사 로그 행위자 설정 → 메트릭 수집 순서로 적용
+	r.Use(middleware.RequestID())
+	r.Use(middleware.Logger())
+	r.Use(middleware.Recovery())
+	r.Use(middleware.Actor())
+	r.Use(metrics.Middleware())
 
 	// CORS 설정 - 프론트엔드 도메인 허용
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"https://lunch-app-spd2.onrender.com", "http://localhost:3000"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "Last-Event-ID", middleware.RequestIDHeader, middleware.ActorHeader},
+		ExposeHeaders:    []string{"Content-Length", middleware.RequestIDHeader},
 		AllowCredentials: true,
 		MaxAge:          12 * time.Hour,
 	}))
@@ -45,8 +126,12 @@ func main() {
 	if port == "" {
 		port = "8080" // 로컬 개발환경 기본값
 	}
-	
-	r.Run(":" + port)
+
+	slog.Info("서버 시작", "port", port)
+	if err := r.Run(":" + port); err != nil {
+		slog.Error("서버 실행 실패", "error", err)
+		os.Exit(1)
+	}
 }
 
 func insertTestData(db *gorm.DB) {
@@ -54,11 +139,11 @@ func insertTestData(db *gorm.DB) {
 	var count int64
 	db.Model(&models.Restaurant{}).Count(&count)
 	if count > 0 {
-		fmt.Printf("📊 기존 맛집 데이터 %d개 발견, 테스트 데이터 삽입 건너뜀\n", count)
+		slog.Info("기존 맛집 데이터 발견, 테스트 데이터 삽입 건너뜀", "count", count)
 		return
 	}
 
-	fmt.Println("🍽️ 테스트 맛집 데이터 삽입 중...")
+	slog.Info("테스트 맛집 데이터 삽입 중")
 	restaurants := []models.Restaurant{
 		{Name: "고향집", Address: "서울시 강남구", Phone: "02-123-4567", Category: "한식", Latitude: 37.4979, Longitude: 127.0276},
 		{Name: "차이나오", Address: "서울시 서초구", Phone: "02-987-6543", Category: "중식", Latitude: 37.4836, Longitude: 127.0325},
@@ -67,10 +152,10 @@ func insertTestData(db *gorm.DB) {
 
 	for _, restaurant := range restaurants {
 		if err := db.Create(&restaurant).Error; err != nil {
-			fmt.Printf("❌ 테스트 데이터 삽입 실패: %v\n", err)
+			slog.Error("테스트 데이터 삽입 실패", "name", restaurant.Name, "error", err)
 		} else {
-			fmt.Printf("✅ %s 맛집 데이터 삽입 완료\n", restaurant.Name)
+			slog.Info("테스트 데이터 삽입 완료", "name", restaurant.Name)
 		}
 	}
-	fmt.Println("🎉 테스트 데이터 삽입 완료!")
+	slog.Info("테스트 데이터 삽입 완료", "count", len(restaurants))
 }
@@ -0,0 +1,68 @@
+package apierror
+
+import (
+	"lunch_app/backend/internal/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Code 클라이언트가 분기 처리에 사용하는 고정 에러 코드
+type Code string
+
+const (
+	CodeInvalidRequestBody  Code = "INVALID_REQUEST_BODY"
+	CodeValidationFailed    Code = "VALIDATION_FAILED"
+	CodeRestaurantNotFound  Code = "RESTAURANT_NOT_FOUND"
+	CodeVisitNotFound       Code = "VISIT_NOT_FOUND"
+	CodeDuplicateRestaurant Code = "DUPLICATE_RESTAURANT"
+	CodeRouteNotFound       Code = "ROUTE_NOT_FOUND"
+	CodeMethodNotAllowed    Code = "METHOD_NOT_ALLOWED"
+	CodeUnauthorized        Code = "UNAUTHORIZED"
+	CodeAdminDisabled       Code = "ADMIN_DISABLED"
+	CodeRestoreConflict     Code = "RESTORE_CONFLICT"
+	CodePollNotFound        Code = "POLL_NOT_FOUND"
+	CodePollClosed          Code = "POLL_CLOSED"
+	CodeInvalidMessage      Code = "INVALID_MESSAGE"
+	CodeSpinInProgress      Code = "SPIN_IN_PROGRESS"
+	CodeNoRestaurants       Code = "NO_RESTAURANTS"
+	CodeDrawNotFound        Code = "DRAW_NOT_FOUND"
+	CodeDrawLimitReached    Code = "DRAW_LIMIT_REACHED"
+	CodeSlackDisabled       Code = "SLACK_DISABLED"
+	CodeKakaoDisabled       Code = "KAKAO_DISABLED"
+	CodeWebhookNotFound     Code = "WEBHOOK_NOT_FOUND"
+	CodeDeliveryNotFound    Code = "WEBHOOK_DELIVERY_NOT_FOUND"
+	CodeDeliveryPending     Code = "WEBHOOK_DELIVERY_PENDING"
+	CodeDigestNotFound      Code = "DIGEST_SUBSCRIPTION_NOT_FOUND"
+	CodeMailDisabled        Code = "MAIL_DISABLED"
+	CodeMailSendFailed      Code = "MAIL_SEND_FAILED"
+	CodeJobNotFound         Code = "JOB_NOT_FOUND"
+	CodeJobRunning          Code = "JOB_RUNNING"
+	CodeHolidayNotFound     Code = "HOLIDAY_NOT_FOUND"
+	CodeInternal            Code = "INTERNAL_ERROR"
+)
+
+// FieldError 필드 단위 검증 실패 정보
+type FieldError struct {
+	Field   string `json:"field"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// Response 모든 API 에러 응답의 공통 형식
+// error 필드는 기존 클라이언트 호환을 위해 사람이 읽을 수 있는 메시지를 유지
+type Response struct {
+	Error     string       `json:"error"`
+	Code      Code         `json:"code"`
+	Details   []FieldError `json:"details,omitempty"`
+	RequestID string       `json:"requestId,omitempty"`
+}
+
+// Abort 에러 응답을 작성하고 이후 핸들러 실행을 중단
+func Abort(c *gin.Context, status int, code Code, message string, details ...FieldError) {
+	c.AbortWithStatusJSON(status, Response{
+		Error:     message,
+		Code:      code,
+		Details:   details,
+		RequestID: logger.RequestID(c.Request.Context()),
+	})
+}
@@ -0,0 +1,31 @@
+package audit
+
+import "context"
+
+// SystemActor 요청 컨텍스트 밖(초기 데이터, 백그라운드 작업)에서 일어난 변경의 행위자
+const SystemActor = "system"
+
+// Actor 변경을 일으킨 주체
+type Actor struct {
+	Name string
+	IP   string
+}
+
+type contextKey struct{}
+
+var actorKey = contextKey{}
+
+// WithActor 컨텍스트에 행위자 저장
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// ActorFromContext 컨텍스트에 저장된 행위자 조회 (없으면 SystemActor)
+func ActorFromContext(ctx context.Context) Actor {
+	if ctx != nil {
+		if actor, ok := ctx.Value(actorKey).(Actor); ok && actor.Name != "" {
+			return actor
+		}
+	}
+	return Actor{Name: SystemActor}
+}
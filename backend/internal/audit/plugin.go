@@ -0,0 +1,301 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"lunch_app/backend/internal/logger"
+	"lunch_app/backend/internal/models"
+	"reflect"
+	"strconv"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const beforeKey = "audit:before"
+
+// 감사 로그 action 값
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// auditedTables 변경 이력을 남기는 테이블
+var auditedTables = map[string]bool{
+	"restaurants": true,
+	"visits":      true,
+	"reviews":     true,
+	"bookmarks":   true,
+}
+
+// Plugin 생성/수정/삭제 전후의 행을 audit_logs 테이블에 기록하는 GORM 플러그인
+// database.Connect에서 db.Use(audit.Plugin{})로 등록
+// 감사 로그는 원래 쿼리와 같은 트랜잭션에서 저장되며, 저장에 실패하면 변경도 롤백됨
+type Plugin struct{}
+
+// Name gorm.Plugin 인터페이스 구현
+func (Plugin) Name() string {
+	return "audit"
+}
+
+// Initialize 생성 후, 수정/삭제 전후에 감사 콜백 등록
+func (Plugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	registrations := []struct {
+		name string
+		fn   func(*gorm.DB)
+		reg  func(name string, fn func(*gorm.DB)) error
+	}{
+		{"audit:after_create", recordCreate, cb.Create().After("gorm:after_create").Register},
+		{"audit:before_update", captureBefore, cb.Update().Before("gorm:update").Register},
+		{"audit:after_update", recordChange(ActionUpdate), cb.Update().After("gorm:after_update").Register},
+		{"audit:before_delete", captureBefore, cb.Delete().Before("gorm:delete").Register},
+		{"audit:after_delete", recordChange(ActionDelete), cb.Delete().After("gorm:after_delete").Register},
+	}
+
+	for _, r := range registrations {
+		if err := r.reg(r.name, r.fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type row = map[string]any
+
+func audited(tx *gorm.DB) bool {
+	return tx.Error == nil && auditedTables[tx.Statement.Table]
+}
+
+// newQuery 원래 쿼리와 같은 연결(트랜잭션)·컨텍스트를 쓰는 새 쿼리
+// Delete(&Model{}, ids)처럼 기본 키로 만든 조건은 모델 스키마가 있어야 해석되므로 같은 모델을 지정
+func newQuery(tx *gorm.DB) *gorm.DB {
+	query := tx.Session(&gorm.Session{NewDB: true, SkipHooks: true})
+	if schema := tx.Statement.Schema; schema != nil {
+		return query.Model(reflect.New(schema.ModelType).Interface())
+	}
+	return query.Table(tx.Statement.Table)
+}
+
+// captureBefore 수정/삭제 대상 행을 변경 전에 조회해 저장
+// 조건은 체이닝된 WHERE 절과 모델의 기본 키로 GORM과 같은 방식으로 구성
+func captureBefore(tx *gorm.DB) {
+	if !audited(tx) {
+		return
+	}
+	stmt := tx.Statement
+
+	query := newQuery(tx).Unscoped()
+	hasCondition := false
+	if c, ok := stmt.Clauses["WHERE"]; ok {
+		if where, ok := c.Expression.(clause.Where); ok && len(where.Exprs) > 0 {
+			query = query.Clauses(clause.Where{Exprs: where.Exprs})
+			hasCondition = true
+		}
+	}
+	if ids := primaryKeys(stmt); len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+		hasCondition = true
+	}
+	if !hasCondition {
+		// 조건 없는 수정/삭제는 GORM이 ErrMissingWhereClause로 거부함
+		return
+	}
+	if !stmt.Unscoped && stmt.Schema != nil && stmt.Schema.LookUpField("DeletedAt") != nil {
+		query = query.Where("deleted_at IS NULL")
+	}
+
+	var before []row
+	if err := query.Find(&before).Error; err != nil {
+		tx.AddError(fmt.Errorf("audit: load rows before %s: %w", stmt.Table, err))
+		return
+	}
+	tx.InstanceSet(beforeKey, before)
+}
+
+// recordCreate 생성된 행을 기본 키로 다시 조회해 기록
+func recordCreate(tx *gorm.DB) {
+	if !audited(tx) {
+		return
+	}
+	ids := primaryKeys(tx.Statement)
+	if len(ids) == 0 {
+		return
+	}
+
+	after, err := loadRows(tx, ids)
+	if err != nil {
+		tx.AddError(fmt.Errorf("audit: load created %s: %w", tx.Statement.Table, err))
+		return
+	}
+	entries := make([]models.AuditLog, 0, len(after))
+	for _, r := range after {
+		entries = append(entries, newEntry(tx, ActionCreate, nil, r))
+	}
+	save(tx, entries)
+}
+
+// recordChange 변경 전 행과 변경 후 행(삭제됐으면 없음)을 짝지어 기록
+func recordChange(action string) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		if !audited(tx) {
+			return
+		}
+		value, ok := tx.InstanceGet(beforeKey)
+		if !ok {
+			return
+		}
+		before, _ := value.([]row)
+		if len(before) == 0 {
+			return
+		}
+
+		ids := make([]uint, 0, len(before))
+		for _, r := range before {
+			ids = append(ids, rowID(r))
+		}
+		after, err := loadRows(tx, ids)
+		if err != nil {
+			tx.AddError(fmt.Errorf("audit: load changed %s: %w", tx.Statement.Table, err))
+			return
+		}
+		afterByID := make(map[uint]row, len(after))
+		for _, r := range after {
+			afterByID[rowID(r)] = r
+		}
+
+		entries := make([]models.AuditLog, 0, len(before))
+		for _, b := range before {
+			entries = append(entries, newEntry(tx, action, b, afterByID[rowID(b)]))
+		}
+		save(tx, entries)
+	}
+}
+
+func loadRows(tx *gorm.DB, ids []uint) ([]row, error) {
+	var rows []row
+	err := newQuery(tx).Unscoped().Where("id IN ?", ids).Find(&rows).Error
+	return rows, err
+}
+
+func save(tx *gorm.DB, entries []models.AuditLog) {
+	if len(entries) == 0 {
+		return
+	}
+	if err := tx.Session(&gorm.Session{NewDB: true, SkipHooks: true}).CreateInBatches(&entries, 100).Error; err != nil {
+		tx.AddError(fmt.Errorf("audit: save audit logs: %w", err))
+	}
+}
+
+func newEntry(tx *gorm.DB, action string, before, after row) models.AuditLog {
+	ctx := tx.Statement.Context
+	actor := ActorFromContext(ctx)
+
+	id := rowID(before)
+	if id == 0 {
+		id = rowID(after)
+	}
+	return models.AuditLog{
+		Action:     action,
+		EntityType: tx.Statement.Table,
+		EntityID:   id,
+		Actor:      actor.Name,
+		RemoteIP:   actor.IP,
+		RequestID:  logger.RequestID(ctx),
+		Before:     marshal(before),
+		After:      marshal(after),
+		Changes:    marshal(diff(before, after)),
+	}
+}
+
+// diff 값이 달라진 컬럼만 {"컬럼": {"before": 이전 값, "after": 이후 값}} 형태로 반환
+// 생성은 before가, 완전 삭제는 after가 없으므로 변경 내역을 따로 남기지 않음
+func diff(before, after row) map[string]map[string]any {
+	if before == nil || after == nil {
+		return nil
+	}
+	changes := map[string]map[string]any{}
+	for column, old := range before {
+		updated := after[column]
+		if marshal(old) != marshal(updated) {
+			changes[column] = map[string]any{"before": old, "after": updated}
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return changes
+}
+
+func marshal(v any) string {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Map && reflect.ValueOf(v).IsNil()) {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// primaryKeys 모델 값(구조체 또는 슬라이스)에서 0이 아닌 ID 수집
+func primaryKeys(stmt *gorm.Statement) []uint {
+	if stmt.Schema == nil {
+		return nil
+	}
+	field := stmt.Schema.LookUpField("ID")
+	if field == nil || !stmt.ReflectValue.IsValid() {
+		return nil
+	}
+
+	var ids []uint
+	collect := func(v reflect.Value) {
+		if value, zero := field.ValueOf(stmt.Context, v); !zero {
+			if id := toUint(value); id != 0 {
+				ids = append(ids, id)
+			}
+		}
+	}
+	switch stmt.ReflectValue.Kind() {
+	case reflect.Struct:
+		collect(stmt.ReflectValue)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < stmt.ReflectValue.Len(); i++ {
+			collect(reflect.Indirect(stmt.ReflectValue.Index(i)))
+		}
+	}
+	return ids
+}
+
+func rowID(r row) uint {
+	if r == nil {
+		return 0
+	}
+	return toUint(r["id"])
+}
+
+// toUint 드라이버마다 다른 정수 타입(int64, uint 등)의 ID를 uint로 변환
+func toUint(v any) uint {
+	switch id := v.(type) {
+	case uint:
+		return id
+	case int64:
+		return uint(id)
+	case int:
+		return uint(id)
+	case int32:
+		return uint(id)
+	case uint64:
+		return uint(id)
+	case uint32:
+		return uint(id)
+	case []byte:
+		n, _ := strconv.ParseUint(string(id), 10, 64)
+		return uint(n)
+	case string:
+		n, _ := strconv.ParseUint(id, 10, 64)
+		return uint(n)
+	}
+	return 0
+}
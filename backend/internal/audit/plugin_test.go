@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"lunch_app/backend/internal/logger"
+	"lunch_app/backend/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(Plugin{}))
+	require.NoError(t, db.AutoMigrate(&models.Restaurant{}, &models.Visit{}, &models.AuditLog{}))
+	return db
+}
+
+func requestContext() context.Context {
+	ctx := WithActor(context.Background(), Actor{Name: "kim", IP: "10.0.0.1"})
+	return logger.WithRequestID(ctx, "req-1")
+}
+
+func auditLogs(t *testing.T, db *gorm.DB) []models.AuditLog {
+	var logs []models.AuditLog
+	require.NoError(t, db.Order("id").Find(&logs).Error)
+	return logs
+}
+
+func TestPlugin_RecordsCreateUpdateDelete(t *testing.T) {
+	db := setupDB(t)
+	tx := db.WithContext(requestContext())
+
+	restaurant := models.Restaurant{Name: "감사 맛집", Address: "서울시 중구"}
+	require.NoError(t, tx.Create(&restaurant).Error)
+	require.NoError(t, tx.Model(&restaurant).Update("name", "이름 바뀐 맛집").Error)
+	require.NoError(t, tx.Delete(&restaurant).Error)
+
+	logs := auditLogs(t, db)
+	require.Len(t, logs, 3)
+	for _, log := range logs {
+		assert.Equal(t, "restaurants", log.EntityType)
+		assert.Equal(t, restaurant.ID, log.EntityID)
+		assert.Equal(t, "kim", log.Actor)
+		assert.Equal(t, "10.0.0.1", log.RemoteIP)
+		assert.Equal(t, "req-1", log.RequestID)
+	}
+
+	assert.Equal(t, ActionCreate, logs[0].Action)
+	assert.Empty(t, logs[0].Before)
+	assert.Contains(t, logs[0].After, "감사 맛집")
+
+	assert.Equal(t, ActionUpdate, logs[1].Action)
+	var changes map[string]map[string]any
+	require.NoError(t, json.Unmarshal([]byte(logs[1].Changes), &changes))
+	assert.Equal(t, map[string]any{"before": "감사 맛집", "after": "이름 바뀐 맛집"}, changes["name"])
+
+	// soft delete는 deleted_at 변경으로 기록
+	assert.Equal(t, ActionDelete, logs[2].Action)
+	require.NoError(t, json.Unmarshal([]byte(logs[2].Changes), &changes))
+	assert.Contains(t, changes, "deleted_at")
+}
+
+func TestPlugin_RecordsEveryRowOfBulkChange(t *testing.T) {
+	db := setupDB(t)
+	visits := []models.Visit{{RestaurantID: 1}, {RestaurantID: 1}, {RestaurantID: 2}}
+	require.NoError(t, db.Create(&visits).Error)
+
+	require.NoError(t, db.Unscoped().Where("restaurant_id = ?", 1).Delete(&models.Visit{}).Error)
+
+	var deletes []models.AuditLog
+	require.NoError(t, db.Where("action = ?", ActionDelete).Order("entity_id").Find(&deletes).Error)
+	require.Len(t, deletes, 2)
+	assert.Equal(t, visits[0].ID, deletes[0].EntityID)
+	assert.Equal(t, visits[1].ID, deletes[1].EntityID)
+	assert.NotEmpty(t, deletes[0].Before)
+	assert.Empty(t, deletes[0].After, "완전 삭제 후에는 남은 행이 없음")
+	assert.Equal(t, SystemActor, deletes[0].Actor)
+}
+
+func TestPlugin_RollsBackWithTransaction(t *testing.T) {
+	db := setupDB(t)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&models.Restaurant{Name: "롤백 맛집"}).Error; err != nil {
+			return err
+		}
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, auditLogs(t, db))
+}
+
+func TestList_Filters(t *testing.T) {
+	db := setupDB(t)
+	tx := db.WithContext(requestContext())
+	restaurant := models.Restaurant{Name: "필터 맛집"}
+	require.NoError(t, tx.Create(&restaurant).Error)
+	require.NoError(t, db.Create(&models.Visit{RestaurantID: restaurant.ID}).Error)
+
+	logs, total, err := List(db, Filter{Actor: "kim", Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, logs, 1)
+	assert.Equal(t, "restaurants", logs[0].EntityType)
+
+	logs, total, err = List(db, Filter{EntityType: "visits", Action: ActionCreate, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, SystemActor, logs[0].Actor)
+}
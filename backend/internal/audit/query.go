@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"lunch_app/backend/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Filter 감사 로그 조회 조건 (비어 있는 값은 조건에서 제외)
+type Filter struct {
+	EntityType string
+	EntityID   uint
+	Action     string
+	Actor      string
+	RequestID  string
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+// List 조건에 맞는 감사 로그를 최신순으로 조회, 페이지 적용 전 전체 건수와 함께 반환
+func List(db *gorm.DB, filter Filter) ([]models.AuditLog, int64, error) {
+	query := db.Model(&models.AuditLog{})
+	if filter.EntityType != "" {
+		query = query.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.EntityID != 0 {
+		query = query.Where("entity_id = ?", filter.EntityID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+	if filter.RequestID != "" {
+		query = query.Where("request_id = ?", filter.RequestID)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("created_at < ?", filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Order("id desc").Offset(filter.Offset)
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	var logs []models.AuditLog
+	err := query.Find(&logs).Error
+	return logs, total, err
+}
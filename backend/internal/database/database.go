@@ -1,7 +1,10 @@
 package database
 
 import (
-	"fmt"
+	"log/slog"
+	"lunch_app/backend/internal/audit"
+	"lunch_app/backend/internal/logger"
+	"lunch_app/backend/internal/metrics"
 	"lunch_app/backend/internal/models"
 	"os"
 
@@ -16,27 +19,41 @@ func Connect() {
 	var db *gorm.DB
 	var err error
 
+	// 쿼리 로그는 DB_LOG_LEVEL 환경변수로 레벨 조정
+	// 유니크 제약 위반 등 드라이버 에러는 gorm.ErrDuplicatedKey 같은 공통 에러로 변환
+	gormConfig := &gorm.Config{Logger: logger.NewGormLogger(), TranslateError: true}
+
 	// Render.com에서는 DATABASE_URL 환경변수로 PostgreSQL URL을 제공
 	if databaseURL := os.Getenv("DATABASE_URL"); databaseURL != "" {
 		// Production: PostgreSQL 사용
-		fmt.Println("🗄️ PostgreSQL 데이터베이스에 연결 중...")
-		db, err = gorm.Open(postgres.Open(databaseURL), &gorm.Config{})
+		slog.Info("데이터베이스 연결 중", "driver", "postgres")
+		db, err = gorm.Open(postgres.Open(databaseURL), gormConfig)
 		if err != nil {
 			panic("Failed to connect to PostgreSQL database: " + err.Error())
 		}
-		fmt.Println("✅ PostgreSQL 연결 성공!")
+		slog.Info("데이터베이스 연결 성공", "driver", "postgres")
 	} else {
 		// Development: SQLite 사용  
-		fmt.Println("🗄️ SQLite 데이터베이스에 연결 중...")
-		db, err = gorm.Open(sqlite.Open("lunch_app.db"), &gorm.Config{})
+		slog.Info("데이터베이스 연결 중", "driver", "sqlite")
+		db, err = gorm.Open(sqlite.Open("lunch_app.db"), gormConfig)
 		if err != nil {
 			panic("Failed to connect to SQLite database: " + err.Error())
 		}
-		fmt.Println("✅ SQLite 연결 성공!")
+		slog.Info("데이터베이스 연결 성공", "driver", "sqlite")
+	}
+
+	// 쿼리 실행 시간 메트릭 수집
+	if err := db.Use(metrics.GormPlugin{}); err != nil {
+		panic("Failed to register metrics plugin: " + err.Error())
+	}
+
+	// 맛집·방문 기록·리뷰·북마크 변경 이력을 audit_logs에 기록
+	if err := db.Use(audit.Plugin{}); err != nil {
+		panic("Failed to register audit plugin: " + err.Error())
 	}
 
 	// 데이터베이스 마이그레이션
-	fmt.Println("🔄 데이터베이스 마이그레이션 실행 중...")
+	slog.Info("데이터베이스 마이그레이션 실행 중")
 	err = db.AutoMigrate(
 		&models.User{},
 		&models.Restaurant{},
@@ -44,11 +61,52 @@ func Connect() {
 		&models.ReviewImage{},
 		&models.Bookmark{},
 		&models.Visit{},
+		&models.AuditLog{},
+		&models.Poll{},
+		&models.PollCandidate{},
+		&models.PollVote{},
+		&models.Draw{},
+		&models.DrawCandidate{},
+		&models.Webhook{},
+		&models.WebhookDelivery{},
+		&models.DigestSubscription{},
+		&models.JobLease{},
+		&models.JobRun{},
+		&models.OpeningHours{},
+		&models.RestaurantHoliday{},
 	)
 	if err != nil {
 		panic("Failed to migrate database: " + err.Error())
 	}
-	fmt.Println("✅ 데이터베이스 마이그레이션 완료!")
+	slog.Info("데이터베이스 마이그레이션 완료")
+
+	if err := backfillVisitSnapshots(db); err != nil {
+		panic("Failed to backfill visit snapshots: " + err.Error())
+	}
+
+	if err := CreateIndexes(db); err != nil {
+		panic("Failed to create indexes: " + err.Error())
+	}
 
 	DB = db
 }
+
+// backfillVisitSnapshots 스냅샷 컬럼 추가 이전의 방문 기록을 현재 맛집 정보(삭제된 맛집 포함)로 채움
+func backfillVisitSnapshots(db *gorm.DB) error {
+	result := db.Exec(`
+		UPDATE visits SET
+			restaurant_name = (SELECT r.name FROM restaurants r WHERE r.id = visits.restaurant_id),
+			restaurant_address = (SELECT r.address FROM restaurants r WHERE r.id = visits.restaurant_id),
+			restaurant_category = (SELECT r.category FROM restaurants r WHERE r.id = visits.restaurant_id),
+			restaurant_latitude = (SELECT r.latitude FROM restaurants r WHERE r.id = visits.restaurant_id),
+			restaurant_longitude = (SELECT r.longitude FROM restaurants r WHERE r.id = visits.restaurant_id)
+		WHERE (restaurant_name IS NULL OR restaurant_name = '')
+			AND EXISTS (SELECT 1 FROM restaurants r WHERE r.id = visits.restaurant_id)`)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		slog.Info("방문 기록 맛집 스냅샷 보완 완료", "rows", result.RowsAffected)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// RestaurantNameAddressIndex 삭제되지 않은 맛집의 이름·주소 유일성 인덱스
+// soft delete된 맛집은 제외하므로 같은 맛집을 삭제 후 다시 등록할 수 있음
+const RestaurantNameAddressIndex = "idx_restaurants_name_address_active"
+
+// RestaurantExternalIDIndex 출처별 외부 장소 ID 유일성 인덱스 (외부 ID가 없는 맛집과 삭제된 맛집 제외)
+const RestaurantExternalIDIndex = "idx_restaurants_external_active"
+
+// partialUniqueIndex GORM 태그로 만들지 않는 부분(partial) 유니크 인덱스 정의
+type partialUniqueIndex struct {
+	name    string
+	table   string
+	columns string
+	where   string
+}
+
+var partialUniqueIndexes = []partialUniqueIndex{
+	{RestaurantNameAddressIndex, "restaurants", "name, address", "deleted_at IS NULL"},
+	{RestaurantExternalIDIndex, "restaurants", "external_source, external_id", "external_id <> '' AND deleted_at IS NULL"},
+}
+
+// maxReportedGroups DuplicateRowsError 메시지에 나열하는 중복 묶음 수
+const maxReportedGroups = 10
+
+// DuplicateGroup 유니크 인덱스 컬럼 값이 같은 행들
+type DuplicateGroup struct {
+	// Values 인덱스 컬럼 값 ("name=…, address=…")
+	Values string
+	IDs    []uint
+}
+
+// DuplicateRowsError 이미 중복된 행이 있어 유니크 인덱스를 만들 수 없음
+type DuplicateRowsError struct {
+	Index  string
+	Groups []DuplicateGroup
+}
+
+func (e *DuplicateRowsError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "cannot create unique index %s: %d duplicate groups", e.Index, len(e.Groups))
+	for i, group := range e.Groups {
+		if i == maxReportedGroups {
+			fmt.Fprintf(&b, "; … %d more", len(e.Groups)-maxReportedGroups)
+			break
+		}
+		fmt.Fprintf(&b, "; %s ids %v", group.Values, group.IDs)
+	}
+	b.WriteString(" (merge them with POST /api/restaurants/{id}/merge or delete them, then restart)")
+	return b.String()
+}
+
+// CreateIndexes 부분 유니크 인덱스 생성
+// SQLite와 PostgreSQL 모두 CREATE UNIQUE INDEX ... WHERE 문법을 지원
+// 이미 중복된 행이 있으면 인덱스 없이 계속하지 않고 중복 묶음을 담은 *DuplicateRowsError 반환 (정리 후 재시작)
+func CreateIndexes(db *gorm.DB) error {
+	for _, index := range partialUniqueIndexes {
+		groups, err := findDuplicates(db, index)
+		if err != nil {
+			return err
+		}
+		if len(groups) > 0 {
+			return &DuplicateRowsError{Index: index.name, Groups: groups}
+		}
+
+		err = db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS ` + index.name + `
+			ON ` + index.table + ` (` + index.columns + `) WHERE ` + index.where).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findDuplicates index 컬럼 값이 같은 행 묶음 (컬럼 값, ID 순)
+func findDuplicates(db *gorm.DB, index partialUniqueIndex) ([]DuplicateGroup, error) {
+	var rows []map[string]any
+	err := db.Raw(`
+		SELECT id, ` + index.columns + ` FROM ` + index.table + `
+		WHERE ` + index.where + ` AND (` + index.columns + `) IN (
+			SELECT ` + index.columns + ` FROM ` + index.table + `
+			WHERE ` + index.where + `
+			GROUP BY ` + index.columns + `
+			HAVING COUNT(*) > 1
+		)
+		ORDER BY ` + index.columns + `, id`).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	columns := strings.Split(index.columns, ", ")
+	var groups []DuplicateGroup
+	for _, row := range rows {
+		values := make([]string, 0, len(columns))
+		for _, column := range columns {
+			value := row[column]
+			if b, ok := value.([]byte); ok {
+				value = string(b)
+			}
+			values = append(values, fmt.Sprintf("%s=%v", column, value))
+		}
+		key := strings.Join(values, ", ")
+		if len(groups) == 0 || groups[len(groups)-1].Values != key {
+			groups = append(groups, DuplicateGroup{Values: key})
+		}
+		id, _ := strconv.ParseUint(fmt.Sprint(row["id"]), 10, 64)
+		groups[len(groups)-1].IDs = append(groups[len(groups)-1].IDs, uint(id))
+	}
+	return groups, nil
+}
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"lunch_app/backend/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func openTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{TranslateError: true})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Restaurant{}))
+	return db
+}
+
+func TestCreateIndexes_IgnoresSoftDeletedRows(t *testing.T) {
+	db := openTestDB(t)
+	require.NoError(t, CreateIndexes(db))
+	assert.True(t, db.Migrator().HasIndex(&models.Restaurant{}, RestaurantNameAddressIndex))
+
+	first := models.Restaurant{Name: "인덱스 맛집", Address: "서울시 중구"}
+	require.NoError(t, db.Create(&first).Error)
+	err := db.Create(&models.Restaurant{Name: "인덱스 맛집", Address: "서울시 중구"}).Error
+	assert.ErrorIs(t, err, gorm.ErrDuplicatedKey)
+
+	// 삭제된 맛집과는 충돌하지 않음
+	require.NoError(t, db.Delete(&first).Error)
+	assert.NoError(t, db.Create(&models.Restaurant{Name: "인덱스 맛집", Address: "서울시 중구"}).Error)
+}
+
+func TestCreateIndexes_FailsWhenDuplicatesExist(t *testing.T) {
+	db := openTestDB(t)
+	rows := []models.Restaurant{
+		{Name: "중복 맛집", Address: "서울시 중구"},
+		{Name: "다른 맛집", Address: "서울시 중구"},
+		{Name: "중복 맛집", Address: "서울시 중구"},
+		{Name: "중복 맛집", Address: "서울시 중구"},
+	}
+	require.NoError(t, db.Create(&rows).Error)
+	// 삭제된 행은 중복으로 보지 않음
+	require.NoError(t, db.Delete(&rows[3]).Error)
+
+	err := CreateIndexes(db)
+	var duplicateErr *DuplicateRowsError
+	require.ErrorAs(t, err, &duplicateErr)
+	assert.Equal(t, RestaurantNameAddressIndex, duplicateErr.Index)
+	assert.Equal(t, []DuplicateGroup{{Values: "name=중복 맛집, address=서울시 중구", IDs: []uint{rows[0].ID, rows[2].ID}}}, duplicateErr.Groups)
+	assert.Contains(t, err.Error(), "name=중복 맛집, address=서울시 중구 ids [1 3]")
+	assert.False(t, db.Migrator().HasIndex(&models.Restaurant{}, RestaurantNameAddressIndex))
+
+	// 정리하면 만들어짐
+	require.NoError(t, db.Delete(&rows[2]).Error)
+	require.NoError(t, CreateIndexes(db))
+	assert.True(t, db.Migrator().HasIndex(&models.Restaurant{}, RestaurantNameAddressIndex))
+}
+
+func TestCreateIndexes_ExternalIDUniquePerSource(t *testing.T) {
+	db := openTestDB(t)
+	require.NoError(t, CreateIndexes(db))
+
+	require.NoError(t, db.Create(&models.Restaurant{Name: "카카오 맛집", ExternalSource: "kakao", ExternalID: "123"}).Error)
+	err := db.Create(&models.Restaurant{Name: "이름만 다른 맛집", ExternalSource: "kakao", ExternalID: "123"}).Error
+	assert.ErrorIs(t, err, gorm.ErrDuplicatedKey)
+
+	// 출처가 다르거나 외부 ID가 없으면 충돌하지 않음
+	assert.NoError(t, db.Create(&models.Restaurant{Name: "네이버 맛집", ExternalSource: "naver", ExternalID: "123"}).Error)
+	assert.NoError(t, db.Create(&models.Restaurant{Name: "직접 등록 1"}).Error)
+	assert.NoError(t, db.Create(&models.Restaurant{Name: "직접 등록 2"}).Error)
+}
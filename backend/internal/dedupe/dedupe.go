@@ -0,0 +1,226 @@
+package dedupe
+
+import (
+	"errors"
+	"fmt"
+	"lunch_app/backend/internal/geo"
+	"lunch_app/backend/internal/models"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultRadiusMeters 같은 건물로 보는 기본 거리
+	DefaultRadiusMeters = 30.0
+	// MaxRadiusMeters 중복 후보 검색 최대 거리
+	MaxRadiusMeters = 200.0
+	// similarityThreshold 같은 가게로 보는 최소 이름 유사도
+	similarityThreshold = 0.75
+)
+
+// Candidate 중복으로 의심되는 맛집 한 쌍 (Restaurant가 먼저 등록된 쪽)
+type Candidate struct {
+	Restaurant     models.Restaurant
+	Duplicate      models.Restaurant
+	DistanceMeters float64
+	NameSimilarity float64
+}
+
+// FindDuplicates radiusMeters 안에 있고 정규화한 이름이 비슷한 맛집 쌍을 가까운 순으로 조회
+func FindDuplicates(db *gorm.DB, radiusMeters float64) ([]Candidate, error) {
+	var restaurants []models.Restaurant
+	if err := db.Order("latitude").Find(&restaurants).Error; err != nil {
+		return nil, err
+	}
+
+	// 위도순으로 정렬해 두고 위도 차이가 반경을 넘으면 더 볼 필요 없음
+	latitudeDelta := geo.LatitudeDelta(radiusMeters)
+	candidates := []Candidate{}
+	for i, a := range restaurants {
+		for _, b := range restaurants[i+1:] {
+			if b.Latitude-a.Latitude > latitudeDelta {
+				break
+			}
+			distance := geo.DistanceMeters(a.Latitude, a.Longitude, b.Latitude, b.Longitude)
+			if distance > radiusMeters {
+				continue
+			}
+			similarity := NameSimilarity(a.Name, b.Name)
+			if similarity < similarityThreshold {
+				continue
+			}
+			older, newer := a, b
+			if newer.ID < older.ID {
+				older, newer = newer, older
+			}
+			candidates = append(candidates, Candidate{
+				Restaurant:     older,
+				Duplicate:      newer,
+				DistanceMeters: distance,
+				NameSimilarity: similarity,
+			})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].DistanceMeters != candidates[j].DistanceMeters {
+			return candidates[i].DistanceMeters < candidates[j].DistanceMeters
+		}
+		return candidates[i].Restaurant.ID < candidates[j].Restaurant.ID
+	})
+	return candidates, nil
+}
+
+// NotFoundError 병합 대상 맛집이 없거나 삭제됨
+type NotFoundError struct {
+	ID uint
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("restaurant %d not found", e.ID)
+}
+
+// ErrMergeIntoSelf 남길 맛집이 병합 대상 목록에 포함됨
+var ErrMergeIntoSelf = errors.New("cannot merge a restaurant into itself")
+
+// MergeResult 병합 결과
+type MergeResult struct {
+	Restaurant     models.Restaurant
+	MergedIDs      []uint
+	MovedVisits    int64
+	MovedReviews   int64
+	MovedBookmarks int64
+}
+
+// Merge 중복 맛집의 방문 기록·리뷰·북마크를 남길 맛집으로 옮기고 중복 맛집을 soft delete (한 트랜잭션)
+// 삭제된 방문 기록·리뷰도 함께 옮겨 나중에 복원해도 남길 맛집을 가리키게 함
+// 방문 기록의 스냅샷은 방문 당시 정보이므로 그대로 두고, 이미 남길 맛집을 북마크한 사용자의 북마크는 삭제
+// 남길 맛집에 외부 장소 ID나 영업시간이 없으면 병합된 맛집의 것을 이어받음
+func Merge(db *gorm.DB, survivorID uint, duplicateIDs []uint) (MergeResult, error) {
+	result := MergeResult{MergedIDs: uniqueIDs(duplicateIDs)}
+	for _, id := range result.MergedIDs {
+		if id == survivorID {
+			return result, ErrMergeIntoSelf
+		}
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&result.Restaurant, survivorID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &NotFoundError{ID: survivorID}
+			}
+			return err
+		}
+
+		var found []uint
+		if err := tx.Model(&models.Restaurant{}).Where("id IN ?", result.MergedIDs).Pluck("id", &found).Error; err != nil {
+			return err
+		}
+		if missing := firstMissing(result.MergedIDs, found); missing != 0 {
+			return &NotFoundError{ID: missing}
+		}
+
+		visits := tx.Unscoped().Model(&models.Visit{}).Where("restaurant_id IN ?", result.MergedIDs).Update("restaurant_id", survivorID)
+		if visits.Error != nil {
+			return visits.Error
+		}
+		result.MovedVisits = visits.RowsAffected
+
+		reviews := tx.Unscoped().Model(&models.Review{}).Where("restaurant_id IN ?", result.MergedIDs).Update("restaurant_id", survivorID)
+		if reviews.Error != nil {
+			return reviews.Error
+		}
+		result.MovedReviews = reviews.RowsAffected
+
+		// 같은 사용자가 두 맛집을 모두 북마크했다면 하나만 남김
+		bookmarkedUsers := tx.Model(&models.Bookmark{}).Select("user_id").Where("restaurant_id = ?", survivorID)
+		if err := tx.Where("restaurant_id IN ? AND user_id IN (?)", result.MergedIDs, bookmarkedUsers).Delete(&models.Bookmark{}).Error; err != nil {
+			return err
+		}
+		bookmarks := tx.Unscoped().Model(&models.Bookmark{}).Where("restaurant_id IN ?", result.MergedIDs).Update("restaurant_id", survivorID)
+		if bookmarks.Error != nil {
+			return bookmarks.Error
+		}
+		result.MovedBookmarks = bookmarks.RowsAffected
+
+		if err := tx.Delete(&models.Restaurant{}, result.MergedIDs).Error; err != nil {
+			return err
+		}
+		if err := inheritHours(tx, survivorID, result.MergedIDs); err != nil {
+			return err
+		}
+		return inheritExternalID(tx, &result.Restaurant, result.MergedIDs)
+	})
+	return result, err
+}
+
+// inheritExternalID 남길 맛집에 외부 장소 ID가 없으면 병합된 맛집의 외부 ID를 이어받음
+// 병합된 맛집은 이미 삭제되었으므로 부분 유니크 인덱스와 충돌하지 않음
+func inheritExternalID(tx *gorm.DB, survivor *models.Restaurant, mergedIDs []uint) error {
+	if survivor.ExternalID != "" {
+		return nil
+	}
+	var linked models.Restaurant
+	err := tx.Unscoped().Where("id IN ? AND external_id <> ''", mergedIDs).Order("id").First(&linked).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Model(survivor).Updates(map[string]any{
+		"external_source": linked.ExternalSource,
+		"external_id":     linked.ExternalID,
+	}).Error
+}
+
+// inheritHours 남길 맛집에 영업시간이 없으면 병합된 맛집 중 영업시간이 있는 첫 맛집의 영업시간과 날짜별 예외를 옮김
+func inheritHours(tx *gorm.DB, survivorID uint, mergedIDs []uint) error {
+	var count int64
+	if err := tx.Model(&models.OpeningHours{}).Where("restaurant_id = ?", survivorID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	var sourceIDs []uint
+	err := tx.Model(&models.OpeningHours{}).Where("restaurant_id IN ?", mergedIDs).
+		Order("restaurant_id").Limit(1).Pluck("restaurant_id", &sourceIDs).Error
+	if err != nil || len(sourceIDs) == 0 {
+		return err
+	}
+	if err := tx.Model(&models.OpeningHours{}).Where("restaurant_id = ?", sourceIDs[0]).Update("restaurant_id", survivorID).Error; err != nil {
+		return err
+	}
+	// 남길 맛집에 따로 지정한 날짜는 남길 맛집의 설정을 유지
+	survivorDates := tx.Model(&models.RestaurantHoliday{}).Select("date").Where("restaurant_id = ?", survivorID)
+	return tx.Model(&models.RestaurantHoliday{}).
+		Where("restaurant_id = ? AND date NOT IN (?)", sourceIDs[0], survivorDates).
+		Update("restaurant_id", survivorID).Error
+}
+
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+func firstMissing(want, found []uint) uint {
+	present := make(map[uint]bool, len(found))
+	for _, id := range found {
+		present[id] = true
+	}
+	for _, id := range want {
+		if !present[id] {
+			return id
+		}
+	}
+	return 0
+}
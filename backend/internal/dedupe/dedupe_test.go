@@ -0,0 +1,130 @@
+package dedupe
+
+import (
+	"lunch_app/backend/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Restaurant{}, &models.Visit{}, &models.Review{}, &models.Bookmark{}, &models.OpeningHours{}, &models.RestaurantHoliday{}))
+	return db
+}
+
+func TestNormalizeName(t *testing.T) {
+	cases := map[string]string{
+		"스시하나":             "스시하나",
+		"스시 하나 본점":         "스시하나",
+		"스시하나 강남점":         "스시하나",
+		"Sushi Hana (강남역)": "sushihana",
+		"버거킹 1호점":          "버거킹",
+		"우리집":              "우리집",
+		"본점":               "본점",
+		"맛있는 김밥천국 - 역삼점":   "맛있는김밥천국",
+	}
+	for input, want := range cases {
+		assert.Equal(t, want, NormalizeName(input), input)
+	}
+}
+
+func TestNameSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, NameSimilarity("스시하나", "스시 하나 본점"))
+	assert.Equal(t, 1.0, NameSimilarity("스타벅스", "스타벅스 리저브"))
+	assert.GreaterOrEqual(t, NameSimilarity("김밥천국", "김밥천굿"), similarityThreshold)
+	assert.Less(t, NameSimilarity("스시하나", "라멘둘"), similarityThreshold)
+	assert.Zero(t, NameSimilarity("", "스시하나"))
+}
+
+func TestFindDuplicates(t *testing.T) {
+	db := setupDB(t)
+	original := models.Restaurant{Name: "스시하나", Latitude: 37.50000, Longitude: 127.03000}
+	branch := models.Restaurant{Name: "스시 하나 본점", Latitude: 37.50005, Longitude: 127.03005} // 약 7m
+	neighbor := models.Restaurant{Name: "라멘둘", Latitude: 37.50001, Longitude: 127.03001}    // 같은 건물, 다른 가게
+	faraway := models.Restaurant{Name: "스시하나", Latitude: 37.51000, Longitude: 127.03000}    // 약 1.1km
+	require.NoError(t, db.Create(&[]*models.Restaurant{&original, &branch, &neighbor, &faraway}).Error)
+
+	candidates, err := FindDuplicates(db, DefaultRadiusMeters)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	assert.Equal(t, original.ID, candidates[0].Restaurant.ID)
+	assert.Equal(t, branch.ID, candidates[0].Duplicate.ID)
+	assert.InDelta(t, 7, candidates[0].DistanceMeters, 1)
+
+	// 반경을 넓히면 먼 곳의 같은 이름도 후보가 됨
+	candidates, err = FindDuplicates(db, 2000)
+	require.NoError(t, err)
+	assert.Len(t, candidates, 3)
+}
+
+func TestMerge_MovesEverythingInOneTransaction(t *testing.T) {
+	db := setupDB(t)
+	survivor := models.Restaurant{Name: "스시하나"}
+	duplicate := models.Restaurant{Name: "스시 하나 본점", ExternalSource: models.ExternalSourceKakao, ExternalID: "26338954"}
+	require.NoError(t, db.Create(&[]*models.Restaurant{&survivor, &duplicate}).Error)
+
+	require.NoError(t, db.Create(&[]models.Visit{
+		{RestaurantID: duplicate.ID, RestaurantSnapshot: duplicate.Snapshot()},
+		{RestaurantID: duplicate.ID, RestaurantSnapshot: duplicate.Snapshot()},
+	}).Error)
+	require.NoError(t, db.Create(&models.Review{RestaurantID: duplicate.ID, UserID: 1, Content: "맛있음"}).Error)
+	require.NoError(t, db.Create(&[]models.Bookmark{
+		{UserID: 1, RestaurantID: survivor.ID},
+		{UserID: 1, RestaurantID: duplicate.ID}, // 이미 남길 맛집을 북마크함
+		{UserID: 2, RestaurantID: duplicate.ID},
+	}).Error)
+	require.NoError(t, db.Create(&models.OpeningHours{RestaurantID: duplicate.ID, Weekday: 1, Opens: "11:30", Closes: "21:00"}).Error)
+
+	result, err := Merge(db, survivor.ID, []uint{duplicate.ID, duplicate.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []uint{duplicate.ID}, result.MergedIDs)
+	assert.Equal(t, int64(2), result.MovedVisits)
+	assert.Equal(t, int64(1), result.MovedReviews)
+
+	var visits []models.Visit
+	require.NoError(t, db.Find(&visits).Error)
+	for _, v := range visits {
+		assert.Equal(t, survivor.ID, v.RestaurantID)
+		assert.Equal(t, "스시 하나 본점", v.RestaurantSnapshot.Name, "방문 당시 스냅샷은 유지")
+	}
+
+	var bookmarkUsers []uint
+	db.Model(&models.Bookmark{}).Where("restaurant_id = ?", survivor.ID).Order("user_id").Pluck("user_id", &bookmarkUsers)
+	assert.Equal(t, []uint{1, 2}, bookmarkUsers)
+
+	var remaining []models.Restaurant
+	require.NoError(t, db.Find(&remaining).Error)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, "26338954", remaining[0].ExternalID, "카카오 장소 ID를 이어받음")
+	assert.Equal(t, "26338954", result.Restaurant.ExternalID)
+
+	var hours []models.OpeningHours
+	require.NoError(t, db.Where("restaurant_id = ?", survivor.ID).Find(&hours).Error)
+	assert.Len(t, hours, 1, "영업시간이 없던 맛집은 영업시간을 이어받음")
+}
+
+func TestMerge_Errors(t *testing.T) {
+	db := setupDB(t)
+	survivor := models.Restaurant{Name: "스시하나"}
+	duplicate := models.Restaurant{Name: "스시 하나 본점"}
+	require.NoError(t, db.Create(&[]*models.Restaurant{&survivor, &duplicate}).Error)
+	require.NoError(t, db.Create(&models.Visit{RestaurantID: duplicate.ID}).Error)
+
+	_, err := Merge(db, survivor.ID, []uint{survivor.ID})
+	assert.ErrorIs(t, err, ErrMergeIntoSelf)
+
+	// 없는 맛집이 섞여 있으면 아무것도 옮기지 않음
+	_, err = Merge(db, survivor.ID, []uint{duplicate.ID, 999})
+	var notFound *NotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, uint(999), notFound.ID)
+
+	var visit models.Visit
+	require.NoError(t, db.First(&visit).Error)
+	assert.Equal(t, duplicate.ID, visit.RestaurantID)
+}
@@ -0,0 +1,109 @@
+package dedupe
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// branchSuffixes 이름 끝에 붙어도 같은 가게로 보는 지점 표기
+var branchSuffixes = []string{"본점", "직영점", "1호점"}
+
+// NormalizeName 비교용 맛집 이름 정규화
+// 괄호 안 내용과 지점 표기("본점", 띄어 쓴 "OO점")를 제거하고 공백·기호를 없앤 소문자로 변환
+// 예: "스시 하나 본점" → "스시하나", "Sushi Hana (강남역)" → "sushihana"
+func NormalizeName(name string) string {
+	name = stripParentheses(name)
+
+	tokens := strings.Fields(name)
+	if n := len(tokens); n > 1 && isBranchToken(tokens[n-1]) {
+		tokens = tokens[:n-1]
+	}
+
+	var b strings.Builder
+	for _, r := range strings.Join(tokens, "") {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	normalized := b.String()
+
+	for _, suffix := range branchSuffixes {
+		if trimmed := strings.TrimSuffix(normalized, suffix); trimmed != "" {
+			normalized = trimmed
+		}
+	}
+	return normalized
+}
+
+// isBranchToken 띄어 쓴 마지막 단어가 "강남점", "2호점" 같은 지점 표기인지 확인
+func isBranchToken(token string) bool {
+	return utf8.RuneCountInString(token) >= 2 && strings.HasSuffix(token, "점")
+}
+
+func stripParentheses(name string) string {
+	var b strings.Builder
+	depth := 0
+	for _, r := range name {
+		switch r {
+		case '(', '[', '（':
+			depth++
+		case ')', ']', '）':
+			if depth > 0 {
+				depth--
+			}
+		default:
+			if depth == 0 {
+				b.WriteRune(r)
+			}
+		}
+	}
+	return b.String()
+}
+
+// NameSimilarity 정규화한 두 이름의 유사도 (0~1)
+// 한쪽이 다른 쪽을 포함하면(2글자 이상) 같은 가게의 다른 표기로 보고 1을 반환, 그 외에는 편집 거리 기반
+func NameSimilarity(a, b string) float64 {
+	a, b = NormalizeName(a), NormalizeName(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+
+	shorter, longer := a, b
+	if utf8.RuneCountInString(shorter) > utf8.RuneCountInString(longer) {
+		shorter, longer = longer, shorter
+	}
+	if utf8.RuneCountInString(shorter) >= 2 && strings.Contains(longer, shorter) {
+		return 1
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	maxLen := len(ra)
+	if len(rb) > maxLen {
+		maxLen = len(rb)
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(maxLen)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
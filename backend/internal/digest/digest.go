@@ -0,0 +1,188 @@
+// Package digest 구독자에게 아침마다 보내는 점심 다이제스트 메일
+//
+// 오늘의 추천 맛집, 팀의 진행 중인 점심 투표, 한동안 가지 않은 맛집을 모아
+// HTML·텍스트 템플릿으로 만든 뒤 mail.Sender로 보냄.
+package digest
+
+import (
+	"cmp"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/poll"
+	"lunch_app/backend/internal/recommend"
+	mathrand "math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// RecommendCount 추천 맛집 수
+	RecommendCount = 3
+	// ForgottenCount 한동안 가지 않은 맛집 수
+	ForgottenCount = 3
+	// ForgottenDays 이 기간 동안 가지 않은 맛집을 "오랜만" 맛집으로 보여 줌
+	ForgottenDays = 30
+)
+
+// ErrNotFound 구독이 없음
+var ErrNotFound = errors.New("digest: subscription not found")
+
+// ForgottenPlace 한동안 가지 않은 맛집
+type ForgottenPlace struct {
+	Restaurant models.Restaurant
+	LastVisit  time.Time
+	// DaysAgo 마지막 방문이 며칠 전인지 (Asia/Seoul 날짜 기준)
+	DaysAgo int
+}
+
+// Digest 구독자 한 명의 그날 다이제스트 내용
+type Digest struct {
+	Subscription models.DigestSubscription
+	// Date 점심 날짜 (Asia/Seoul 자정)
+	Date            time.Time
+	Recommendations []models.Restaurant
+	// Poll 팀의 오늘 진행 중인 투표 (없으면 nil)
+	Poll      *models.Poll
+	Forgotten []ForgottenPlace
+}
+
+// IsEmpty 보여 줄 내용이 하나도 없는지 여부 (등록된 맛집이 없을 때)
+func (d Digest) IsEmpty() bool {
+	return len(d.Recommendations) == 0 && d.Poll == nil && len(d.Forgotten) == 0
+}
+
+// Build now 기준으로 구독자의 다이제스트 내용을 모음 (rng가 nil이면 전역 난수로 추천)
+func Build(db *gorm.DB, sub models.DigestSubscription, now time.Time, rng *mathrand.Rand) (Digest, error) {
+	d := Digest{Subscription: sub, Date: kst.Date(now)}
+
+	forgotten, err := forgottenPlaces(db, sub.Name, now)
+	if err != nil {
+		return d, err
+	}
+	d.Forgotten = forgotten
+
+	excludeIDs := make([]uint, 0, len(forgotten))
+	for _, place := range forgotten {
+		excludeIDs = append(excludeIDs, place.Restaurant.ID)
+	}
+	d.Recommendations, err = recommend.Restaurants(db, recommend.Options{
+		Limit:      RecommendCount,
+		ExcludeIDs: excludeIDs,
+		Now:        now,
+		Rand:       rng,
+	})
+	if err != nil {
+		return d, err
+	}
+
+	if sub.Team != "" {
+		var open models.Poll
+		err := db.Scopes(poll.WithDetails).
+			Where("team = ? AND status = ? AND date = ? AND deadline > ?", sub.Team, models.PollStatusOpen, d.Date, now).
+			Order("id DESC").First(&open).Error
+		switch {
+		case err == nil:
+			d.Poll = &open
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return d, err
+		}
+	}
+	return d, nil
+}
+
+// forgottenPlaces ForgottenDays 넘게 가지 않은 맛집을 마지막 방문이 오래된 순으로
+// visitor가 있으면 그 사람의 방문 기록만 봄
+func forgottenPlaces(db *gorm.DB, visitor string, now time.Time) ([]ForgottenPlace, error) {
+	query := db.Model(&models.Visit{}).Where("restaurant_id IS NOT NULL AND restaurant_id <> 0")
+	if visitor != "" {
+		query = query.Where("visitor = ?", visitor)
+	}
+	var visits []models.Visit
+	if err := query.Select("restaurant_id", "visit_date").Find(&visits).Error; err != nil {
+		return nil, err
+	}
+
+	lastVisits := map[uint]time.Time{}
+	for _, visit := range visits {
+		if visit.VisitDate.After(lastVisits[visit.RestaurantID]) {
+			lastVisits[visit.RestaurantID] = visit.VisitDate
+		}
+	}
+	cutoff := now.AddDate(0, 0, -ForgottenDays)
+	ids := make([]uint, 0, len(lastVisits))
+	for id, last := range lastVisits {
+		if last.Before(cutoff) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return []ForgottenPlace{}, nil
+	}
+
+	var restaurants []models.Restaurant
+	if err := db.Where("id IN ?", ids).Find(&restaurants).Error; err != nil {
+		return nil, err
+	}
+	places := make([]ForgottenPlace, 0, len(restaurants))
+	today := kst.Date(now)
+	for _, r := range restaurants {
+		last := lastVisits[r.ID]
+		places = append(places, ForgottenPlace{
+			Restaurant: r,
+			LastVisit:  last,
+			DaysAgo:    int(today.Sub(kst.Date(last)).Hours() / 24),
+		})
+	}
+	slices.SortFunc(places, func(a, b ForgottenPlace) int {
+		return cmp.Or(a.LastVisit.Compare(b.LastVisit), cmp.Compare(a.Restaurant.ID, b.Restaurant.ID))
+	})
+	if len(places) > ForgottenCount {
+		places = places[:ForgottenCount]
+	}
+	return places, nil
+}
+
+// Subscribe 구독 등록 (같은 이메일로 이미 있으면 이름·팀을 바꾸고 다시 켬, 새로 만들었으면 created가 true)
+func Subscribe(db *gorm.DB, email, name, team string) (sub models.DigestSubscription, created bool, err error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	err = db.Where("email = ?", email).First(&sub).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		sub = models.DigestSubscription{Email: email, Name: name, Team: team, Token: NewToken(), Active: true}
+		return sub, true, db.Create(&sub).Error
+	case err != nil:
+		return sub, false, err
+	}
+	sub.Name, sub.Team, sub.Active = name, team, true
+	return sub, false, db.Save(&sub).Error
+}
+
+// Unsubscribe 해지 링크 토큰으로 구독 해지 (이미 해지한 구독도 성공)
+func Unsubscribe(db *gorm.DB, token string) (models.DigestSubscription, error) {
+	var sub models.DigestSubscription
+	if token == "" {
+		return sub, ErrNotFound
+	}
+	err := db.Where("token = ?", token).First(&sub).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return sub, ErrNotFound
+	}
+	if err != nil {
+		return sub, err
+	}
+	sub.Active = false
+	return sub, db.Model(&sub).Update("active", false).Error
+}
+
+// NewToken 구독 해지 링크 토큰 생성 (32바이트 hex)
+func NewToken() string {
+	token := make([]byte, 32)
+	rand.Read(token)
+	return hex.EncodeToString(token)
+}
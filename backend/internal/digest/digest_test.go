@@ -0,0 +1,225 @@
+package digest
+
+import (
+	"context"
+	"io"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/mail"
+	"lunch_app/backend/internal/mail/mailtest"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/poll"
+	"math/rand/v2"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// 2024-07-05 (금) 08:40 KST
+var fixedNow = time.Date(2024, 7, 4, 23, 40, 0, 0, time.UTC)
+
+var testConfig = Config{SendAt: 8*time.Hour + 30*time.Minute, AppURL: "https://lunch.example.com", APIURL: "https://api.example.com/"}
+
+func setupDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{TranslateError: true})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Restaurant{}, &models.Visit{}, &models.Poll{}, &models.PollCandidate{}, &models.PollVote{}, &models.DigestSubscription{}))
+	return db
+}
+
+func createRestaurants(t *testing.T, db *gorm.DB, names ...string) []models.Restaurant {
+	restaurants := make([]models.Restaurant, 0, len(names))
+	for i, name := range names {
+		restaurants = append(restaurants, models.Restaurant{Name: name, Address: "서울시 " + name, Category: "한식", Latitude: 37.5 + float64(i)/1000, Longitude: 127})
+	}
+	require.NoError(t, db.Create(&restaurants).Error)
+	return restaurants
+}
+
+func createVisit(t *testing.T, db *gorm.DB, r models.Restaurant, visitor string, daysAgo int) {
+	visit := models.Visit{RestaurantID: r.ID, RestaurantSnapshot: r.Snapshot(), Visitor: visitor, VisitDate: fixedNow.AddDate(0, 0, -daysAgo)}
+	require.NoError(t, db.Create(&visit).Error)
+}
+
+func newSMTPSender(t *testing.T) (*mail.SMTPSender, *mailtest.Server) {
+	server, err := mailtest.NewServer()
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+	return mail.NewSMTPSender(mail.SMTPConfig{Host: server.Host(), Port: server.Port(), From: "lunch@example.com", TLS: mail.TLSNone}), server
+}
+
+// textBody 받은 메일의 텍스트 본문
+func textBody(t *testing.T, message mailtest.Message) string {
+	parsed, err := message.Parse()
+	require.NoError(t, err)
+	_, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	part, err := multipart.NewReader(parsed.Body, params["boundary"]).NextRawPart()
+	require.NoError(t, err)
+	body, err := io.ReadAll(quotedprintable.NewReader(part))
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestBuildAndRender(t *testing.T) {
+	db := setupDB(t)
+	restaurants := createRestaurants(t, db, "국밥집", "초밥집", "버거집", "분식집", "<b>태그</b>식당")
+	createVisit(t, db, restaurants[0], "민수", 45)
+	createVisit(t, db, restaurants[1], "민수", 40)
+	createVisit(t, db, restaurants[1], "민수", 2)  // 최근에 다시 감
+	createVisit(t, db, restaurants[2], "지영", 60) // 다른 사람의 방문
+	_, err := poll.Create(db, poll.CreateInput{
+		Team: "backend", Title: "금요일 점심", CreatedBy: "지영",
+		Deadline: fixedNow.Add(3 * time.Hour), RestaurantIDs: []uint{restaurants[2].ID, restaurants[3].ID},
+	}, fixedNow)
+	require.NoError(t, err)
+	sub, _, err := Subscribe(db, " Minsu@Example.com ", "민수", "backend")
+	require.NoError(t, err)
+
+	d, err := Build(db, sub, fixedNow, rand.New(rand.NewPCG(1, 2)))
+	require.NoError(t, err)
+	require.Len(t, d.Forgotten, 1, "그 사람이 30일 넘게 가지 않은 맛집만")
+	assert.Equal(t, "국밥집", d.Forgotten[0].Restaurant.Name)
+	assert.Equal(t, 45, d.Forgotten[0].DaysAgo)
+	assert.Len(t, d.Recommendations, RecommendCount)
+	for _, r := range d.Recommendations {
+		assert.NotEqual(t, "국밥집", r.Name, "오랜만 맛집과 추천이 겹치지 않음")
+	}
+	require.NotNil(t, d.Poll)
+	assert.Equal(t, "금요일 점심", d.Poll.Title)
+
+	msg, err := Render(d, testConfig)
+	require.NoError(t, err)
+	assert.Equal(t, "[점심] 7월 5일 (금) 오늘의 점심", msg.Subject)
+	assert.Equal(t, []string{"=?utf-8?q?=EB=AF=BC=EC=88=98?= <minsu@example.com>"}, msg.To)
+	unsubscribe := "https://api.example.com/api/digest/unsubscribe?token=" + sub.Token
+	assert.Equal(t, "<"+unsubscribe+">", msg.Headers["List-Unsubscribe"])
+	assert.Contains(t, msg.Text, "민수님, 좋은 아침이에요! 7월 5일 (금) 점심 소식입니다.")
+	assert.Contains(t, msg.Text, "■ 진행 중인 점심 투표: 금요일 점심 (11:40 마감)\n- 버거집 · 한식 — 0표\n- 분식집 · 한식 — 0표")
+	assert.Contains(t, msg.Text, "- 국밥집 · 한식 — 마지막 방문 45일 전")
+	assert.Contains(t, msg.Text, "메일 그만 받기: "+unsubscribe)
+	assert.Contains(t, msg.HTML, `<a href="https://api.example.com/api/digest/unsubscribe?token=`+sub.Token+`"`)
+	assert.NotContains(t, msg.HTML, "<b>태그</b>", "HTML 템플릿은 맛집 이름을 이스케이프")
+}
+
+func TestRender_Empty(t *testing.T) {
+	db := setupDB(t)
+	sub := models.DigestSubscription{Email: "a@example.com", Token: "token"}
+
+	d, err := Build(db, sub, fixedNow, nil)
+	require.NoError(t, err)
+	assert.True(t, d.IsEmpty())
+	msg, err := Render(d, testConfig)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(msg.Text, "좋은 아침이에요!"), "이름이 없으면 인사말만")
+	assert.Contains(t, msg.Text, "아직 등록된 맛집이 없어요")
+}
+
+func TestSendDue(t *testing.T) {
+	db := setupDB(t)
+	createRestaurants(t, db, "국밥집", "초밥집")
+	sender, server := newSMTPSender(t)
+	server.RejectRecipients["gone@example.com"] = true
+	minsu, _, err := Subscribe(db, "minsu@example.com", "민수", "backend")
+	require.NoError(t, err)
+	gone, _, err := Subscribe(db, "gone@example.com", "", "")
+	require.NoError(t, err)
+	jiyoung, _, err := Subscribe(db, "jiyoung@example.com", "지영", "backend")
+	require.NoError(t, err)
+	_, err = Unsubscribe(db, jiyoung.Token)
+	require.NoError(t, err)
+
+	sent, err := SendDue(context.Background(), db, sender, testConfig, fixedNow.Add(-20*time.Minute))
+	require.NoError(t, err)
+	assert.Zero(t, sent, "발송 시각 전")
+
+	sent, err = SendDue(context.Background(), db, sender, testConfig, fixedNow)
+	assert.ErrorContains(t, err, "mailbox unavailable")
+	assert.Equal(t, 1, sent)
+	messages := server.Messages()
+	require.Len(t, messages, 1, "해지한 구독자에게는 보내지 않음")
+	assert.Equal(t, []string{"minsu@example.com"}, messages[0].To)
+	assert.Contains(t, textBody(t, messages[0]), "민수님, 좋은 아침이에요!")
+
+	require.NoError(t, db.First(&gone, gone.ID).Error)
+	assert.Contains(t, gone.LastError, "mailbox unavailable")
+	require.NotNil(t, gone.LastSentOn, "실패해도 그날은 다시 보내지 않음")
+	require.NoError(t, db.First(&minsu, minsu.ID).Error)
+	assert.Empty(t, minsu.LastError)
+	assert.True(t, kst.Date(fixedNow).Equal(*minsu.LastSentOn))
+
+	sent, err = SendDue(context.Background(), db, sender, testConfig, fixedNow.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, sent, "하루에 한 번")
+
+	sent, err = SendDue(context.Background(), db, sender, testConfig, fixedNow.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Zero(t, sent, "주말에는 보내지 않음")
+
+	server.RejectRecipients["gone@example.com"] = false
+	sent, err = SendDue(context.Background(), db, sender, testConfig, fixedNow.AddDate(0, 0, 3))
+	require.NoError(t, err)
+	assert.Equal(t, 2, sent, "다음 평일")
+}
+
+func TestConfig_DueLocation(t *testing.T) {
+	assert.True(t, testConfig.Due(fixedNow), "기본은 Asia/Seoul")
+	assert.False(t, testConfig.Due(fixedNow.Add(-20*time.Minute)))
+
+	utc := testConfig
+	utc.Location = time.UTC
+	assert.False(t, utc.Due(time.Date(2024, 7, 5, 8, 29, 0, 0, time.UTC)), "서울은 17:29지만 UTC로는 발송 시각 전")
+	assert.True(t, utc.Due(time.Date(2024, 7, 5, 8, 30, 0, 0, time.UTC)), "UTC 금요일 08:30")
+	assert.False(t, utc.Due(time.Date(2024, 7, 6, 8, 30, 0, 0, time.UTC)), "UTC 토요일")
+}
+
+func TestSubscribe_Resubscribe(t *testing.T) {
+	db := setupDB(t)
+	sub, created, err := Subscribe(db, "minsu@example.com", "민수", "backend")
+	require.NoError(t, err)
+	assert.True(t, created)
+	_, err = Unsubscribe(db, sub.Token)
+	require.NoError(t, err)
+
+	again, created, err := Subscribe(db, "MINSU@example.com", "김민수", "frontend")
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, sub.ID, again.ID)
+	assert.Equal(t, sub.Token, again.Token, "해지 링크는 그대로")
+	assert.True(t, again.Active)
+	assert.Equal(t, "frontend", again.Team)
+
+	_, err = Unsubscribe(db, "unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("DIGEST_API_URL", "https://lunch-api.example.com")
+	t.Setenv("DIGEST_SEND_AT", "07:45")
+	cfg, err := ConfigFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, 7*time.Hour+45*time.Minute, cfg.SendAt)
+	assert.Equal(t, "45 7 * * 1-5", cfg.Spec())
+	assert.Equal(t, defaultAppURL, cfg.AppURL)
+	assert.Equal(t, "https://lunch-api.example.com", cfg.APIURL)
+
+	t.Setenv("DIGEST_SEND_AT", "25:00")
+	cfg, err = ConfigFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, defaultSendAt, cfg.SendAt, "잘못된 값이면 기본값")
+	assert.Equal(t, "30 8 * * 1-5", cfg.Spec())
+
+	// 구독 해지 링크가 localhost 등 엉뚱한 곳을 가리키지 않도록 기본값 없음
+	for _, value := range []string{"", "lunch-api.example.com", "ftp://lunch-api.example.com", "https://"} {
+		t.Setenv("DIGEST_API_URL", value)
+		_, err = ConfigFromEnv()
+		assert.ErrorIs(t, err, ErrAPIURLRequired, value)
+	}
+}
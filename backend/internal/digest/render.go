@@ -0,0 +1,150 @@
+package digest
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"lunch_app/backend/internal/kakao"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/mail"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/poll"
+	netmail "net/mail"
+	"net/url"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var (
+	htmlTemplate = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/digest.html.tmpl"))
+	textTemplate = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/digest.txt.tmpl"))
+)
+
+// weekdays 요일 한 글자 (time.Weekday 순서)
+var weekdays = [...]string{"일", "월", "화", "수", "목", "금", "토"}
+
+// view 템플릿에 넘기는 값 (HTML과 텍스트 템플릿이 같은 값을 씀)
+type view struct {
+	Subject         string
+	Name            string
+	DateLabel       string
+	Poll            *pollView
+	Recommendations []restaurantView
+	Forgotten       []forgottenView
+	Empty           bool
+	AppURL          string
+	UnsubscribeURL  string
+}
+
+type pollView struct {
+	Title         string
+	DeadlineLabel string
+	Candidates    []candidateView
+}
+
+type candidateView struct {
+	Name     string
+	Category string
+	Votes    int
+}
+
+type restaurantView struct {
+	Name     string
+	Category string
+	Address  string
+	MapURL   string
+}
+
+type forgottenView struct {
+	Name     string
+	Category string
+	DaysAgo  int
+}
+
+// Subject 메일 제목 (예: "[점심] 7월 5일 (금) 오늘의 점심")
+func Subject(date time.Time) string {
+	return "[점심] " + dateLabel(date) + " 오늘의 점심"
+}
+
+// UnsubscribeURL 구독 해지 링크
+func UnsubscribeURL(cfg Config, token string) string {
+	return strings.TrimRight(cfg.APIURL, "/") + "/api/digest/unsubscribe?token=" + url.QueryEscape(token)
+}
+
+// Render 다이제스트를 HTML·텍스트 본문의 메일로 만듦
+func Render(d Digest, cfg Config) (mail.Message, error) {
+	v := newView(d, cfg)
+	var html, text bytes.Buffer
+	if err := htmlTemplate.Execute(&html, v); err != nil {
+		return mail.Message{}, err
+	}
+	if err := textTemplate.Execute(&text, v); err != nil {
+		return mail.Message{}, err
+	}
+	to := (&netmail.Address{Name: d.Subscription.Name, Address: d.Subscription.Email}).String()
+	return mail.Message{
+		To:      []string{to},
+		Subject: v.Subject,
+		Text:    text.String(),
+		HTML:    html.String(),
+		Headers: map[string]string{
+			// 메일 앱의 "구독 해지" 버튼 (RFC 8058 원클릭 해지는 POST로 보냄)
+			"List-Unsubscribe":      "<" + v.UnsubscribeURL + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}, nil
+}
+
+// RenderHTML 미리보기용 HTML 본문
+func RenderHTML(d Digest, cfg Config) (string, error) {
+	var html bytes.Buffer
+	err := htmlTemplate.Execute(&html, newView(d, cfg))
+	return html.String(), err
+}
+
+func newView(d Digest, cfg Config) view {
+	v := view{
+		Subject:        Subject(d.Date),
+		Name:           d.Subscription.Name,
+		DateLabel:      dateLabel(d.Date),
+		Empty:          d.IsEmpty(),
+		AppURL:         cfg.AppURL,
+		UnsubscribeURL: UnsubscribeURL(cfg, d.Subscription.Token),
+	}
+	if d.Poll != nil {
+		p := &pollView{Title: d.Poll.Title, DeadlineLabel: d.Poll.Deadline.In(kst.Location()).Format("15:04")}
+		for _, tally := range poll.Results(*d.Poll) {
+			p.Candidates = append(p.Candidates, candidateView{
+				Name:     tally.Candidate.RestaurantSnapshot.Name,
+				Category: tally.Candidate.RestaurantSnapshot.Category,
+				Votes:    tally.Count(),
+			})
+		}
+		v.Poll = p
+	}
+	for _, r := range d.Recommendations {
+		v.Recommendations = append(v.Recommendations, newRestaurantView(r))
+	}
+	for _, place := range d.Forgotten {
+		v.Forgotten = append(v.Forgotten, forgottenView{
+			Name:     place.Restaurant.Name,
+			Category: place.Restaurant.Category,
+			DaysAgo:  place.DaysAgo,
+		})
+	}
+	return v
+}
+
+func newRestaurantView(r models.Restaurant) restaurantView {
+	return restaurantView{Name: r.Name, Category: r.Category, Address: r.Address, MapURL: kakao.MapURL(r)}
+}
+
+// dateLabel "7월 5일 (금)"
+func dateLabel(date time.Time) string {
+	local := date.In(kst.Location())
+	return local.Format("1월 2일") + " (" + weekdays[local.Weekday()] + ")"
+}
@@ -0,0 +1,163 @@
+package digest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/mail"
+	"lunch_app/backend/internal/models"
+	mathrand "math/rand/v2"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultSendAt 기본 발송 시각 (Asia/Seoul 08:30)
+	defaultSendAt = 8*time.Hour + 30*time.Minute
+	// defaultAppURL 메일의 "점심 앱 열기" 링크
+	defaultAppURL = "https://lunch-app-spd2.onrender.com"
+	// maxErrorLength 구독에 남기는 발송 실패 사유 길이
+	maxErrorLength = 512
+)
+
+// Config 다이제스트 발송 설정
+type Config struct {
+	// SendAt 발송 시각 (Location 자정부터의 시간, 평일에만 보냄)
+	SendAt time.Duration
+	// Location SendAt과 평일을 판단하는 시간대 (예약 작업 cron과 같은 시간대, nil이면 Asia/Seoul)
+	Location *time.Location
+	// AppURL 프론트엔드 주소
+	AppURL string
+	// APIURL 구독 해지 링크에 쓰는 백엔드 공개 주소
+	APIURL string
+}
+
+// ErrAPIURLRequired 구독 해지 링크에 쓸 DIGEST_API_URL이 없거나 http(s) 주소가 아님
+var ErrAPIURLRequired = errors.New("digest: DIGEST_API_URL must be the public http(s) URL of the API")
+
+// ConfigFromEnv 환경변수에서 발송 설정을 읽음 (발송 시각이 잘못되면 기본값)
+// 메일의 구독 해지 링크와 List-Unsubscribe 헤더가 닿을 수 있어야 하므로 DIGEST_API_URL은 기본값 없이 필수
+//
+//	DIGEST_SEND_AT   발송 시각 HH:MM (예약 작업 시간대 JOB_TIMEZONE, 기본 08:30)
+//	DIGEST_APP_URL   메일의 점심 앱 링크 (기본 https://lunch-app-spd2.onrender.com)
+//	DIGEST_API_URL   구독 해지 링크의 백엔드 공개 주소 (필수, 예: https://lunch-api.example.com)
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		SendAt: defaultSendAt,
+		AppURL: envString("DIGEST_APP_URL", defaultAppURL),
+		APIURL: strings.TrimSpace(os.Getenv("DIGEST_API_URL")),
+	}
+	if sendAt, err := ParseClock(os.Getenv("DIGEST_SEND_AT")); err == nil {
+		cfg.SendAt = sendAt
+	}
+	if u, err := url.Parse(cfg.APIURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return cfg, ErrAPIURLRequired
+	}
+	return cfg, nil
+}
+
+// ParseClock "HH:MM"을 자정부터의 시간으로 변환
+func ParseClock(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("digest: invalid time %q: %w", value, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Spec 평일 발송 시각의 cron 표현식 (예: "30 8 * * 1-5", 예약 작업 digest의 기본 일정)
+func (c Config) Spec() string {
+	return fmt.Sprintf("%d %d * * 1-5", int(c.SendAt.Minutes())%60, int(c.SendAt.Hours()))
+}
+
+// Due now가 평일 발송 시각 이후인지 (Location 기준)
+func (c Config) Due(now time.Time) bool {
+	local := now.In(c.location())
+	if local.Weekday() == time.Saturday || local.Weekday() == time.Sunday {
+		return false
+	}
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+	return local.Sub(midnight) >= c.SendAt
+}
+
+// location 발송 시각의 시간대 (없으면 Asia/Seoul)
+func (c Config) location() *time.Location {
+	if c.Location != nil {
+		return c.Location
+	}
+	return kst.Location()
+}
+
+// Send 구독자 한 명에게 지금 다이제스트를 보내고 결과를 LastError에 기록
+func Send(ctx context.Context, db *gorm.DB, sender mail.Sender, cfg Config, sub models.DigestSubscription, now time.Time, rng *mathrand.Rand) error {
+	d, err := Build(db, sub, now, rng)
+	if err != nil {
+		return err
+	}
+	msg, err := Render(d, cfg)
+	if err != nil {
+		return err
+	}
+	sendErr := sender.Send(ctx, msg)
+	lastError := ""
+	if sendErr != nil {
+		lastError = sendErr.Error()
+		if len(lastError) > maxErrorLength {
+			lastError = lastError[:maxErrorLength]
+		}
+	}
+	if err := db.Model(&models.DigestSubscription{}).Where("id = ?", sub.ID).Update("last_error", lastError).Error; err != nil {
+		return errors.Join(sendErr, err)
+	}
+	return sendErr
+}
+
+// SendDue 예약 작업 digest의 본문. 발송 시각이 지났으면 오늘 아직 받지 않은 활성 구독자에게 보내고 보낸 수를 반환
+// 보내기 전에 LastSentOn을 조건부 UPDATE로 오늘로 바꿔 선점하므로 여러 인스턴스가 같은 구독자에게 두 번 보내지 않음
+// 실패한 발송은 그날 다시 시도하지 않음 (관리자 API로 다시 보낼 수 있음)
+func SendDue(ctx context.Context, db *gorm.DB, sender mail.Sender, cfg Config, now time.Time) (int, error) {
+	if !cfg.Due(now) {
+		return 0, nil
+	}
+	today := kst.Date(now)
+	var subs []models.DigestSubscription
+	err := db.Where("active = ? AND (last_sent_on IS NULL OR last_sent_on < ?)", true, today).Order("id").Find(&subs).Error
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	for _, sub := range subs {
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+		claimed := db.Model(&models.DigestSubscription{}).
+			Where("id = ? AND (last_sent_on IS NULL OR last_sent_on < ?)", sub.ID, today).
+			Update("last_sent_on", today)
+		if claimed.Error != nil {
+			return sent, claimed.Error
+		}
+		if claimed.RowsAffected == 0 {
+			continue
+		}
+		if err := Send(ctx, db, sender, cfg, sub, now, nil); err != nil {
+			errs = append(errs, fmt.Errorf("subscription %d: %w", sub.ID, err))
+			continue
+		}
+		sent++
+	}
+	return sent, errors.Join(errs...)
+}
+
+func envString(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,289 @@
+package draw
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"math"
+	mathrand "math/rand/v2"
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Algorithm 현재 추첨 알고리즘
+// 시드로 만든 ChaCha8(math/rand/v2) 스트림의 Uint64를 거부 표본추출해 [0, 가중치 합)의 정수 n을 뽑고,
+// Position 순서로 가중치를 누적해 n이 속한 후보를 당첨으로 함
+const Algorithm = "chacha8-weighted-v1"
+
+const (
+	// MaxCandidates 직접 고를 수 있는 최대 후보 수
+	MaxCandidates = 50
+	// MaxWeight 후보 하나의 최대 가중치
+	MaxWeight = 100
+	// DefaultRerollsPerDay 팀별 하루 다시 뽑기 기본 횟수 (첫 추첨 제외)
+	DefaultRerollsPerDay = 2
+)
+
+// maxAttempts 같은 순번으로 동시에 추첨해 충돌했을 때 다시 시도하는 횟수
+const maxAttempts = 3
+
+var (
+	// ErrNoCandidates 추첨할 맛집이 없음
+	ErrNoCandidates = errors.New("draw: no candidates")
+	// ErrTooManyCandidates 후보가 MaxCandidates를 넘음
+	ErrTooManyCandidates = errors.New("draw: too many candidates")
+	// ErrLimitReached 오늘 다시 뽑기 횟수를 모두 씀
+	ErrLimitReached = errors.New("draw: daily re-roll limit reached")
+	// ErrNotFound 추첨 기록이 없음
+	ErrNotFound = errors.New("draw: not found")
+)
+
+// RestaurantNotFoundError 후보로 고른 맛집이 없거나 삭제됨
+type RestaurantNotFoundError struct {
+	ID uint
+}
+
+func (e *RestaurantNotFoundError) Error() string {
+	return fmt.Sprintf("draw: restaurant %d not found", e.ID)
+}
+
+// Entry 후보 맛집과 가중치
+type Entry struct {
+	RestaurantID uint
+	Weight       int
+}
+
+// CreateInput 추첨 조건
+type CreateInput struct {
+	Team    string
+	DrawnBy string
+	// Entries 후보 (비어 있으면 등록된 모든 맛집을 가중치 1로)
+	Entries []Entry
+	// RerollsPerDay 첫 추첨 뒤 그날 더 뽑을 수 있는 횟수
+	RerollsPerDay int
+	// Seed 난수 시드 (nil이면 crypto/rand로 생성, 테스트에서 고정)
+	Seed []byte
+}
+
+// Create 오늘(Asia/Seoul) 팀의 추첨을 하고 시드·후보·가중치·결과를 함께 저장
+// 같은 팀이 그날 RerollsPerDay번을 넘게 다시 뽑으려 하면 ErrLimitReached
+func Create(db *gorm.DB, input CreateInput, now time.Time) (models.Draw, error) {
+	entries := uniqueEntries(input.Entries)
+	if len(entries) > MaxCandidates {
+		return models.Draw{}, ErrTooManyCandidates
+	}
+	team := input.Team
+	if team == "" {
+		team = models.DefaultTeam
+	}
+	seed := input.Seed
+	if seed == nil {
+		seed = NewSeed()
+	}
+
+	var draw models.Draw
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		draw, err = create(db, team, input.DrawnBy, entries, input.RerollsPerDay, seed, now)
+		// 다른 요청이 같은 순번을 먼저 저장함 → 순번을 다시 세서 재시도
+		if !errors.Is(err, gorm.ErrDuplicatedKey) {
+			break
+		}
+	}
+	return draw, err
+}
+
+func create(db *gorm.DB, team, drawnBy string, entries []Entry, rerolls int, seed []byte, now time.Time) (models.Draw, error) {
+	draw := models.Draw{
+		Team:      team,
+		Date:      kst.Date(now),
+		Seed:      hex.EncodeToString(seed),
+		Algorithm: Algorithm,
+		DrawnBy:   drawnBy,
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.Draw{}).Where("team = ? AND date = ?", draw.Team, draw.Date).Count(&count).Error; err != nil {
+			return err
+		}
+		if int(count) > max(rerolls, 0) {
+			return ErrLimitReached
+		}
+		draw.Sequence = int(count) + 1
+
+		candidates, err := loadCandidates(tx, entries)
+		if err != nil {
+			return err
+		}
+		weights := make([]int, len(candidates))
+		for i, candidate := range candidates {
+			weights[i] = candidate.Weight
+		}
+		draw.Candidates = candidates
+		draw.ResultPosition = Pick(seed, weights)
+		return tx.Create(&draw).Error
+	})
+	return draw, err
+}
+
+// loadCandidates 후보 맛집을 불러와 순서대로 Position 부여 (entries가 비면 모든 맛집을 가중치 1로)
+func loadCandidates(tx *gorm.DB, entries []Entry) ([]models.DrawCandidate, error) {
+	var restaurants []models.Restaurant
+	if len(entries) == 0 {
+		if err := tx.Order("id").Find(&restaurants).Error; err != nil {
+			return nil, err
+		}
+		for _, r := range restaurants {
+			entries = append(entries, Entry{RestaurantID: r.ID, Weight: 1})
+		}
+	} else {
+		ids := make([]uint, 0, len(entries))
+		for _, entry := range entries {
+			ids = append(ids, entry.RestaurantID)
+		}
+		if err := tx.Where("id IN ?", ids).Find(&restaurants).Error; err != nil {
+			return nil, err
+		}
+	}
+	if len(entries) == 0 {
+		return nil, ErrNoCandidates
+	}
+
+	byID := make(map[uint]models.Restaurant, len(restaurants))
+	for _, r := range restaurants {
+		byID[r.ID] = r
+	}
+	candidates := make([]models.DrawCandidate, 0, len(entries))
+	for i, entry := range entries {
+		r, ok := byID[entry.RestaurantID]
+		if !ok {
+			return nil, &RestaurantNotFoundError{ID: entry.RestaurantID}
+		}
+		candidates = append(candidates, models.DrawCandidate{
+			Position:           i,
+			RestaurantID:       r.ID,
+			RestaurantSnapshot: r.Snapshot(),
+			Weight:             entry.Weight,
+		})
+	}
+	return candidates, nil
+}
+
+// NewSeed 추첨용 32바이트 난수 시드
+func NewSeed() []byte {
+	seed := make([]byte, 32)
+	rand.Read(seed)
+	return seed
+}
+
+// Pick seed로 weights 중 하나를 가중 추첨해 그 인덱스 반환 (Algorithm 참고)
+// 같은 seed와 weights면 항상 같은 결과. weights는 모두 양수여야 함
+func Pick(seed []byte, weights []int) int {
+	var key [32]byte
+	copy(key[:], seed)
+	source := mathrand.NewChaCha8(key)
+
+	total := uint64(0)
+	for _, weight := range weights {
+		total += uint64(weight)
+	}
+	// 2^64가 total로 나누어떨어지지 않아 생기는 편향을 없애기 위해 마지막 구간은 버리고 다시 뽑음
+	limit := math.MaxUint64 - math.MaxUint64%total
+	value := source.Uint64()
+	for value >= limit {
+		value = source.Uint64()
+	}
+	n := value % total
+
+	for i, weight := range weights {
+		if n < uint64(weight) {
+			return i
+		}
+		n -= uint64(weight)
+	}
+	return len(weights) - 1
+}
+
+// Verification 저장된 추첨을 다시 계산한 결과
+type Verification struct {
+	// Verified 다시 계산한 당첨 후보가 저장된 결과와 같음
+	Verified bool
+	// ExpectedPosition 다시 계산한 당첨 후보의 Position (검증할 수 없으면 -1)
+	ExpectedPosition int
+}
+
+// Verify 저장된 시드·후보·가중치로 추첨을 다시 계산해 저장된 결과와 비교
+// 알 수 없는 알고리즘이거나 시드·가중치가 손상됐으면 Verified=false
+func Verify(draw models.Draw) Verification {
+	failed := Verification{ExpectedPosition: -1}
+	seed, err := hex.DecodeString(draw.Seed)
+	if draw.Algorithm != Algorithm || err != nil || len(seed) != 32 || len(draw.Candidates) == 0 {
+		return failed
+	}
+	weights := make([]int, len(draw.Candidates))
+	for i, candidate := range draw.Candidates {
+		if candidate.Position != i || candidate.Weight <= 0 {
+			return failed
+		}
+		weights[i] = candidate.Weight
+	}
+	expected := Pick(seed, weights)
+	return Verification{Verified: expected == draw.ResultPosition, ExpectedPosition: expected}
+}
+
+// Result 당첨 후보 (Candidates를 불러오지 않았으면 false)
+func Result(draw models.Draw) (models.DrawCandidate, bool) {
+	for _, candidate := range draw.Candidates {
+		if candidate.Position == draw.ResultPosition {
+			return candidate, true
+		}
+	}
+	return models.DrawCandidate{}, false
+}
+
+// RemainingRerolls 이 추첨 뒤 그날 남은 다시 뽑기 횟수
+func RemainingRerolls(draw models.Draw, rerollsPerDay int) int {
+	return max(rerollsPerDay+1-draw.Sequence, 0)
+}
+
+// WithCandidates 후보를 Position 순서로 함께 불러오는 scope
+func WithCandidates(tx *gorm.DB) *gorm.DB {
+	return tx.Preload("Candidates", func(tx *gorm.DB) *gorm.DB { return tx.Order("position") })
+}
+
+// Get 후보를 포함해 추첨 기록 조회
+func Get(db *gorm.DB, id uint) (models.Draw, error) {
+	var draw models.Draw
+	err := db.Scopes(WithCandidates).First(&draw, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return draw, ErrNotFound
+	}
+	return draw, err
+}
+
+// RerollsFromEnv DRAW_REROLLS_PER_DAY 환경변수의 팀별 하루 다시 뽑기 횟수 (기본 2, 0이면 하루 한 번만 추첨)
+func RerollsFromEnv() int {
+	if n, err := strconv.Atoi(os.Getenv("DRAW_REROLLS_PER_DAY")); err == nil && n >= 0 {
+		return n
+	}
+	return DefaultRerollsPerDay
+}
+
+// uniqueEntries 같은 맛집이 여러 번 있으면 처음 것만 남김
+func uniqueEntries(entries []Entry) []Entry {
+	seen := make(map[uint]bool, len(entries))
+	unique := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		if !seen[entry.RestaurantID] {
+			seen[entry.RestaurantID] = true
+			unique = append(unique, entry)
+		}
+	}
+	return unique
+}
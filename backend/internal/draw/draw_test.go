@@ -0,0 +1,158 @@
+package draw
+
+import (
+	"bytes"
+	"lunch_app/backend/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// 2024-06-30 11:00 KST
+var fixedNow = time.Date(2024, 6, 30, 2, 0, 0, 0, time.UTC)
+
+func setupDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{TranslateError: true})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Restaurant{}, &models.Draw{}, &models.DrawCandidate{}))
+	return db
+}
+
+func createRestaurants(t *testing.T, db *gorm.DB, names ...string) []models.Restaurant {
+	restaurants := make([]models.Restaurant, 0, len(names))
+	for _, name := range names {
+		restaurants = append(restaurants, models.Restaurant{Name: name, Address: "서울시 " + name})
+	}
+	require.NoError(t, db.Create(&restaurants).Error)
+	return restaurants
+}
+
+func TestPick_Reproducible(t *testing.T) {
+	seed := bytes.Repeat([]byte{7}, 32)
+	weights := []int{1, 5, 2}
+	first := Pick(seed, weights)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, first, Pick(seed, weights), "같은 시드와 가중치면 항상 같은 결과")
+	}
+
+	counts := make([]int, len(weights))
+	for i := 0; i < 4000; i++ {
+		counts[Pick(NewSeed(), weights)]++
+	}
+	// 기대값 500 / 2500 / 1000
+	assert.InDelta(t, 500, counts[0], 150)
+	assert.InDelta(t, 2500, counts[1], 200)
+	assert.InDelta(t, 1000, counts[2], 150)
+
+	assert.Equal(t, 0, Pick(NewSeed(), []int{3}))
+}
+
+func TestCreate_RecordsAndVerifies(t *testing.T) {
+	db := setupDB(t)
+	restaurants := createRestaurants(t, db, "국밥집", "초밥집", "버거집")
+	seed := bytes.Repeat([]byte{1}, 32)
+
+	draw, err := Create(db, CreateInput{
+		Team:    "alpha",
+		DrawnBy: "민수",
+		Entries: []Entry{
+			{RestaurantID: restaurants[2].ID, Weight: 3},
+			{RestaurantID: restaurants[0].ID, Weight: 1},
+			{RestaurantID: restaurants[2].ID, Weight: 9},
+		},
+		RerollsPerDay: 2,
+		Seed:          seed,
+	}, fixedNow)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, draw.Sequence)
+	assert.Equal(t, "2024-06-30", draw.Date.Format("2006-01-02"))
+	assert.Equal(t, Algorithm, draw.Algorithm)
+	assert.Len(t, draw.Seed, 64)
+	require.Len(t, draw.Candidates, 2, "중복 맛집은 처음 것만")
+	assert.Equal(t, "버거집", draw.Candidates[0].RestaurantSnapshot.Name)
+	assert.Equal(t, 3, draw.Candidates[0].Weight)
+	assert.Equal(t, Pick(seed, []int{3, 1}), draw.ResultPosition)
+	assert.Equal(t, 2, RemainingRerolls(draw, 2))
+
+	// 맛집이 나중에 바뀌어도 저장된 기록으로 검증
+	require.NoError(t, db.Model(&restaurants[2]).Update("name", "수제버거집").Error)
+	found, err := Get(db, draw.ID)
+	require.NoError(t, err)
+	result, ok := Result(found)
+	require.True(t, ok)
+	assert.Equal(t, draw.Candidates[draw.ResultPosition].RestaurantSnapshot.Name, result.RestaurantSnapshot.Name)
+	assert.Equal(t, Verification{Verified: true, ExpectedPosition: draw.ResultPosition}, Verify(found))
+
+	// 기록이 조작되면 검증 실패
+	tampered := found
+	tampered.ResultPosition = 1 - found.ResultPosition
+	assert.False(t, Verify(tampered).Verified)
+	tampered = found
+	tampered.Algorithm = "math-random"
+	assert.Equal(t, -1, Verify(tampered).ExpectedPosition)
+
+	_, err = Get(db, 999)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCreate_AllRestaurantsAndErrors(t *testing.T) {
+	db := setupDB(t)
+
+	_, err := Create(db, CreateInput{RerollsPerDay: 1}, fixedNow)
+	assert.ErrorIs(t, err, ErrNoCandidates)
+
+	restaurants := createRestaurants(t, db, "국밥집", "초밥집")
+	draw, err := Create(db, CreateInput{RerollsPerDay: 1}, fixedNow)
+	require.NoError(t, err)
+	assert.Equal(t, models.DefaultTeam, draw.Team)
+	require.Len(t, draw.Candidates, 2)
+	assert.Equal(t, 1, draw.Candidates[1].Weight)
+
+	var notFound *RestaurantNotFoundError
+	_, err = Create(db, CreateInput{Entries: []Entry{{RestaurantID: restaurants[0].ID, Weight: 1}, {RestaurantID: 999, Weight: 1}}, RerollsPerDay: 1}, fixedNow)
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, uint(999), notFound.ID)
+
+	entries := make([]Entry, MaxCandidates+1)
+	for i := range entries {
+		entries[i] = Entry{RestaurantID: uint(i + 1), Weight: 1}
+	}
+	_, err = Create(db, CreateInput{Entries: entries}, fixedNow)
+	assert.ErrorIs(t, err, ErrTooManyCandidates)
+}
+
+func TestCreate_DailyRerollLimit(t *testing.T) {
+	db := setupDB(t)
+	createRestaurants(t, db, "국밥집")
+
+	for i := 1; i <= 2; i++ {
+		draw, err := Create(db, CreateInput{Team: "alpha", RerollsPerDay: 1}, fixedNow)
+		require.NoError(t, err)
+		assert.Equal(t, i, draw.Sequence)
+		assert.Equal(t, 2-i, RemainingRerolls(draw, 1))
+	}
+	_, err := Create(db, CreateInput{Team: "alpha", RerollsPerDay: 1}, fixedNow)
+	assert.ErrorIs(t, err, ErrLimitReached)
+
+	// 다른 팀과 다음 날(Asia/Seoul 자정 이후)은 따로 셈
+	_, err = Create(db, CreateInput{Team: "beta", RerollsPerDay: 1}, fixedNow)
+	assert.NoError(t, err)
+	nextDay := time.Date(2024, 6, 30, 15, 0, 0, 0, time.UTC)
+	draw, err := Create(db, CreateInput{Team: "alpha", RerollsPerDay: 1}, nextDay)
+	require.NoError(t, err)
+	assert.Equal(t, 1, draw.Sequence)
+}
+
+func TestRerollsFromEnv(t *testing.T) {
+	t.Setenv("DRAW_REROLLS_PER_DAY", "")
+	assert.Equal(t, DefaultRerollsPerDay, RerollsFromEnv())
+	t.Setenv("DRAW_REROLLS_PER_DAY", "0")
+	assert.Equal(t, 0, RerollsFromEnv())
+	t.Setenv("DRAW_REROLLS_PER_DAY", "-3")
+	assert.Equal(t, DefaultRerollsPerDay, RerollsFromEnv())
+}
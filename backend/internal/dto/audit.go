@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"encoding/json"
+	"lunch_app/backend/internal/models"
+	"time"
+)
+
+// AuditLogResponse 감사 로그 응답
+// before/after는 변경 전후 행 전체, changes는 값이 달라진 컬럼만 ({"컬럼": {"before", "after"}})
+type AuditLogResponse struct {
+	ID         uint            `json:"id"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entityType"`
+	EntityID   uint            `json:"entityId"`
+	Actor      string          `json:"actor"`
+	RemoteIP   string          `json:"remoteIp,omitempty"`
+	RequestID  string          `json:"requestId,omitempty"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	Changes    json.RawMessage `json:"changes"`
+}
+
+// NewAuditLogResponses 감사 로그 목록을 응답으로 변환
+func NewAuditLogResponses(logs []models.AuditLog) []AuditLogResponse {
+	responses := make([]AuditLogResponse, 0, len(logs))
+	for _, l := range logs {
+		responses = append(responses, AuditLogResponse{
+			ID:         l.ID,
+			CreatedAt:  l.CreatedAt,
+			Action:     l.Action,
+			EntityType: l.EntityType,
+			EntityID:   l.EntityID,
+			Actor:      l.Actor,
+			RemoteIP:   l.RemoteIP,
+			RequestID:  l.RequestID,
+			Before:     rawJSON(l.Before),
+			After:      rawJSON(l.After),
+			Changes:    rawJSON(l.Changes),
+		})
+	}
+	return responses
+}
+
+// rawJSON 저장된 JSON 문자열을 그대로 내보냄 (비어 있으면 null)
+func rawJSON(value string) json.RawMessage {
+	if value == "" {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(value)
+}
@@ -0,0 +1,51 @@
+package dto
+
+import "lunch_app/backend/internal/dedupe"
+
+// DuplicateCandidateResponse 중복으로 의심되는 맛집 쌍
+// restaurant가 먼저 등록된 쪽이며 병합 시 남길 맛집으로 권장
+type DuplicateCandidateResponse struct {
+	Restaurant     RestaurantResponse `json:"restaurant"`
+	Duplicate      RestaurantResponse `json:"duplicate"`
+	DistanceMeters float64            `json:"distanceMeters"`
+	NameSimilarity float64            `json:"nameSimilarity"`
+}
+
+// NewDuplicateCandidateResponses 중복 후보 목록을 응답으로 변환
+func NewDuplicateCandidateResponses(candidates []dedupe.Candidate) []DuplicateCandidateResponse {
+	responses := make([]DuplicateCandidateResponse, 0, len(candidates))
+	for _, c := range candidates {
+		responses = append(responses, DuplicateCandidateResponse{
+			Restaurant:     NewRestaurantResponse(c.Restaurant),
+			Duplicate:      NewRestaurantResponse(c.Duplicate),
+			DistanceMeters: c.DistanceMeters,
+			NameSimilarity: c.NameSimilarity,
+		})
+	}
+	return responses
+}
+
+// MergeRestaurantsRequest 맛집 병합 요청 (경로의 맛집으로 duplicateIds를 합침)
+type MergeRestaurantsRequest struct {
+	DuplicateIDs []uint `json:"duplicateIds" binding:"required,min=1,dive,gt=0"`
+}
+
+// MergeRestaurantsResponse 맛집 병합 결과
+type MergeRestaurantsResponse struct {
+	Restaurant     RestaurantResponse `json:"restaurant"`
+	MergedIDs      []uint             `json:"mergedIds"`
+	MovedVisits    int64              `json:"movedVisits"`
+	MovedReviews   int64              `json:"movedReviews"`
+	MovedBookmarks int64              `json:"movedBookmarks"`
+}
+
+// NewMergeRestaurantsResponse 병합 결과를 응답으로 변환
+func NewMergeRestaurantsResponse(result dedupe.MergeResult) MergeRestaurantsResponse {
+	return MergeRestaurantsResponse{
+		Restaurant:     NewRestaurantResponse(result.Restaurant),
+		MergedIDs:      result.MergedIDs,
+		MovedVisits:    result.MovedVisits,
+		MovedReviews:   result.MovedReviews,
+		MovedBookmarks: result.MovedBookmarks,
+	}
+}
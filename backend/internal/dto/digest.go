@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"time"
+)
+
+// SubscribeDigestRequest 점심 다이제스트 구독 등록 요청
+// 같은 이메일이 이미 있으면 이름·팀을 바꾸고 구독을 다시 켬
+type SubscribeDigestRequest struct {
+	Email string `json:"email" binding:"required,email,max=254"`
+	// Name 인사말과 "오랜만에 가 볼까요?" 계산에 쓰는 방문자 이름
+	Name string `json:"name" binding:"max=64"`
+	// Team 진행 중인 점심 투표를 보여 줄 팀
+	Team string `json:"team" binding:"max=64"`
+}
+
+// DigestSubscriptionResponse 다이제스트 구독 (해지 토큰 제외)
+type DigestSubscriptionResponse struct {
+	ID     uint   `json:"id"`
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	Team   string `json:"team"`
+	Active bool   `json:"active"`
+	// LastSentOn 마지막으로 보낸 점심 날짜 (Asia/Seoul, YYYY-MM-DD, 보낸 적 없으면 null)
+	LastSentOn *string   `json:"lastSentOn"`
+	LastError  string    `json:"lastError,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// NewDigestSubscriptionResponse 구독을 응답으로 변환
+func NewDigestSubscriptionResponse(s models.DigestSubscription) DigestSubscriptionResponse {
+	response := DigestSubscriptionResponse{
+		ID:        s.ID,
+		Email:     s.Email,
+		Name:      s.Name,
+		Team:      s.Team,
+		Active:    s.Active,
+		LastError: s.LastError,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+	if s.LastSentOn != nil {
+		date := s.LastSentOn.In(kst.Location()).Format("2006-01-02")
+		response.LastSentOn = &date
+	}
+	return response
+}
+
+// NewDigestSubscriptionResponses 구독 목록을 응답으로 변환
+func NewDigestSubscriptionResponses(subs []models.DigestSubscription) []DigestSubscriptionResponse {
+	responses := make([]DigestSubscriptionResponse, 0, len(subs))
+	for _, s := range subs {
+		responses = append(responses, NewDigestSubscriptionResponse(s))
+	}
+	return responses
+}
+
+// SendDigestResponse 다이제스트 수동 발송 결과
+type SendDigestResponse struct {
+	To      string    `json:"to"`
+	Subject string    `json:"subject"`
+	SentAt  time.Time `json:"sentAt"`
+}
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"lunch_app/backend/internal/routing"
+	"math"
+)
+
+// LatLng 위경도 좌표
+type LatLng struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// DirectionsResponse 경로 계산 결과
+type DirectionsResponse struct {
+	Mode            string  `json:"mode"`
+	DistanceMeters  float64 `json:"distanceMeters"`
+	DurationSeconds float64 `json:"durationSeconds"`
+	// DurationMinutes 화면 표시용 소요 시간 (반올림, 최소 1분)
+	DurationMinutes int `json:"durationMinutes"`
+	// Source osrm(실제 도로 경로) 또는 estimate(직선 거리 추정)
+	Source   string   `json:"source"`
+	Geometry []LatLng `json:"geometry"`
+}
+
+// NewDirectionsResponse 경로를 응답으로 변환
+func NewDirectionsResponse(route routing.Route) DirectionsResponse {
+	geometry := make([]LatLng, 0, len(route.Geometry))
+	for _, p := range route.Geometry {
+		geometry = append(geometry, LatLng{Latitude: p.Latitude, Longitude: p.Longitude})
+	}
+	return DirectionsResponse{
+		Mode:            string(route.Mode),
+		DistanceMeters:  route.DistanceMeters,
+		DurationSeconds: route.DurationSeconds,
+		DurationMinutes: int(math.Max(1, math.Round(route.DurationSeconds/60))),
+		Source:          route.Source,
+		Geometry:        geometry,
+	}
+}
@@ -0,0 +1,123 @@
+package dto
+
+import (
+	"lunch_app/backend/internal/draw"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"time"
+)
+
+// DrawEntryRequest 추첨 후보 맛집과 가중치 (weight를 생략하면 1)
+type DrawEntryRequest struct {
+	RestaurantID uint `json:"restaurantId" binding:"required,gt=0"`
+	Weight       int  `json:"weight" binding:"min=0,max=100"`
+}
+
+// CreateDrawRequest 점심 룰렛 추첨 요청
+// candidates가 비어 있으면 등록된 모든 맛집을 같은 가중치로 추첨
+type CreateDrawRequest struct {
+	Team       string             `json:"team" binding:"max=64"`
+	Candidates []DrawEntryRequest `json:"candidates" binding:"max=50,dive"`
+}
+
+// DrawCandidateResponse 추첨 후보와 가중치
+type DrawCandidateResponse struct {
+	Position     int     `json:"position"`
+	RestaurantID uint    `json:"restaurantId"`
+	Name         string  `json:"name"`
+	Address      string  `json:"address"`
+	Category     string  `json:"category"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+	Weight       int     `json:"weight"`
+}
+
+// DrawResponse 점심 룰렛 추첨 기록
+type DrawResponse struct {
+	ID   uint   `json:"id"`
+	Team string `json:"team"`
+	// Date 점심 날짜 (Asia/Seoul, YYYY-MM-DD)
+	Date string `json:"date"`
+	// Sequence 그날 팀의 몇 번째 추첨인지 (2부터 다시 뽑기)
+	Sequence   int                     `json:"sequence"`
+	DrawnBy    string                  `json:"drawnBy"`
+	Seed       string                  `json:"seed"`
+	Algorithm  string                  `json:"algorithm"`
+	Result     DrawCandidateResponse   `json:"result"`
+	Candidates []DrawCandidateResponse `json:"candidates"`
+	CreatedAt  time.Time               `json:"createdAt"`
+}
+
+// NewDrawResponse 추첨 기록을 응답으로 변환
+func NewDrawResponse(d models.Draw) DrawResponse {
+	candidates := make([]DrawCandidateResponse, 0, len(d.Candidates))
+	for _, candidate := range d.Candidates {
+		candidates = append(candidates, newDrawCandidateResponse(candidate))
+	}
+	result, _ := draw.Result(d)
+	return DrawResponse{
+		ID:         d.ID,
+		Team:       d.Team,
+		Date:       d.Date.In(kst.Location()).Format("2006-01-02"),
+		Sequence:   d.Sequence,
+		DrawnBy:    d.DrawnBy,
+		Seed:       d.Seed,
+		Algorithm:  d.Algorithm,
+		Result:     newDrawCandidateResponse(result),
+		Candidates: candidates,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+// NewDrawResponses 추첨 기록 목록을 응답으로 변환
+func NewDrawResponses(draws []models.Draw) []DrawResponse {
+	responses := make([]DrawResponse, 0, len(draws))
+	for _, d := range draws {
+		responses = append(responses, NewDrawResponse(d))
+	}
+	return responses
+}
+
+func newDrawCandidateResponse(c models.DrawCandidate) DrawCandidateResponse {
+	return DrawCandidateResponse{
+		Position:     c.Position,
+		RestaurantID: c.RestaurantID,
+		Name:         c.RestaurantSnapshot.Name,
+		Address:      c.RestaurantSnapshot.Address,
+		Category:     c.RestaurantSnapshot.Category,
+		Latitude:     c.RestaurantSnapshot.Latitude,
+		Longitude:    c.RestaurantSnapshot.Longitude,
+		Weight:       c.Weight,
+	}
+}
+
+// CreateDrawResponse 추첨 결과와 그날 남은 다시 뽑기 횟수
+type CreateDrawResponse struct {
+	DrawResponse
+	RemainingRerolls int `json:"remainingRerolls"`
+}
+
+// DrawVerificationResponse 추첨 검증 결과
+type DrawVerificationResponse struct {
+	ID        uint   `json:"id"`
+	Algorithm string `json:"algorithm"`
+	Seed      string `json:"seed"`
+	// Verified 저장된 시드·후보·가중치로 다시 계산한 결과가 기록된 결과와 같음
+	Verified bool `json:"verified"`
+	// RecordedPosition 기록된 당첨 후보의 position
+	RecordedPosition int `json:"recordedPosition"`
+	// ExpectedPosition 다시 계산한 당첨 후보의 position (검증할 수 없으면 -1)
+	ExpectedPosition int `json:"expectedPosition"`
+}
+
+// NewDrawVerificationResponse 검증 결과를 응답으로 변환
+func NewDrawVerificationResponse(d models.Draw, v draw.Verification) DrawVerificationResponse {
+	return DrawVerificationResponse{
+		ID:               d.ID,
+		Algorithm:        d.Algorithm,
+		Seed:             d.Seed,
+		Verified:         v.Verified,
+		RecordedPosition: d.ResultPosition,
+		ExpectedPosition: v.ExpectedPosition,
+	}
+}
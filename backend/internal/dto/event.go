@@ -0,0 +1,6 @@
+package dto
+
+// DeletedEvent 삭제 이벤트 데이터 (삭제된 항목 ID)
+type DeletedEvent struct {
+	ID uint `json:"id"`
+}
@@ -0,0 +1,111 @@
+package dto
+
+import (
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+)
+
+// OpeningHoursDay 요일 하나의 영업시간 ("HH:MM", Asia/Seoul)
+type OpeningHoursDay struct {
+	// Weekday 요일 (0 일요일 ~ 6 토요일)
+	Weekday int `json:"weekday"`
+	// Opens, Closes 영업 시작·종료 (closes가 opens보다 이르면 다음 날 새벽까지, 자정 마감은 24:00)
+	Opens  string `json:"opens"`
+	Closes string `json:"closes"`
+	// BreakStart, BreakEnd 브레이크타임 (없으면 생략)
+	BreakStart string `json:"breakStart,omitempty"`
+	BreakEnd   string `json:"breakEnd,omitempty"`
+}
+
+// UpdateOpeningHoursRequest 요일별 영업시간 전체 교체 요청 (빠진 요일은 정기 휴무, 빈 목록이면 영업시간 삭제)
+type UpdateOpeningHoursRequest struct {
+	Weekly []OpeningHoursDay `json:"weekly"`
+}
+
+// ToModels 요청을 저장용 모델로 변환
+func (r UpdateOpeningHoursRequest) ToModels() []models.OpeningHours {
+	weekly := make([]models.OpeningHours, 0, len(r.Weekly))
+	for _, d := range r.Weekly {
+		weekly = append(weekly, models.OpeningHours{
+			Weekday:    d.Weekday,
+			Opens:      d.Opens,
+			Closes:     d.Closes,
+			BreakStart: d.BreakStart,
+			BreakEnd:   d.BreakEnd,
+		})
+	}
+	return weekly
+}
+
+// HolidayRequest 날짜별 영업시간 예외 요청 (closed면 하루 종일 휴무, 아니면 opens~closes만 영업)
+type HolidayRequest struct {
+	Closed     bool   `json:"closed"`
+	Opens      string `json:"opens,omitempty"`
+	Closes     string `json:"closes,omitempty"`
+	BreakStart string `json:"breakStart,omitempty"`
+	BreakEnd   string `json:"breakEnd,omitempty"`
+	Note       string `json:"note,omitempty" binding:"max=128"`
+}
+
+// HolidayResponse 날짜별 영업시간 예외
+type HolidayResponse struct {
+	// Date 날짜 (YYYY-MM-DD, Asia/Seoul)
+	Date       string `json:"date"`
+	Closed     bool   `json:"closed"`
+	Opens      string `json:"opens,omitempty"`
+	Closes     string `json:"closes,omitempty"`
+	BreakStart string `json:"breakStart,omitempty"`
+	BreakEnd   string `json:"breakEnd,omitempty"`
+	Note       string `json:"note,omitempty"`
+}
+
+// NewHolidayResponse 모델을 응답으로 변환
+func NewHolidayResponse(h models.RestaurantHoliday) HolidayResponse {
+	return HolidayResponse{
+		Date:       h.Date.In(kst.Location()).Format(kst.DateLayout),
+		Closed:     h.Closed,
+		Opens:      h.Opens,
+		Closes:     h.Closes,
+		BreakStart: h.BreakStart,
+		BreakEnd:   h.BreakEnd,
+		Note:       h.Note,
+	}
+}
+
+// OpeningHoursResponse 맛집의 요일별 영업시간과 날짜별 예외
+type OpeningHoursResponse struct {
+	RestaurantID uint `json:"restaurantId"`
+	// Timezone 시각의 기준 시간대 (Asia/Seoul)
+	Timezone string            `json:"timezone"`
+	Weekly   []OpeningHoursDay `json:"weekly"`
+	Holidays []HolidayResponse `json:"holidays"`
+}
+
+// NewOpeningHoursResponse 영업시간 모델을 응답으로 변환
+func NewOpeningHoursResponse(restaurantID uint, weekly []models.OpeningHours, holidays []models.RestaurantHoliday) OpeningHoursResponse {
+	response := OpeningHoursResponse{
+		RestaurantID: restaurantID,
+		Timezone:     kst.Location().String(),
+		Weekly:       make([]OpeningHoursDay, 0, len(weekly)),
+		Holidays:     make([]HolidayResponse, 0, len(holidays)),
+	}
+	for _, w := range weekly {
+		response.Weekly = append(response.Weekly, OpeningHoursDay{
+			Weekday:    w.Weekday,
+			Opens:      w.Opens,
+			Closes:     w.Closes,
+			BreakStart: w.BreakStart,
+			BreakEnd:   w.BreakEnd,
+		})
+	}
+	for _, h := range holidays {
+		response.Holidays = append(response.Holidays, NewHolidayResponse(h))
+	}
+	return response
+}
+
+// NearbyRestaurantResponse 기준 위치 근처의 맛집과 거리
+type NearbyRestaurantResponse struct {
+	Restaurant     RestaurantResponse `json:"restaurant"`
+	DistanceMeters float64            `json:"distanceMeters"`
+}
@@ -0,0 +1,99 @@
+package dto
+
+import (
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/scheduler"
+	"time"
+)
+
+// JobResponse 예약 작업과 현재 상태
+type JobResponse struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Schedule cron 표현식 (예약 실행하지 않으면 "off")
+	Schedule string `json:"schedule"`
+	// Timezone cron 표현식을 해석하는 시간대
+	Timezone string `json:"timezone"`
+	Enabled  bool   `json:"enabled"`
+	// NextRunAt 다음 예약 실행 시각 (예약 실행하지 않으면 null)
+	NextRunAt *time.Time `json:"nextRunAt"`
+	Running   bool       `json:"running"`
+	// RunningOn 실행 중인 인스턴스
+	RunningOn string          `json:"runningOn,omitempty"`
+	LastRun   *JobRunResponse `json:"lastRun"`
+}
+
+// NewJobResponse 작업 상태를 응답으로 변환
+func NewJobResponse(s scheduler.Status, timezone string) JobResponse {
+	response := JobResponse{
+		Name:        s.Job.Name,
+		Description: s.Job.Description,
+		Schedule:    s.Job.Spec,
+		Timezone:    timezone,
+		Enabled:     s.Job.Enabled(),
+		NextRunAt:   s.NextRunAt,
+		Running:     s.RunningOn != "",
+		RunningOn:   s.RunningOn,
+	}
+	if s.LastRun != nil {
+		run := NewJobRunResponse(*s.LastRun)
+		response.LastRun = &run
+	}
+	return response
+}
+
+// NewJobResponses 작업 상태 목록을 응답으로 변환
+func NewJobResponses(statuses []scheduler.Status, timezone string) []JobResponse {
+	responses := make([]JobResponse, 0, len(statuses))
+	for _, s := range statuses {
+		responses = append(responses, NewJobResponse(s, timezone))
+	}
+	return responses
+}
+
+// JobRunResponse 예약 작업 실행 기록
+type JobRunResponse struct {
+	ID  uint   `json:"id"`
+	Job string `json:"job"`
+	// Trigger schedule(예약 실행) 또는 manual(관리자 API)
+	Trigger string `json:"trigger"`
+	// Status running, succeeded 또는 failed
+	Status string `json:"status"`
+	// Instance 작업을 실행한 서버 인스턴스
+	Instance   string     `json:"instance"`
+	Summary    string     `json:"summary,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt"`
+	// DurationMs 실행 시간 (밀리초, 실행 중이면 null)
+	DurationMs *int64 `json:"durationMs"`
+}
+
+// NewJobRunResponse 실행 기록을 응답으로 변환
+func NewJobRunResponse(r models.JobRun) JobRunResponse {
+	response := JobRunResponse{
+		ID:         r.ID,
+		Job:        r.Job,
+		Trigger:    r.Trigger,
+		Status:     r.Status,
+		Instance:   r.Owner,
+		Summary:    r.Summary,
+		Error:      r.Error,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+	}
+	if r.FinishedAt != nil {
+		duration := r.FinishedAt.Sub(r.StartedAt).Milliseconds()
+		response.DurationMs = &duration
+	}
+	return response
+}
+
+// NewJobRunResponses 실행 기록 목록을 응답으로 변환
+func NewJobRunResponses(runs []models.JobRun) []JobRunResponse {
+	responses := make([]JobRunResponse, 0, len(runs))
+	for _, r := range runs {
+		responses = append(responses, NewJobRunResponse(r))
+	}
+	return responses
+}
@@ -0,0 +1,15 @@
+package dto
+
+// ListResponse v2 목록 응답 (빈 목록도 null이 아닌 []로 직렬화)
+type ListResponse[T any] struct {
+	Items []T `json:"items"`
+	Total int `json:"total"`
+}
+
+// NewListResponse 목록 응답 생성
+func NewListResponse[T any](items []T) ListResponse[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return ListResponse[T]{Items: items, Total: len(items)}
+}
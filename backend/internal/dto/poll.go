@@ -0,0 +1,117 @@
+package dto
+
+import (
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/poll"
+	"time"
+)
+
+// CreatePollRequest 점심 투표 생성 요청
+// restaurantIds로 직접 고른 후보 뒤에 autoSeed개만큼 추천 후보가 추가됨
+type CreatePollRequest struct {
+	Team          string    `json:"team" binding:"max=64"`
+	Title         string    `json:"title" binding:"max=100"`
+	Deadline      time.Time `json:"deadline" binding:"required"`
+	RestaurantIDs []uint    `json:"restaurantIds" binding:"max=10,dive,gt=0"`
+	AutoSeed      int       `json:"autoSeed" binding:"min=0,max=10"`
+}
+
+// VoteRequest 투표 요청 (voter가 없으면 X-Actor 헤더 사용)
+type VoteRequest struct {
+	CandidateID uint   `json:"candidateId" binding:"required,gt=0"`
+	Voter       string `json:"voter" binding:"max=64"`
+}
+
+// PollCandidateResponse 후보 맛집과 득표
+type PollCandidateResponse struct {
+	ID           uint     `json:"id"`
+	RestaurantID uint     `json:"restaurantId"`
+	Name         string   `json:"name"`
+	Address      string   `json:"address"`
+	Category     string   `json:"category"`
+	Latitude     float64  `json:"latitude"`
+	Longitude    float64  `json:"longitude"`
+	Source       string   `json:"source"`
+	Votes        int      `json:"votes"`
+	Voters       []string `json:"voters"`
+}
+
+// PollResponse 점심 투표
+type PollResponse struct {
+	ID    uint   `json:"id"`
+	Team  string `json:"team"`
+	Title string `json:"title"`
+	// Date 점심 날짜 (Asia/Seoul, YYYY-MM-DD)
+	Date              string                  `json:"date"`
+	Deadline          time.Time               `json:"deadline"`
+	Status            string                  `json:"status"`
+	CreatedBy         string                  `json:"createdBy"`
+	WinnerCandidateID *uint                   `json:"winnerCandidateId"`
+	ClosedAt          *time.Time              `json:"closedAt"`
+	TotalVotes        int                     `json:"totalVotes"`
+	Candidates        []PollCandidateResponse `json:"candidates"`
+	CreatedAt         time.Time               `json:"createdAt"`
+}
+
+// NewPollResponse 투표를 후보별 득표와 함께 응답으로 변환
+func NewPollResponse(p models.Poll) PollResponse {
+	tallies := poll.Results(p)
+	candidates := make([]PollCandidateResponse, 0, len(tallies))
+	total := 0
+	for _, tally := range tallies {
+		snapshot := tally.Candidate.RestaurantSnapshot
+		candidates = append(candidates, PollCandidateResponse{
+			ID:           tally.Candidate.ID,
+			RestaurantID: tally.Candidate.RestaurantID,
+			Name:         snapshot.Name,
+			Address:      snapshot.Address,
+			Category:     snapshot.Category,
+			Latitude:     snapshot.Latitude,
+			Longitude:    snapshot.Longitude,
+			Source:       tally.Candidate.Source,
+			Votes:        tally.Count(),
+			Voters:       tally.Voters,
+		})
+		total += tally.Count()
+	}
+	return PollResponse{
+		ID:                p.ID,
+		Team:              p.Team,
+		Title:             p.Title,
+		Date:              p.Date.In(kst.Location()).Format("2006-01-02"),
+		Deadline:          p.Deadline,
+		Status:            p.Status,
+		CreatedBy:         p.CreatedBy,
+		WinnerCandidateID: p.WinnerCandidateID,
+		ClosedAt:          p.ClosedAt,
+		TotalVotes:        total,
+		Candidates:        candidates,
+		CreatedAt:         p.CreatedAt,
+	}
+}
+
+// NewPollResponses 투표 목록을 응답으로 변환
+func NewPollResponses(polls []models.Poll) []PollResponse {
+	responses := make([]PollResponse, 0, len(polls))
+	for _, p := range polls {
+		responses = append(responses, NewPollResponse(p))
+	}
+	return responses
+}
+
+// ClosePollResponse 투표 마감 결과
+type ClosePollResponse struct {
+	Poll PollResponse `json:"poll"`
+	// VisitIDs 당선 맛집으로 기록된 투표자별 방문 기록 ID (표가 없으면 빈 배열)
+	VisitIDs []uint `json:"visitIds"`
+}
+
+// NewClosePollResponse 마감 결과를 응답으로 변환
+func NewClosePollResponse(result poll.CloseResult) ClosePollResponse {
+	ids := make([]uint, 0, len(result.Visits))
+	for _, visit := range result.Visits {
+		ids = append(ids, visit.ID)
+	}
+	return ClosePollResponse{Poll: NewPollResponse(result.Poll), VisitIDs: ids}
+}
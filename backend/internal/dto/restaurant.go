@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"lunch_app/backend/internal/models"
+	"time"
+)
+
+// CreateRestaurantRequest v2 맛집 등록 요청
+type CreateRestaurantRequest struct {
+	Name      string  `json:"name"`
+	Address   string  `json:"address"`
+	Phone     string  `json:"phone"`
+	Category  string  `json:"category"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// ExternalSource 장소 출처 (kakao, naver), ExternalID와 함께 보내면 같은 장소 중복 등록을 막음
+	ExternalSource string `json:"externalSource,omitempty"`
+	ExternalID     string `json:"externalId,omitempty"`
+}
+
+// ToModel 요청을 저장용 모델로 변환
+func (r CreateRestaurantRequest) ToModel() models.Restaurant {
+	return models.Restaurant{
+		Name:           r.Name,
+		Address:        r.Address,
+		Phone:          r.Phone,
+		Category:       r.Category,
+		Latitude:       r.Latitude,
+		Longitude:      r.Longitude,
+		ExternalSource: r.ExternalSource,
+		ExternalID:     r.ExternalID,
+	}
+}
+
+// RestaurantResponse v2 맛집 응답
+type RestaurantResponse struct {
+	ID        uint    `json:"id"`
+	Name      string  `json:"name"`
+	Address   string  `json:"address"`
+	Phone     string  `json:"phone"`
+	Category  string  `json:"category"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// ExternalSource, ExternalID 지도 서비스 장소 ID (연결되지 않았으면 생략)
+	ExternalSource string    `json:"externalSource,omitempty"`
+	ExternalID     string    `json:"externalId,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// NewRestaurantResponse 모델을 v2 응답으로 변환
+func NewRestaurantResponse(r models.Restaurant) RestaurantResponse {
+	return RestaurantResponse{
+		ID:             r.ID,
+		Name:           r.Name,
+		Address:        r.Address,
+		Phone:          r.Phone,
+		Category:       r.Category,
+		Latitude:       r.Latitude,
+		Longitude:      r.Longitude,
+		ExternalSource: r.ExternalSource,
+		ExternalID:     r.ExternalID,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
+
+// NewRestaurantResponses 모델 목록을 v2 응답 목록으로 변환
+func NewRestaurantResponses(restaurants []models.Restaurant) []RestaurantResponse {
+	responses := make([]RestaurantResponse, 0, len(restaurants))
+	for _, r := range restaurants {
+		responses = append(responses, NewRestaurantResponse(r))
+	}
+	return responses
+}
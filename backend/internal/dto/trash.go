@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"lunch_app/backend/internal/models"
+	"time"
+)
+
+// TrashedRestaurantResponse 휴지통 맛집 응답
+type TrashedRestaurantResponse struct {
+	RestaurantResponse
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// NewTrashedRestaurantResponses soft delete된 맛집 목록을 휴지통 응답으로 변환
+func NewTrashedRestaurantResponses(restaurants []models.Restaurant) []TrashedRestaurantResponse {
+	responses := make([]TrashedRestaurantResponse, 0, len(restaurants))
+	for _, r := range restaurants {
+		responses = append(responses, TrashedRestaurantResponse{
+			RestaurantResponse: NewRestaurantResponse(r),
+			DeletedAt:          r.DeletedAt.Time,
+		})
+	}
+	return responses
+}
+
+// RestoreRestaurantResponse 맛집 복원 결과
+type RestoreRestaurantResponse struct {
+	Restaurant     RestaurantResponse `json:"restaurant"`
+	RelinkedVisits int64              `json:"relinkedVisits"`
+}
+
+// PurgeResponse 휴지통 비우기 결과
+type PurgeResponse struct {
+	Purged int64     `json:"purged"`
+	Cutoff time.Time `json:"cutoff"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+// CreateVisitRequest v2 방문 기록 생성 요청
+type CreateVisitRequest struct {
+	RestaurantID uint      `json:"restaurantId" binding:"required"`
+	VisitedAt    time.Time `json:"visitedAt" binding:"required"`
+}
+
+// UpdateVisitRequest v2 방문 기록 수정 요청
+type UpdateVisitRequest struct {
+	VisitedAt time.Time `json:"visitedAt" binding:"required"`
+}
+
+// VisitRestaurant 방문 시점의 맛집 정보 (이후 삭제·수정되어도 유지)
+type VisitRestaurant struct {
+	ID        uint    `json:"id"`
+	Name      string  `json:"name"`
+	Address   string  `json:"address"`
+	Category  string  `json:"category"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	IsDeleted bool    `json:"isDeleted"`
+}
+
+// VisitResponse v2 방문 기록 응답 (목록, 생성, 수정 모두 같은 형식)
+type VisitResponse struct {
+	ID         uint            `json:"id"`
+	Restaurant VisitRestaurant `json:"restaurant"`
+	VisitedAt  time.Time       `json:"visitedAt"`
+	Date       string          `json:"date"`
+	Time       string          `json:"time"`
+	Visitor    string          `json:"visitor,omitempty"`
+	PollID     *uint           `json:"pollId,omitempty"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	UpdatedAt  time.Time       `json:"updatedAt"`
+}
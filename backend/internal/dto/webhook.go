@@ -0,0 +1,120 @@
+package dto
+
+import (
+	"encoding/json"
+	"lunch_app/backend/internal/models"
+	"time"
+)
+
+// CreateWebhookRequest 웹훅 등록 요청
+// secret을 생략하면 서버가 생성해 생성 응답에서 한 번만 보여 줌
+type CreateWebhookRequest struct {
+	Team   string   `json:"team" binding:"required,max=64"`
+	URL    string   `json:"url" binding:"required,url,max=2048"`
+	Events []string `json:"events" binding:"required,min=1,max=20"`
+	Secret string   `json:"secret" binding:"omitempty,min=16,max=128"`
+	// Active 생략하면 true
+	Active *bool `json:"active"`
+}
+
+// UpdateWebhookRequest 웹훅 수정 요청 (생략한 필드는 그대로 둠, 서명 키는 바꿀 수 없음)
+type UpdateWebhookRequest struct {
+	URL    *string  `json:"url" binding:"omitempty,url,max=2048"`
+	Events []string `json:"events" binding:"omitempty,min=1,max=20"`
+	Active *bool    `json:"active"`
+}
+
+// WebhookResponse 웹훅 (서명 키 제외)
+type WebhookResponse struct {
+	ID        uint      `json:"id"`
+	Team      string    `json:"team"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NewWebhookResponse 웹훅을 응답으로 변환
+func NewWebhookResponse(w models.Webhook) WebhookResponse {
+	return WebhookResponse{
+		ID:        w.ID,
+		Team:      w.Team,
+		URL:       w.URL,
+		Events:    w.Events(),
+		Active:    w.Active,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+// NewWebhookResponses 웹훅 목록을 응답으로 변환
+func NewWebhookResponses(webhooks []models.Webhook) []WebhookResponse {
+	responses := make([]WebhookResponse, 0, len(webhooks))
+	for _, w := range webhooks {
+		responses = append(responses, NewWebhookResponse(w))
+	}
+	return responses
+}
+
+// CreateWebhookResponse 등록한 웹훅과 서명 키
+type CreateWebhookResponse struct {
+	WebhookResponse
+	// Secret 요청 본문 서명 키 (이후 조회 응답에는 포함되지 않음)
+	Secret string `json:"secret"`
+}
+
+// WebhookDeliveryResponse 웹훅 배달 기록
+type WebhookDeliveryResponse struct {
+	ID        uint   `json:"id"`
+	WebhookID uint   `json:"webhookId"`
+	EventType string `json:"eventType"`
+	// Status pending, succeeded, failed
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+	// NextAttemptAt pending일 때 다음 시도 시각
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt"`
+	LastAttemptAt  *time.Time      `json:"lastAttemptAt"`
+	ResponseStatus int             `json:"responseStatus"`
+	LastError      string          `json:"lastError"`
+	DeliveredAt    *time.Time      `json:"deliveredAt"`
+	RedeliveryOf   *uint           `json:"redeliveryOf"`
+	Payload        json.RawMessage `json:"payload"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+// NewWebhookDeliveryResponse 배달 기록을 응답으로 변환
+func NewWebhookDeliveryResponse(d models.WebhookDelivery) WebhookDeliveryResponse {
+	var nextAttemptAt *time.Time
+	if d.Status == models.WebhookDeliveryPending {
+		nextAttemptAt = &d.NextAttemptAt
+	}
+	payload := json.RawMessage(d.Payload)
+	if !json.Valid(payload) {
+		payload = json.RawMessage("null")
+	}
+	return WebhookDeliveryResponse{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextAttemptAt:  nextAttemptAt,
+		LastAttemptAt:  d.LastAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		RedeliveryOf:   d.RedeliveryOf,
+		Payload:        payload,
+		CreatedAt:      d.CreatedAt,
+	}
+}
+
+// NewWebhookDeliveryResponses 배달 기록 목록을 응답으로 변환
+func NewWebhookDeliveryResponses(deliveries []models.WebhookDelivery) []WebhookDeliveryResponse {
+	responses := make([]WebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		responses = append(responses, NewWebhookDeliveryResponse(d))
+	}
+	return responses
+}
@@ -0,0 +1,222 @@
+// Package events 맛집·방문 기록·점심 투표 변경을 실시간으로 전달하는 프로세스 내 이벤트 버스
+//
+// 핸들러는 DB 커밋이 성공한 뒤에만 Publish하고, SSE 스트림은 Subscribe로 받습니다.
+// 최근 이벤트를 기록해 두어 재연결한 클라이언트가 Last-Event-ID 이후 이벤트를 다시 받을 수 있습니다.
+package events
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 이벤트 종류
+const (
+	RestaurantCreated  = "restaurant.created"
+	RestaurantDeleted  = "restaurant.deleted"
+	RestaurantRestored = "restaurant.restored"
+	RestaurantsMerged  = "restaurant.merged"
+	VisitCreated       = "visit.created"
+	VisitUpdated       = "visit.updated"
+	VisitDeleted       = "visit.deleted"
+	PollCreated        = "poll.created"
+	PollVoted          = "poll.voted"
+	PollClosed         = "poll.closed"
+	DrawCreated        = "draw.created"
+	// Reset 놓친 이벤트를 재전송할 수 없음 (클라이언트는 전체 데이터를 다시 조회)
+	Reset = "reset"
+)
+
+const (
+	// DefaultHistorySize 재연결 재전송용으로 보관하는 최근 이벤트 수
+	DefaultHistorySize = 512
+	// subscriberBuffer 구독자별 대기 이벤트 수 (넘치면 구독 종료 후 재연결에서 재전송)
+	subscriberBuffer = 64
+)
+
+// Event 버스로 전달되는 변경 이벤트
+type Event struct {
+	// ID 버스 안에서 1부터 단조 증가 (SSE id는 Bus.StreamID로 버스의 epoch를 붙임)
+	ID   uint64
+	Type string
+	// Team 이벤트가 속한 팀 (빈 문자열이면 모든 팀에 전달)
+	Team       string
+	Data       json.RawMessage
+	OccurredAt time.Time
+}
+
+// visibleTo team 구독자가 받을 이벤트인지 여부
+func (e Event) visibleTo(team string) bool {
+	return e.Team == "" || e.Team == team
+}
+
+// Bus 팀별 구독자에게 이벤트를 전달하는 버스 (동시 사용 가능)
+type Bus struct {
+	// epoch 버스(프로세스 기동)마다 다른 값, 재시작이나 다른 인스턴스의 이벤트 ID를 구별
+	epoch       string
+	mu          sync.Mutex
+	lastID      uint64
+	history     []Event
+	historySize int
+	subscribers map[*Subscription]struct{}
+}
+
+// NewBus historySize개의 최근 이벤트를 보관하는 버스 생성
+func NewBus(historySize int) *Bus {
+	if historySize <= 0 {
+		historySize = DefaultHistorySize
+	}
+	return &Bus{
+		epoch:       newEpoch(),
+		historySize: historySize,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// newEpoch 기동마다 다른 8자리 16진수
+func newEpoch() string {
+	b := make([]byte, 4)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// StreamID SSE id로 내보내는 이벤트 ID ("<epoch>-<ID>")
+func (b *Bus) StreamID(id uint64) string {
+	return b.epoch + "-" + strconv.FormatUint(id, 10)
+}
+
+// parseStreamID 이 버스가 만든 StreamID면 이벤트 ID 반환 (다른 epoch이거나 형식이 틀리면 false)
+func (b *Bus) parseStreamID(value string) (uint64, bool) {
+	epoch, seq, ok := strings.Cut(value, "-")
+	if !ok || epoch != b.epoch {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(seq, 10, 64)
+	return id, err == nil
+}
+
+// Publish data를 JSON으로 직렬화해 team 구독자에게 전달
+// 버퍼가 가득 찬 느린 구독자는 기다리지 않고 구독을 끊음
+func (b *Bus) Publish(team, eventType string, data any) (Event, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastID++
+	event := Event{ID: b.lastID, Type: eventType, Team: team, Data: payload, OccurredAt: time.Now()}
+	b.history = append(b.history, event)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+
+	for sub := range b.subscribers {
+		if !sub.all && !event.visibleTo(sub.team) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			b.removeLocked(sub)
+		}
+	}
+	return event, nil
+}
+
+// Replay 구독 시점에 재전송할 이벤트
+type Replay struct {
+	// Events Last-Event-ID 이후 놓친 이벤트 (Complete가 false면 비어 있음)
+	Events []Event
+	// Complete 놓친 이벤트를 모두 재전송할 수 있는지 여부
+	// 보관 범위를 벗어났거나, 서버 재시작 전이나 다른 인스턴스의 ID(다른 epoch)면 false (클라이언트는 전체 데이터를 다시 조회해야 함)
+	Complete bool
+	// LastID 구독 시점의 마지막 이벤트 ID
+	LastID uint64
+}
+
+// Subscribe team 이벤트 구독 (lastEventID가 StreamID면 그 이후 이벤트를 Replay로 반환, 빈 문자열이면 재전송 없음)
+// 재전송 이벤트와 이후 수신 이벤트 사이에 빠지거나 겹치는 이벤트가 없음
+func (b *Bus) Subscribe(team, lastEventID string) (*Subscription, Replay) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &Subscription{team: team, ch: make(chan Event, subscriberBuffer), bus: b}
+	if lastEventID == "" {
+		return b.subscribeLocked(sub, 0)
+	}
+	id, ok := b.parseStreamID(lastEventID)
+	if !ok {
+		b.subscribers[sub] = struct{}{}
+		return sub, Replay{LastID: b.lastID}
+	}
+	return b.subscribeLocked(sub, id)
+}
+
+// SubscribeAll 모든 팀의 이벤트 구독 (웹훅 발송처럼 서버 안에서 모든 이벤트를 처리하는 구독자용, lastEventID는 Event.ID)
+func (b *Bus) SubscribeAll(lastEventID uint64) (*Subscription, Replay) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.subscribeLocked(&Subscription{all: true, ch: make(chan Event, subscriberBuffer), bus: b}, lastEventID)
+}
+
+func (b *Bus) subscribeLocked(sub *Subscription, lastEventID uint64) (*Subscription, Replay) {
+	b.subscribers[sub] = struct{}{}
+
+	replay := Replay{Complete: true, LastID: b.lastID}
+	if lastEventID == 0 {
+		return sub, replay
+	}
+	replay.Complete = lastEventID <= b.lastID && (len(b.history) == 0 || lastEventID >= b.history[0].ID-1)
+	if !replay.Complete {
+		return sub, replay
+	}
+	for _, event := range b.history {
+		if event.ID > lastEventID && (sub.all || event.visibleTo(sub.team)) {
+			replay.Events = append(replay.Events, event)
+		}
+	}
+	return sub, replay
+}
+
+// Subscribers 현재 구독자 수
+func (b *Bus) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers)
+}
+
+func (b *Bus) removeLocked(sub *Subscription) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.ch)
+}
+
+// Subscription 한 구독자의 이벤트 수신
+type Subscription struct {
+	team string
+	// all 팀과 관계없이 모든 이벤트 수신
+	all bool
+	ch  chan Event
+	bus *Bus
+}
+
+// Events 수신 채널 (구독이 끊기면 닫힘)
+func (s *Subscription) Events() <-chan Event {
+	return s.ch
+}
+
+// Close 구독 해제 (여러 번 호출해도 안전)
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.bus.removeLocked(s)
+}
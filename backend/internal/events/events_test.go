@@ -0,0 +1,135 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receive(t *testing.T, sub *Subscription) Event {
+	t.Helper()
+	select {
+	case event, ok := <-sub.Events():
+		require.True(t, ok, "구독이 끊김")
+		return event
+	default:
+		t.Fatal("이벤트 없음")
+		return Event{}
+	}
+}
+
+func TestBus_TeamFilter(t *testing.T) {
+	bus := NewBus(10)
+	alpha, _ := bus.Subscribe("alpha", "")
+	beta, _ := bus.Subscribe("beta", "")
+	defer alpha.Close()
+	defer beta.Close()
+
+	_, err := bus.Publish("", RestaurantCreated, map[string]any{"id": 1})
+	require.NoError(t, err)
+	_, err = bus.Publish("alpha", PollCreated, map[string]any{"id": 7})
+	require.NoError(t, err)
+
+	event := receive(t, alpha)
+	assert.Equal(t, uint64(1), event.ID)
+	assert.Equal(t, RestaurantCreated, event.Type)
+	assert.JSONEq(t, `{"id":1}`, string(event.Data))
+	assert.Equal(t, PollCreated, receive(t, alpha).Type)
+
+	assert.Equal(t, RestaurantCreated, receive(t, beta).Type, "팀이 없는 이벤트는 모든 팀에 전달")
+	assert.Empty(t, beta.Events(), "다른 팀 투표 이벤트는 받지 않음")
+
+	_, err = bus.Publish("", VisitCreated, func() {})
+	assert.Error(t, err, "직렬화할 수 없는 데이터")
+}
+
+func TestBus_SubscribeAll(t *testing.T) {
+	bus := NewBus(10)
+	bus.Publish("alpha", PollCreated, 1)
+	all, replay := bus.SubscribeAll(0)
+	defer all.Close()
+	assert.Empty(t, replay.Events)
+
+	bus.Publish("beta", PollVoted, 2)
+	bus.Publish("", VisitCreated, 3)
+	assert.Equal(t, PollVoted, receive(t, all).Type, "다른 팀 이벤트도 수신")
+	assert.Equal(t, VisitCreated, receive(t, all).Type)
+
+	resumed, replay := bus.SubscribeAll(1)
+	defer resumed.Close()
+	require.True(t, replay.Complete)
+	require.Len(t, replay.Events, 2)
+	assert.Equal(t, "beta", replay.Events[0].Team)
+}
+
+func TestBus_Replay(t *testing.T) {
+	bus := NewBus(3)
+	for i := 0; i < 5; i++ {
+		_, err := bus.Publish("", VisitCreated, i)
+		require.NoError(t, err)
+	}
+
+	// 보관 중인 이벤트는 3, 4, 5
+	sub, replay := bus.Subscribe("alpha", bus.StreamID(3))
+	defer sub.Close()
+	assert.True(t, replay.Complete)
+	assert.Equal(t, uint64(5), replay.LastID)
+	require.Len(t, replay.Events, 2)
+	assert.Equal(t, []uint64{4, 5}, []uint64{replay.Events[0].ID, replay.Events[1].ID})
+
+	_, replay = bus.Subscribe("alpha", bus.StreamID(2))
+	assert.True(t, replay.Complete, "바로 다음 이벤트(3)부터 보관 중")
+	assert.Len(t, replay.Events, 3)
+
+	_, replay = bus.Subscribe("alpha", bus.StreamID(1))
+	assert.False(t, replay.Complete, "이벤트 2를 놓침")
+	assert.Empty(t, replay.Events)
+	_, replay = bus.Subscribe("alpha", bus.StreamID(99))
+	assert.False(t, replay.Complete, "아직 발행하지 않은 ID")
+	assert.Equal(t, uint64(5), replay.LastID)
+	for _, id := range []string{"4", "abc", bus.StreamID(4) + "x"} {
+		_, replay = bus.Subscribe("alpha", id)
+		assert.False(t, replay.Complete, id)
+		assert.Empty(t, replay.Events, id)
+	}
+}
+
+func TestBus_ReplayAfterRestart(t *testing.T) {
+	previous := NewBus(10)
+	for i := 0; i < 2; i++ {
+		previous.Publish("", VisitCreated, i)
+	}
+	lastSeen := previous.StreamID(2)
+
+	// 재시작한 서버(또는 다른 인스턴스)에서 이미 더 많은 이벤트가 발행됨
+	restarted := NewBus(10)
+	for i := 0; i < 5; i++ {
+		restarted.Publish("", VisitCreated, i)
+	}
+	assert.NotEqual(t, lastSeen, restarted.StreamID(2), "기동마다 다른 ID")
+
+	sub, replay := restarted.Subscribe("alpha", lastSeen)
+	defer sub.Close()
+	assert.False(t, replay.Complete, "이전 epoch의 더 작은 ID는 이어 받을 수 없음")
+	assert.Empty(t, replay.Events)
+	assert.Equal(t, uint64(5), replay.LastID)
+	assert.Equal(t, 1, restarted.Subscribers(), "reset 후 새 이벤트는 계속 받음")
+}
+
+func TestBus_SlowSubscriberIsDropped(t *testing.T) {
+	bus := NewBus(0)
+	slow, _ := bus.Subscribe("alpha", "")
+	for i := 0; i <= subscriberBuffer; i++ {
+		_, err := bus.Publish("", VisitCreated, i)
+		require.NoError(t, err)
+	}
+	assert.Zero(t, bus.Subscribers())
+
+	received := 0
+	for range slow.Events() {
+		received++
+	}
+	assert.Equal(t, subscriberBuffer, received, "버퍼까지 받은 뒤 채널이 닫힘")
+	slow.Close()
+}
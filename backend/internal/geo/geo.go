@@ -0,0 +1,25 @@
+package geo
+
+import "math"
+
+// earthRadiusMeters 지구 평균 반지름
+const earthRadiusMeters = 6371000.0
+
+// metersPerDegreeLatitude 위도 1도의 거리 (DistanceMeters와 같은 구면 기준)
+const metersPerDegreeLatitude = earthRadiusMeters * math.Pi / 180
+
+// DistanceMeters 두 좌표 사이의 대원 거리(haversine, 미터)
+func DistanceMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	φ1 := lat1 * math.Pi / 180
+	φ2 := lat2 * math.Pi / 180
+	dφ := (lat2 - lat1) * math.Pi / 180
+	dλ := (lng2 - lng1) * math.Pi / 180
+
+	a := math.Sin(dφ/2)*math.Sin(dφ/2) + math.Cos(φ1)*math.Cos(φ2)*math.Sin(dλ/2)*math.Sin(dλ/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(a))
+}
+
+// LatitudeDelta 남북으로 meters만큼 떨어진 위도 차이 (후보를 좁히는 범위 검색용)
+func LatitudeDelta(meters float64) float64 {
+	return meters / metersPerDegreeLatitude
+}
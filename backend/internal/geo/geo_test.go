@@ -0,0 +1,20 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistanceMeters(t *testing.T) {
+	// 경도가 같으면 위도 1도 = 2πR/360 ≈ 111,195m
+	assert.InDelta(t, 111195, DistanceMeters(37, 127, 38, 127), 1)
+	// 위도 60도에서 경도 1도는 적도의 절반
+	assert.InDelta(t, 111195.0/2, DistanceMeters(60, 127, 60, 128), 100)
+	assert.Zero(t, DistanceMeters(37.5, 127.0, 37.5, 127.0))
+}
+
+func TestLatitudeDelta(t *testing.T) {
+	delta := LatitudeDelta(100)
+	assert.InDelta(t, 100, DistanceMeters(37.5, 127.0, 37.5+delta, 127.0), 1)
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/audit"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/i18n"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultAuditLimit = 50
+	maxAuditLimit     = 200
+)
+
+// ListAuditLogs godoc
+// @Summary List audit logs (admin)
+// @Description List create/update/delete history of restaurants, visits, reviews and bookmarks, newest first
+// @Tags admin
+// @Produce json
+// @Param entityType query string false "restaurants, visits, reviews or bookmarks"
+// @Param entityId query int false "Entity ID"
+// @Param action query string false "create, update or delete"
+// @Param actor query string false "Actor name"
+// @Param requestId query string false "Request ID"
+// @Param from query string false "RFC 3339 start time (inclusive)"
+// @Param to query string false "RFC 3339 end time (exclusive)"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Param offset query int false "Page offset"
+// @Success 200 {object} dto.ListResponse[dto.AuditLogResponse]
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /audit [get]
+func ListAuditLogs(c *gin.Context) {
+	filter, details := parseAuditFilter(c)
+	if len(details) > 0 {
+		respondValidationError(c, details)
+		return
+	}
+
+	logs, total, err := audit.List(db(c), filter)
+	if err != nil {
+		respondInternalError(c, i18n.AuditListFailed, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ListResponse[dto.AuditLogResponse]{
+		Items: dto.NewAuditLogResponses(logs),
+		Total: int(total),
+	})
+}
+
+// parseAuditFilter 쿼리 파라미터를 감사 로그 조회 조건으로 변환, 잘못된 값은 모두 details로 반환
+func parseAuditFilter(c *gin.Context) (audit.Filter, []apierror.FieldError) {
+	filter := audit.Filter{
+		EntityType: c.Query("entityType"),
+		Action:     c.Query("action"),
+		Actor:      c.Query("actor"),
+		RequestID:  c.Query("requestId"),
+		Limit:      defaultAuditLimit,
+	}
+	var details []apierror.FieldError
+	invalid := func(field string) {
+		details = append(details, fieldError(c, field, "invalid", i18n.ValidationInvalid, field))
+	}
+
+	switch filter.Action {
+	case "", audit.ActionCreate, audit.ActionUpdate, audit.ActionDelete:
+	default:
+		invalid("action")
+	}
+	if value := c.Query("entityId"); value != "" {
+		id, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || id == 0 {
+			invalid("entityId")
+		}
+		filter.EntityID = uint(id)
+	}
+	for _, bound := range []struct {
+		field  string
+		target *time.Time
+	}{{"from", &filter.From}, {"to", &filter.To}} {
+		if value := c.Query(bound.field); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				invalid(bound.field)
+			}
+			*bound.target = t
+		}
+	}
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxAuditLimit {
+			invalid("limit")
+		}
+		filter.Limit = limit
+	}
+	if value := c.Query("offset"); value != "" {
+		offset, err := strconv.Atoi(value)
+		if err != nil || offset < 0 {
+			invalid("offset")
+		}
+		filter.Offset = offset
+	}
+	return filter, details
+}
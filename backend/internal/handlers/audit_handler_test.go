@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/middleware"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAuditRouter() *gin.Engine {
+	router := setupRouter()
+	router.Use(middleware.RequestID(), middleware.Actor())
+	router.POST("/restaurants", CreateRestaurant)
+	router.DELETE("/restaurants/:id", DeleteRestaurant)
+	router.GET("/audit", middleware.AdminAuth(), ListAuditLogs)
+	return router
+}
+
+func TestListAuditLogs_RecordsActorAndRequestID(t *testing.T) {
+	t.Setenv("ADMIN_TOKEN", "secret")
+	router := setupAuditRouter()
+
+	body, _ := json.Marshal(map[string]any{"Name": "감사 로그 맛집", "Address": "서울시 종로구", "Latitude": 37.57, "Longitude": 126.98})
+	req, _ := http.NewRequest("POST", "/restaurants", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.ActorHeader, "lee")
+	req.Header.Set(middleware.RequestIDHeader, "audit-create-1")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var created struct{ ID uint }
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+
+	req, _ = http.NewRequest("DELETE", "/restaurants/"+strconv.FormatUint(uint64(created.ID), 10), nil)
+	req.Header.Set(middleware.ActorHeader, "lee")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("GET", "/audit?entityType=restaurants&actor=lee&entityId="+strconv.FormatUint(uint64(created.ID), 10), nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.ListResponse[dto.AuditLogResponse]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Equal(t, 2, response.Total)
+	assert.Equal(t, "delete", response.Items[0].Action)
+	assert.Contains(t, string(response.Items[0].Changes), "deleted_at")
+	assert.Equal(t, "create", response.Items[1].Action)
+	assert.Equal(t, "audit-create-1", response.Items[1].RequestID)
+	assert.Equal(t, "null", string(response.Items[1].Before))
+}
+
+func TestListAuditLogs_InvalidFilter(t *testing.T) {
+	t.Setenv("ADMIN_TOKEN", "secret")
+	router := setupAuditRouter()
+
+	req, _ := http.NewRequest("GET", "/audit?action=rename&limit=1000", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierror.CodeValidationFailed, response.Code)
+	require.Len(t, response.Details, 2)
+	assert.Equal(t, "action", response.Details[0].Field)
+	assert.Equal(t, "limit", response.Details[1].Field)
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"lunch_app/backend/internal/audit"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// chatMaxMatches 채팅 연동(Slack, 카카오톡)에서 이름으로 찾은 맛집을 보여 주는 최대 수
+const chatMaxMatches = 5
+
+// findRestaurantsByName 이름이 정확히 같은 맛집, 없으면 이름에 query가 들어간 맛집 (최대 chatMaxMatches곳)
+func findRestaurantsByName(c *gin.Context, query string) ([]models.Restaurant, error) {
+	var matches []models.Restaurant
+	if err := db(c).Where("name = ?", query).Order("id").Limit(chatMaxMatches).Find(&matches).Error; err != nil {
+		return nil, err
+	}
+	if len(matches) > 0 {
+		return matches, nil
+	}
+	err := db(c).Where("name LIKE ?", "%"+query+"%").Order("id").Limit(chatMaxMatches).Find(&matches).Error
+	return matches, err
+}
+
+// recordChatVisit 지금 시각으로 visitor의 방문 기록을 남기고 실시간 이벤트 발행
+func recordChatVisit(c *gin.Context, visitor string, restaurant models.Restaurant) error {
+	visit := models.Visit{
+		RestaurantID:       restaurant.ID,
+		RestaurantSnapshot: restaurant.Snapshot(),
+		VisitDate:          pollNow().In(kst.Location()),
+		Visitor:            visitor,
+	}
+	if err := db(c).Create(&visit).Error; err != nil {
+		return err
+	}
+	db(c).Scopes(withRestaurant).First(&visit, visit.ID)
+	publishEvent(c, "", events.VisitCreated, newVisitResponseV2(c, visit))
+	return nil
+}
+
+// setChatActor 감사 로그 행위자를 채팅 연동 사용자로 설정 (예: slack:minsu, 최대 64자)
+func setChatActor(c *gin.Context, name string) {
+	if len(name) > 64 {
+		name = name[:64]
+	}
+	c.Request = c.Request.WithContext(audit.WithActor(c.Request.Context(), audit.Actor{Name: name, IP: c.ClientIP()}))
+}
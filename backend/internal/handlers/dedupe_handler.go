@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dedupe"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/i18n"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FindDuplicateRestaurants godoc
+// @Summary Find duplicate restaurants
+// @Description List pairs of restaurants within the radius whose normalized names are similar, nearest first
+// @Tags restaurants
+// @Produce json
+// @Param radius query number false "Radius in meters (default 30, max 200)"
+// @Success 200 {object} dto.ListResponse[dto.DuplicateCandidateResponse]
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /restaurants/duplicates [get]
+func FindDuplicateRestaurants(c *gin.Context) {
+	radius := dedupe.DefaultRadiusMeters
+	if value := c.Query("radius"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) || parsed <= 0 || parsed > dedupe.MaxRadiusMeters {
+			respondValidationError(c, []apierror.FieldError{
+				fieldError(c, "radius", "invalid", i18n.RestaurantInvalidRadius, dedupe.MaxRadiusMeters),
+			})
+			return
+		}
+		radius = parsed
+	}
+
+	candidates, err := dedupe.FindDuplicates(db(c), radius)
+	if err != nil {
+		respondInternalError(c, i18n.RestaurantLookupFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewDuplicateCandidateResponses(candidates)))
+}
+
+// MergeRestaurants godoc
+// @Summary Merge duplicate restaurants
+// @Description Move visits, reviews and bookmarks of duplicateIds onto this restaurant and soft-delete the duplicates in one transaction
+// @Tags restaurants
+// @Accept json
+// @Produce json
+// @Param id path int true "Restaurant ID to keep"
+// @Param request body dto.MergeRestaurantsRequest true "Restaurants to merge"
+// @Success 200 {object} dto.MergeRestaurantsResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /restaurants/{id}/merge [post]
+func MergeRestaurants(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+	var req dto.MergeRestaurantsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindError(c, err)
+		return
+	}
+
+	result, err := dedupe.Merge(db(c), id, req.DuplicateIDs)
+	var notFound *dedupe.NotFoundError
+	switch {
+	case errors.Is(err, dedupe.ErrMergeIntoSelf):
+		respondValidationError(c, []apierror.FieldError{
+			fieldError(c, "duplicateIds", "invalid", i18n.RestaurantMergeSelf),
+		})
+		return
+	case errors.As(err, &notFound):
+		apierror.Abort(c, http.StatusNotFound, apierror.CodeRestaurantNotFound,
+			i18n.Message(c, i18n.RestaurantMergeNotFound, notFound.ID))
+		return
+	case err != nil:
+		respondInternalError(c, i18n.RestaurantMergeFailed, err)
+		return
+	}
+
+	response := dto.NewMergeRestaurantsResponse(result)
+	publishEvent(c, "", events.RestaurantsMerged, response)
+	c.JSON(http.StatusOK, response)
+}
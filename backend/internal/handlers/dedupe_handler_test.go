@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupDedupeRouter() *gin.Engine {
+	router := setupRouter()
+	router.GET("/restaurants/duplicates", FindDuplicateRestaurants)
+	router.POST("/restaurants/:id/merge", MergeRestaurants)
+	return router
+}
+
+func TestFindAndMergeDuplicateRestaurants(t *testing.T) {
+	router := setupDedupeRouter()
+	original := models.Restaurant{Name: "스시하나", Address: "부산시 해운대구 1", Latitude: 35.16310, Longitude: 129.16350}
+	duplicate := models.Restaurant{Name: "스시 하나 본점", Address: "부산시 해운대구 1-1", Latitude: 35.16312, Longitude: 129.16352}
+	require.NoError(t, database.DB.Create(&[]*models.Restaurant{&original, &duplicate}).Error)
+	visit := models.Visit{RestaurantID: duplicate.ID, RestaurantSnapshot: duplicate.Snapshot(), VisitDate: time.Now()}
+	require.NoError(t, database.DB.Create(&visit).Error)
+
+	req, _ := http.NewRequest("GET", "/restaurants/duplicates?radius=10", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var list dto.ListResponse[dto.DuplicateCandidateResponse]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	found := false
+	for _, candidate := range list.Items {
+		if candidate.Restaurant.ID == original.ID && candidate.Duplicate.ID == duplicate.ID {
+			found = true
+			assert.Less(t, candidate.DistanceMeters, 10.0)
+		}
+	}
+	require.True(t, found, "스시하나 / 스시 하나 본점이 중복 후보에 있어야 함")
+
+	body, _ := json.Marshal(dto.MergeRestaurantsRequest{DuplicateIDs: []uint{duplicate.ID}})
+	req, _ = http.NewRequest("POST", "/restaurants/"+strconv.FormatUint(uint64(original.ID), 10)+"/merge", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var merged dto.MergeRestaurantsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &merged))
+	assert.Equal(t, original.ID, merged.Restaurant.ID)
+	assert.Equal(t, []uint{duplicate.ID}, merged.MergedIDs)
+	assert.Equal(t, int64(1), merged.MovedVisits)
+
+	var moved models.Visit
+	require.NoError(t, database.DB.First(&moved, visit.ID).Error)
+	assert.Equal(t, original.ID, moved.RestaurantID)
+
+	// 병합된 맛집은 휴지통으로 이동
+	var count int64
+	database.DB.Model(&models.Restaurant{}).Where("id = ?", duplicate.ID).Count(&count)
+	assert.Zero(t, count)
+}
+
+func TestMergeRestaurants_Errors(t *testing.T) {
+	router := setupDedupeRouter()
+	restaurant := models.Restaurant{Name: "병합 오류 맛집", Address: "부산시 중구", Latitude: 35.1, Longitude: 129.03}
+	require.NoError(t, database.DB.Create(&restaurant).Error)
+	path := "/restaurants/" + strconv.FormatUint(uint64(restaurant.ID), 10) + "/merge"
+
+	cases := []struct {
+		name   string
+		body   string
+		status int
+		code   apierror.Code
+	}{
+		{"empty", `{"duplicateIds": []}`, http.StatusBadRequest, apierror.CodeValidationFailed},
+		{"self", `{"duplicateIds": [` + strconv.FormatUint(uint64(restaurant.ID), 10) + `]}`, http.StatusBadRequest, apierror.CodeValidationFailed},
+		{"missing", `{"duplicateIds": [999999]}`, http.StatusNotFound, apierror.CodeRestaurantNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", path, bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.status, w.Code)
+
+			var response apierror.Response
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tc.code, response.Code)
+		})
+	}
+}
+
+func TestFindDuplicateRestaurants_InvalidRadius(t *testing.T) {
+	router := setupDedupeRouter()
+	for _, radius := range []string{"NaN", "Inf", "-Inf", "0", "201", "abc"} {
+		t.Run(radius, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "/restaurants/duplicates?radius="+radius, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			require.Equal(t, http.StatusBadRequest, w.Code)
+
+			var response apierror.Response
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, apierror.CodeValidationFailed, response.Code)
+			require.Len(t, response.Details, 1)
+			assert.Equal(t, "radius", response.Details[0].Field)
+		})
+	}
+}
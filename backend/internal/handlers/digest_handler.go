@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/digest"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/logger"
+	"lunch_app/backend/internal/mail"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+var (
+	// digestSender 다이제스트 메일 발송기 (main에서 SetDigest로 설정, nil이면 발송 불가)
+	digestSender mail.Sender
+	// digestConfig 메일의 링크 주소 등 다이제스트 설정
+	digestConfig = digest.Config{}
+)
+
+// SetDigest 다이제스트 메일 발송기와 설정 지정 (sender가 nil이면 수동 발송 API가 503)
+func SetDigest(sender mail.Sender, cfg digest.Config) {
+	digestSender = sender
+	digestConfig = cfg
+}
+
+// ListDigestSubscriptions godoc
+// @Summary List digest subscriptions (admin)
+// @Description Morning lunch digest subscribers, oldest first. Unsubscribe tokens are not included
+// @Tags admin
+// @Produce json
+// @Param active query bool false "Only active (true) or unsubscribed (false) subscriptions"
+// @Success 200 {object} dto.ListResponse[dto.DigestSubscriptionResponse]
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/digest/subscriptions [get]
+func ListDigestSubscriptions(c *gin.Context) {
+	query := db(c)
+	switch c.Query("active") {
+	case "true":
+		query = query.Where("active = ?", true)
+	case "false":
+		query = query.Where("active = ?", false)
+	}
+	var subs []models.DigestSubscription
+	if err := query.Order("id").Find(&subs).Error; err != nil {
+		respondInternalError(c, i18n.DigestLookupFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewDigestSubscriptionResponses(subs)))
+}
+
+// SubscribeDigest godoc
+// @Summary Subscribe to the lunch digest (admin)
+// @Description Subscribe an email to the weekday morning digest. An existing email is updated and reactivated (200)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param subscription body dto.SubscribeDigestRequest true "Subscription"
+// @Success 200 {object} dto.DigestSubscriptionResponse
+// @Success 201 {object} dto.DigestSubscriptionResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/digest/subscriptions [post]
+func SubscribeDigest(c *gin.Context) {
+	var req dto.SubscribeDigestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindError(c, err)
+		return
+	}
+	sub, created, err := digest.Subscribe(db(c), req.Email, req.Name, req.Team)
+	if err != nil {
+		respondInternalError(c, i18n.DigestSaveFailed, err)
+		return
+	}
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	c.JSON(status, dto.NewDigestSubscriptionResponse(sub))
+}
+
+// DeleteDigestSubscription godoc
+// @Summary Delete a digest subscription (admin)
+// @Tags admin
+// @Param id path int true "Subscription ID"
+// @Success 204
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/digest/subscriptions/{id} [delete]
+func DeleteDigestSubscription(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+	result := db(c).Delete(&models.DigestSubscription{}, id)
+	if result.Error != nil {
+		respondInternalError(c, i18n.DigestDeleteFailed, result.Error)
+		return
+	}
+	if result.RowsAffected == 0 {
+		respondError(c, http.StatusNotFound, apierror.CodeDigestNotFound, i18n.DigestNotFound)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// PreviewDigest godoc
+// @Summary Preview a digest (admin)
+// @Description Render the HTML digest the subscriber would get right now, without sending it
+// @Tags admin
+// @Produce html
+// @Param id path int true "Subscription ID"
+// @Success 200 {string} string "HTML digest"
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/digest/subscriptions/{id}/preview [get]
+func PreviewDigest(c *gin.Context) {
+	sub, ok := findDigestSubscription(c)
+	if !ok {
+		return
+	}
+	d, err := digest.Build(db(c), sub, time.Now(), nil)
+	if err != nil {
+		respondInternalError(c, i18n.DigestRenderFailed, err)
+		return
+	}
+	html, err := digest.RenderHTML(d, digestConfig)
+	if err != nil {
+		respondInternalError(c, i18n.DigestRenderFailed, err)
+		return
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(html))
+}
+
+// SendDigest godoc
+// @Summary Send a digest now (admin)
+// @Description Send today's digest to the subscriber immediately, even if it was already sent or the subscription is inactive. Does not change the daily schedule
+// @Tags admin
+// @Produce json
+// @Param id path int true "Subscription ID"
+// @Success 200 {object} dto.SendDigestResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Failure 502 {object} apierror.Response
+// @Failure 503 {object} apierror.Response
+// @Router /admin/digest/subscriptions/{id}/send [post]
+func SendDigest(c *gin.Context) {
+	if digestSender == nil {
+		respondError(c, http.StatusServiceUnavailable, apierror.CodeMailDisabled, i18n.MailDisabled)
+		return
+	}
+	sub, ok := findDigestSubscription(c)
+	if !ok {
+		return
+	}
+	now := time.Now()
+	ctx := c.Request.Context()
+	if err := digest.Send(ctx, db(c), digestSender, digestConfig, sub, now, nil); err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, i18n.T(i18n.DefaultLang, i18n.MailSendFailed), "error", err, "subscription_id", sub.ID)
+		respondError(c, http.StatusBadGateway, apierror.CodeMailSendFailed, i18n.MailSendFailed)
+		return
+	}
+	c.JSON(http.StatusOK, dto.SendDigestResponse{To: sub.Email, Subject: digest.Subject(kst.Date(now)), SentAt: now})
+}
+
+// UnsubscribeDigest godoc
+// @Summary Unsubscribe from the lunch digest
+// @Description Unsubscribe link in the digest mail. POST is the one-click unsubscribe of mail apps (RFC 8058). Unsubscribing twice succeeds
+// @Tags digest
+// @Produce json
+// @Param token query string true "Unsubscribe token from the mail"
+// @Success 200 {object} handlers.MessageResponse
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /digest/unsubscribe [get]
+// @Router /digest/unsubscribe [post]
+func UnsubscribeDigest(c *gin.Context) {
+	_, err := digest.Unsubscribe(db(c), c.Query("token"))
+	if errors.Is(err, digest.ErrNotFound) {
+		respondError(c, http.StatusNotFound, apierror.CodeDigestNotFound, i18n.DigestNotFound)
+		return
+	}
+	if err != nil {
+		respondInternalError(c, i18n.DigestSaveFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, MessageResponse{Message: i18n.Message(c, i18n.DigestUnsubscribed)})
+}
+
+// findDigestSubscription 경로의 ID로 구독 조회 (실패 시 에러 응답 후 false)
+func findDigestSubscription(c *gin.Context) (models.DigestSubscription, bool) {
+	id, ok := parseID(c)
+	if !ok {
+		return models.DigestSubscription{}, false
+	}
+	var sub models.DigestSubscription
+	err := db(c).First(&sub, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		respondError(c, http.StatusNotFound, apierror.CodeDigestNotFound, i18n.DigestNotFound)
+		return models.DigestSubscription{}, false
+	}
+	if err != nil {
+		respondInternalError(c, i18n.DigestLookupFailed, err)
+		return models.DigestSubscription{}, false
+	}
+	return sub, true
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/digest"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/mail"
+	"lunch_app/backend/internal/mail/mailtest"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupDigestRouter(t *testing.T, sender mail.Sender) *gin.Engine {
+	SetDigest(sender, digest.Config{AppURL: "https://lunch.example.com", APIURL: "https://api.example.com"})
+	t.Cleanup(func() { SetDigest(nil, digest.Config{}) })
+
+	router := setupRouter()
+	router.GET("/admin/digest/subscriptions", ListDigestSubscriptions)
+	router.POST("/admin/digest/subscriptions", SubscribeDigest)
+	router.DELETE("/admin/digest/subscriptions/:id", DeleteDigestSubscription)
+	router.GET("/admin/digest/subscriptions/:id/preview", PreviewDigest)
+	router.POST("/admin/digest/subscriptions/:id/send", SendDigest)
+	router.GET("/digest/unsubscribe", UnsubscribeDigest)
+	router.POST("/digest/unsubscribe", UnsubscribeDigest)
+	return router
+}
+
+func TestDigestSubscriptions(t *testing.T) {
+	router := setupDigestRouter(t, nil)
+
+	w := sendWebhookRequest(router, "POST", "/admin/digest/subscriptions", map[string]any{"email": "Digest-Admin@Example.com", "name": "민수", "team": "digest-team"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created dto.DigestSubscriptionResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, "digest-admin@example.com", created.Email)
+	assert.True(t, created.Active)
+	assert.Nil(t, created.LastSentOn)
+
+	w = sendWebhookRequest(router, "POST", "/admin/digest/subscriptions", map[string]any{"email": "digest-admin@example.com", "team": "other-team"})
+	require.Equal(t, http.StatusOK, w.Code, "이미 있는 이메일은 수정")
+	var updated dto.DigestSubscriptionResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+	assert.Equal(t, created.ID, updated.ID)
+	assert.Equal(t, "other-team", updated.Team)
+
+	var sub models.DigestSubscription
+	require.NoError(t, database.DB.First(&sub, created.ID).Error)
+	w = sendWebhookRequest(router, "GET", "/admin/digest/subscriptions?active=true", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "digest-admin@example.com")
+	assert.NotContains(t, w.Body.String(), sub.Token, "목록에는 해지 토큰이 없음")
+
+	w = sendWebhookRequest(router, "GET", fmt.Sprintf("/admin/digest/subscriptions/%d/preview", created.ID), nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "https://api.example.com/api/digest/unsubscribe?token="+sub.Token)
+
+	w = sendWebhookRequest(router, "GET", "/digest/unsubscribe?token="+sub.Token, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	w = sendWebhookRequest(router, "POST", "/digest/unsubscribe?token="+sub.Token, nil)
+	require.Equal(t, http.StatusOK, w.Code, "두 번 해지해도 성공")
+	require.NoError(t, database.DB.First(&sub, created.ID).Error)
+	assert.False(t, sub.Active)
+
+	w = sendWebhookRequest(router, "GET", "/digest/unsubscribe?token=unknown", nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = sendWebhookRequest(router, "DELETE", fmt.Sprintf("/admin/digest/subscriptions/%d", created.ID), nil)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	w = sendWebhookRequest(router, "DELETE", fmt.Sprintf("/admin/digest/subscriptions/%d", created.ID), nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestDigestSubscriptions_InvalidEmail(t *testing.T) {
+	router := setupDigestRouter(t, nil)
+
+	w := sendWebhookRequest(router, "POST", "/admin/digest/subscriptions", map[string]any{"email": "not-an-email"})
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierror.CodeValidationFailed, response.Code)
+}
+
+func TestSendDigest(t *testing.T) {
+	server, err := mailtest.NewServer()
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+	sender := mail.NewSMTPSender(mail.SMTPConfig{Host: server.Host(), Port: server.Port(), From: "lunch@example.com", TLS: mail.TLSNone})
+
+	sub, _, err := digest.Subscribe(database.DB, "digest-send@example.com", "지영", "")
+	require.NoError(t, err)
+	path := fmt.Sprintf("/admin/digest/subscriptions/%d/send", sub.ID)
+
+	w := sendWebhookRequest(setupDigestRouter(t, nil), "POST", path, nil)
+	require.Equal(t, http.StatusServiceUnavailable, w.Code, "SMTP 미설정")
+
+	router := setupDigestRouter(t, sender)
+	w = sendWebhookRequest(router, "POST", path, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var result dto.SendDigestResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, "digest-send@example.com", result.To)
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, []string{"digest-send@example.com"}, messages[0].To)
+
+	server.RejectRecipients["digest-send@example.com"] = true
+	w = sendWebhookRequest(router, "POST", path, nil)
+	require.Equal(t, http.StatusBadGateway, w.Code)
+	require.NoError(t, database.DB.First(&sub, sub.ID).Error)
+	assert.NotEmpty(t, sub.LastError, "실패 사유를 구독에 남김")
+
+	w = sendWebhookRequest(router, "POST", "/admin/digest/subscriptions/999999/send", nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/routing"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// directionsRouter 경로 계산기 (main에서 SetRouter로 설정, 기본은 외부 호출 없는 직선 거리 추정)
+var directionsRouter routing.Router = routing.Haversine{}
+
+// SetRouter 경로 계산에 사용할 Router 설정
+func SetRouter(router routing.Router) {
+	directionsRouter = router
+}
+
+// GetDirections godoc
+// @Summary Get walking or driving directions
+// @Description Road route from OSRM (cached by rounded coordinates); falls back to a straight-line estimate when OSRM is unavailable
+// @Tags directions
+// @Produce json
+// @Param mode query string false "walking (default) or driving"
+// @Param fromLat query number true "Origin latitude"
+// @Param fromLng query number true "Origin longitude"
+// @Param toLat query number true "Destination latitude"
+// @Param toLng query number true "Destination longitude"
+// @Success 200 {object} dto.DirectionsResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /directions [get]
+func GetDirections(c *gin.Context) {
+	var details []apierror.FieldError
+	mode := routing.Mode(c.DefaultQuery("mode", string(routing.ModeWalking)))
+	if !mode.Valid() {
+		details = append(details, fieldError(c, "mode", "oneof", i18n.DirectionsInvalidMode))
+	}
+	from := routing.Point{
+		Latitude:  coordinateQuery(c, "fromLat", 90, i18n.DirectionsInvalidLatitude, &details),
+		Longitude: coordinateQuery(c, "fromLng", 180, i18n.DirectionsInvalidLongitude, &details),
+	}
+	to := routing.Point{
+		Latitude:  coordinateQuery(c, "toLat", 90, i18n.DirectionsInvalidLatitude, &details),
+		Longitude: coordinateQuery(c, "toLng", 180, i18n.DirectionsInvalidLongitude, &details),
+	}
+	if len(details) > 0 {
+		respondValidationError(c, details)
+		return
+	}
+
+	route, err := directionsRouter.Route(c.Request.Context(), mode, from, to)
+	if err != nil {
+		respondInternalError(c, i18n.DirectionsFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewDirectionsResponse(route))
+}
+
+// coordinateQuery 필수 좌표 쿼리 파라미터 파싱 (없거나 NaN·Inf이거나 ±limit를 벗어나면 details에 추가)
+func coordinateQuery(c *gin.Context, name string, limit float64, key i18n.Key, details *[]apierror.FieldError) float64 {
+	value := c.Query(name)
+	if value == "" {
+		*details = append(*details, fieldError(c, name, "required", i18n.ValidationRequired, name))
+		return 0
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) || parsed < -limit || parsed > limit {
+		*details = append(*details, fieldError(c, name, "invalid", key, name))
+		return 0
+	}
+	return parsed
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"encoding/json"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/routing"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDirections(t *testing.T) {
+	osrm := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/route/v1/driving/127.0276,37.4979;127.0364,37.5006" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":"InvalidQuery","message":"unexpected path"}`))
+			return
+		}
+		w.Write([]byte(`{"code":"Ok","routes":[{"distance":1200,"duration":250,
+			"geometry":{"coordinates":[[127.0276,37.4979],[127.0364,37.5006]]}}]}`))
+	}))
+	defer osrm.Close()
+
+	SetRouter(routing.Fallback{Primary: routing.NewOSRMClient(osrm.URL), Secondary: routing.Haversine{}})
+	defer SetRouter(routing.Haversine{})
+
+	router := setupRouter()
+	router.GET("/directions", GetDirections)
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", "/directions?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("mode=driving&fromLat=37.4979&fromLng=127.0276&toLat=37.5006&toLng=127.0364")
+	require.Equal(t, http.StatusOK, w.Code)
+	var route dto.DirectionsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &route))
+	assert.Equal(t, routing.SourceOSRM, route.Source)
+	assert.Equal(t, 1200.0, route.DistanceMeters)
+	assert.Equal(t, 4, route.DurationMinutes)
+	assert.Equal(t, dto.LatLng{Latitude: 37.4979, Longitude: 127.0276}, route.Geometry[0])
+
+	// OSRM이 실패한 경로는 도보 기본값 + 직선 거리 추정
+	w = get("fromLat=37.4979&fromLng=127.0276&toLat=37.5&toLng=127.03")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &route))
+	assert.Equal(t, "walking", route.Mode)
+	assert.Equal(t, routing.SourceEstimate, route.Source)
+	assert.Len(t, route.Geometry, 2)
+}
+
+func TestGetDirections_ValidationErrors(t *testing.T) {
+	router := setupRouter()
+	router.GET("/directions", GetDirections)
+
+	req, _ := http.NewRequest("GET", "/directions?mode=flying&fromLat=91&fromLng=abc&toLat=37.5", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierror.CodeValidationFailed, response.Code)
+	fields := make([]string, 0, len(response.Details))
+	for _, detail := range response.Details {
+		fields = append(fields, detail.Field+":"+detail.Reason)
+	}
+	assert.Equal(t, []string{"mode:oneof", "fromLat:invalid", "fromLng:invalid", "toLng:required"}, fields)
+}
+
+func TestGetDirections_NonFiniteCoordinates(t *testing.T) {
+	router := setupRouter()
+	router.GET("/directions", GetDirections)
+
+	req, _ := http.NewRequest("GET", "/directions?fromLat=NaN&fromLng=Inf&toLat=-Inf&toLng=127.03", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	fields := make([]string, 0, len(response.Details))
+	for _, detail := range response.Details {
+		fields = append(fields, detail.Field+":"+detail.Reason)
+	}
+	assert.Equal(t, []string{"fromLat:invalid", "fromLng:invalid", "toLat:invalid"}, fields)
+}
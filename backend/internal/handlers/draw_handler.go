@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/draw"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxDrawList 추첨 기록 목록 최대 건수
+const maxDrawList = 50
+
+// drawRerollsPerDay 팀별 하루 다시 뽑기 횟수 (main에서 SetDrawRerollsPerDay로 설정)
+var drawRerollsPerDay = draw.DefaultRerollsPerDay
+
+// drawSeed 추첨 시드 생성 (테스트에서 고정 시드 주입용)
+var drawSeed = draw.NewSeed
+
+// SetDrawRerollsPerDay 팀별 하루 다시 뽑기 횟수 설정
+func SetDrawRerollsPerDay(n int) {
+	drawRerollsPerDay = n
+}
+
+// CreateDraw godoc
+// @Summary Draw a restaurant (lunch roulette)
+// @Description Weighted draw on the server. The seed, candidates, weights and result are recorded so anyone can verify the draw later. Each team gets one draw plus a limited number of re-rolls per day (Asia/Seoul)
+// @Tags draws
+// @Accept json
+// @Produce json
+// @Param X-Actor header string false "Who drew"
+// @Param draw body dto.CreateDrawRequest true "Candidates (empty: all restaurants)"
+// @Success 201 {object} dto.CreateDrawResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 429 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /draws [post]
+func CreateDraw(c *gin.Context) {
+	var req dto.CreateDrawRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindError(c, err)
+		return
+	}
+	entries := make([]draw.Entry, 0, len(req.Candidates))
+	for _, candidate := range req.Candidates {
+		weight := candidate.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		entries = append(entries, draw.Entry{RestaurantID: candidate.RestaurantID, Weight: weight})
+	}
+
+	created, err := draw.Create(db(c), draw.CreateInput{
+		Team:          strings.TrimSpace(req.Team),
+		DrawnBy:       actorName(c),
+		Entries:       entries,
+		RerollsPerDay: drawRerollsPerDay,
+		Seed:          drawSeed(),
+	}, pollNow())
+	var notFound *draw.RestaurantNotFoundError
+	switch {
+	case errors.Is(err, draw.ErrTooManyCandidates):
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "candidates", "max", i18n.DrawTooManyCandidates, draw.MaxCandidates)})
+		return
+	case errors.Is(err, draw.ErrNoCandidates):
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "candidates", "required", i18n.DrawNoCandidates)})
+		return
+	case errors.Is(err, draw.ErrLimitReached):
+		apierror.Abort(c, http.StatusTooManyRequests, apierror.CodeDrawLimitReached, i18n.Message(c, i18n.DrawLimitReached, drawRerollsPerDay+1))
+		return
+	case errors.As(err, &notFound):
+		apierror.Abort(c, http.StatusNotFound, apierror.CodeRestaurantNotFound, i18n.Message(c, i18n.DrawRestaurantNotFound, notFound.ID))
+		return
+	case err != nil:
+		respondInternalError(c, i18n.DrawCreateFailed, err)
+		return
+	}
+	response := dto.CreateDrawResponse{
+		DrawResponse:     dto.NewDrawResponse(created),
+		RemainingRerolls: draw.RemainingRerolls(created, drawRerollsPerDay),
+	}
+	publishEvent(c, created.Team, events.DrawCreated, response.DrawResponse)
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListDraws godoc
+// @Summary List lunch roulette draws
+// @Description Newest first, at most 50
+// @Tags draws
+// @Produce json
+// @Param team query string false "Team"
+// @Param date query string false "Lunch date (YYYY-MM-DD, Asia/Seoul)"
+// @Success 200 {object} dto.ListResponse[dto.DrawResponse]
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /draws [get]
+func ListDraws(c *gin.Context) {
+	query := db(c).Scopes(draw.WithCandidates)
+	if team := c.Query("team"); team != "" {
+		query = query.Where("team = ?", team)
+	}
+	if value := c.Query("date"); value != "" {
+		date, err := kst.ParseDate(value)
+		if err != nil {
+			respondValidationError(c, []apierror.FieldError{fieldError(c, "date", "invalid", i18n.DrawInvalidDate)})
+			return
+		}
+		query = query.Where("date = ?", date)
+	}
+
+	var draws []models.Draw
+	if err := query.Order("id DESC").Limit(maxDrawList).Find(&draws).Error; err != nil {
+		respondInternalError(c, i18n.DrawLookupFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewDrawResponses(draws)))
+}
+
+// GetDraw godoc
+// @Summary Get a lunch roulette draw
+// @Description Draw with its seed, candidates, weights and result
+// @Tags draws
+// @Produce json
+// @Param id path int true "Draw ID"
+// @Success 200 {object} dto.DrawResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /draws/{id} [get]
+func GetDraw(c *gin.Context) {
+	found, ok := findDraw(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewDrawResponse(found))
+}
+
+// VerifyDraw godoc
+// @Summary Verify a lunch roulette draw
+// @Description Recompute the draw from its recorded seed, candidates and weights and compare with the recorded result
+// @Tags draws
+// @Produce json
+// @Param id path int true "Draw ID"
+// @Success 200 {object} dto.DrawVerificationResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /draws/{id}/verify [get]
+func VerifyDraw(c *gin.Context) {
+	found, ok := findDraw(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewDrawVerificationResponse(found, draw.Verify(found)))
+}
+
+// findDraw 경로의 ID로 추첨 기록 조회 (실패 시 에러 응답 후 false)
+func findDraw(c *gin.Context) (models.Draw, bool) {
+	id, ok := parseID(c)
+	if !ok {
+		return models.Draw{}, false
+	}
+	found, err := draw.Get(db(c), id)
+	if errors.Is(err, draw.ErrNotFound) {
+		respondError(c, http.StatusNotFound, apierror.CodeDrawNotFound, i18n.DrawNotFound)
+		return models.Draw{}, false
+	}
+	if err != nil {
+		respondInternalError(c, i18n.DrawLookupFailed, err)
+		return models.Draw{}, false
+	}
+	return found, true
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/draw"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupDrawRouter(t *testing.T, now time.Time, rerolls int) *gin.Engine {
+	pollNow = func() time.Time { return now }
+	SetDrawRerollsPerDay(rerolls)
+	t.Cleanup(func() {
+		pollNow = time.Now
+		SetDrawRerollsPerDay(draw.DefaultRerollsPerDay)
+	})
+
+	router := setupRouter()
+	router.GET("/draws", ListDraws)
+	router.POST("/draws", CreateDraw)
+	router.GET("/draws/:id", GetDraw)
+	router.GET("/draws/:id/verify", VerifyDraw)
+	return router
+}
+
+func TestDrawLifecycle(t *testing.T) {
+	// 2024-07-05 12:00 KST
+	now := time.Date(2024, 7, 5, 3, 0, 0, 0, time.UTC)
+	router := setupDrawRouter(t, now, 1)
+
+	restaurants := []models.Restaurant{
+		{Name: "룰렛 국밥집", Address: "서울시 룰렛구 1"},
+		{Name: "룰렛 초밥집", Address: "서울시 룰렛구 2"},
+	}
+	require.NoError(t, database.DB.Create(&restaurants).Error)
+	body := dto.CreateDrawRequest{
+		Team: "draw-team",
+		Candidates: []dto.DrawEntryRequest{
+			{RestaurantID: restaurants[0].ID, Weight: 3},
+			{RestaurantID: restaurants[1].ID},
+		},
+	}
+
+	w := sendPollRequest(router, "POST", "/draws", "민수", body)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created dto.CreateDrawResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, "draw-team", created.Team)
+	assert.Equal(t, "2024-07-05", created.Date)
+	assert.Equal(t, 1, created.Sequence)
+	assert.Equal(t, 1, created.RemainingRerolls)
+	assert.Equal(t, "민수", created.DrawnBy)
+	assert.Equal(t, draw.Algorithm, created.Algorithm)
+	require.Len(t, created.Candidates, 2)
+	assert.Equal(t, 3, created.Candidates[0].Weight)
+	assert.Equal(t, 1, created.Candidates[1].Weight, "weight 생략 시 1")
+	assert.Equal(t, created.Candidates[created.Result.Position], created.Result)
+
+	w = sendPollRequest(router, "GET", fmt.Sprintf("/draws/%d/verify", created.ID), "", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var verification dto.DrawVerificationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &verification))
+	assert.True(t, verification.Verified)
+	assert.Equal(t, created.Seed, verification.Seed)
+	assert.Equal(t, created.Result.Position, verification.ExpectedPosition)
+
+	// 다시 뽑기 한 번 뒤에는 제한
+	w = sendPollRequest(router, "POST", "/draws", "지영", body)
+	require.Equal(t, http.StatusCreated, w.Code)
+	w = sendPollRequest(router, "POST", "/draws", "지영", body)
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	var errorBody apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorBody))
+	assert.Equal(t, apierror.CodeDrawLimitReached, errorBody.Code)
+	assert.Equal(t, "오늘 추첨 횟수를 모두 썼습니다 (하루 2번까지)", errorBody.Error)
+
+	w = sendPollRequest(router, "GET", "/draws?team=draw-team&date=2024-07-05", "", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var list dto.ListResponse[dto.DrawResponse]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	require.Len(t, list.Items, 2)
+	assert.Equal(t, 2, list.Items[0].Sequence)
+
+	w = sendPollRequest(router, "GET", fmt.Sprintf("/draws/%d", created.ID), "", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var found dto.DrawResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &found))
+	assert.Equal(t, created.DrawResponse.Result, found.Result)
+}
+
+func TestCreateDraw_Errors(t *testing.T) {
+	router := setupDrawRouter(t, time.Date(2024, 7, 6, 3, 0, 0, 0, time.UTC), 0)
+
+	w := sendPollRequest(router, "POST", "/draws", "", dto.CreateDrawRequest{Candidates: []dto.DrawEntryRequest{{RestaurantID: 999999}}})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = sendPollRequest(router, "POST", "/draws", "", dto.CreateDrawRequest{Candidates: []dto.DrawEntryRequest{{RestaurantID: 1, Weight: 101}}})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = sendPollRequest(router, "GET", "/draws/999999", "", nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = sendPollRequest(router, "GET", "/draws?date=07-06", "", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"fmt"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/logger"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/poll"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// eventBus 변경 이벤트 버스 (main에서 SetEventBus로 교체 가능)
+var eventBus = events.NewBus(events.DefaultHistorySize)
+
+// eventHeartbeat 프록시가 유휴 연결을 끊지 않도록 보내는 주석 줄 간격
+var eventHeartbeat = 25 * time.Second
+
+// eventRetryMillis 연결이 끊겼을 때 브라우저 EventSource의 재연결 대기 시간
+const eventRetryMillis = 3000
+
+// SetEventBus 변경 이벤트를 발행·구독할 버스 설정
+func SetEventBus(bus *events.Bus) {
+	eventBus = bus
+}
+
+// StreamEvents godoc
+// @Summary Stream change events (SSE)
+// @Description Server-Sent Events stream of restaurant, visit and poll changes. Restaurant and visit events go to every team, poll and draw events only to their team. Reconnect with Last-Event-ID to receive missed events; a reset event means they are no longer available and the client should reload everything
+// @Tags events
+// @Produce text/event-stream
+// @Param team query string false "Team (default: default)"
+// @Param Last-Event-ID header string false "Last received event ID"
+// @Param lastEventId query string false "Last received event ID when the header cannot be set"
+// @Success 200 {string} string "text/event-stream"
+// @Failure 400 {object} apierror.Response
+// @Router /events [get]
+func StreamEvents(c *gin.Context) {
+	team := strings.TrimSpace(c.DefaultQuery("team", models.DefaultTeam))
+	if team == "" || len(team) > 64 {
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "team", "invalid", i18n.EventsInvalidTeam)})
+		return
+	}
+	lastID := c.GetHeader("Last-Event-ID")
+	if lastID == "" {
+		lastID = c.Query("lastEventId")
+	}
+	sub, replay := eventBus.Subscribe(team, lastID)
+	defer sub.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// nginx 등 리버스 프록시의 응답 버퍼링 끄기
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", eventRetryMillis)
+	if !replay.Complete {
+		writeEvent(c, events.Event{ID: replay.LastID, Type: events.Reset, Data: []byte("{}")})
+	}
+	for _, event := range replay.Events {
+		writeEvent(c, event)
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(eventHeartbeat)
+	defer heartbeat.Stop()
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-sub.Events():
+			if !ok {
+				// 너무 느려서 구독이 끊김 → 클라이언트가 Last-Event-ID로 재연결
+				return
+			}
+			writeEvent(c, event)
+		case <-heartbeat.C:
+			fmt.Fprint(c.Writer, ": ping\n\n")
+		}
+		c.Writer.Flush()
+	}
+}
+
+// writeEvent 이벤트 하나를 SSE 형식으로 기록 (id는 버스 epoch를 붙인 StreamID, data는 줄바꿈 없는 JSON)
+func writeEvent(c *gin.Context, event events.Event) {
+	fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", eventBus.StreamID(event.ID), event.Type, event.Data)
+}
+
+// publishEvent 커밋이 끝난 변경을 이벤트로 발행 (실패해도 요청은 성공으로 처리하고 로그만 남김)
+func publishEvent(c *gin.Context, team, eventType string, data any) {
+	if _, err := eventBus.Publish(team, eventType, data); err != nil {
+		ctx := c.Request.Context()
+		logger.FromContext(ctx).ErrorContext(ctx, "이벤트 발행 실패", "type", eventType, "error", err)
+	}
+}
+
+// PublishPollClosed 자동 마감된 투표 결과를 이벤트로 발행 (예약 작업 poll-close에서 호출)
+func PublishPollClosed(result poll.CloseResult) {
+	eventBus.Publish(result.Poll.Team, events.PollClosed, dto.NewClosePollResponse(result))
+}
@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sseMessage 수신한 SSE 메시지 한 건
+type sseMessage struct {
+	ID    string
+	Event string
+	Data  string
+}
+
+// sseStream 테스트용 SSE 연결
+type sseStream struct {
+	messages chan sseMessage
+	cancel   context.CancelFunc
+}
+
+func openEventStream(t *testing.T, url, lastEventID string) *sseStream {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	stream := &sseStream{messages: make(chan sseMessage, 16), cancel: cancel}
+	go func() {
+		defer resp.Body.Close()
+		defer close(stream.messages)
+		reader := bufio.NewReader(resp.Body)
+		var message sseMessage
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimSuffix(line, "\n")
+			field, value, _ := strings.Cut(line, ": ")
+			switch field {
+			case "id":
+				message.ID = value
+			case "event":
+				message.Event = value
+			case "data":
+				message.Data = value
+			case "":
+				// 빈 줄이 메시지 끝 (retry 설정과 ping 주석만 있는 블록은 건너뜀)
+				if message.Event != "" {
+					stream.messages <- message
+				}
+				message = sseMessage{}
+			}
+		}
+	}()
+	t.Cleanup(cancel)
+	return stream
+}
+
+func (s *sseStream) next(t *testing.T) sseMessage {
+	t.Helper()
+	select {
+	case message, ok := <-s.messages:
+		require.True(t, ok, "스트림이 끊김")
+		return message
+	case <-time.After(2 * time.Second):
+		t.Fatal("이벤트 대기 시간 초과")
+		return sseMessage{}
+	}
+}
+
+func TestStreamEvents(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistorySize)
+	SetEventBus(bus)
+	defer SetEventBus(events.NewBus(events.DefaultHistorySize))
+
+	router := setupRouter()
+	router.GET("/events", StreamEvents)
+	router.POST("/restaurants", CreateRestaurant)
+	router.DELETE("/restaurants/:id", DeleteRestaurant)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	waitSubscribed := func(n int) {
+		require.Eventually(t, func() bool { return bus.Subscribers() == n }, time.Second, 5*time.Millisecond)
+	}
+
+	stream := openEventStream(t, server.URL+"/events?team=alpha", "")
+	waitSubscribed(1)
+
+	body := `{"Name":"실시간 맛집","Address":"서울시 이벤트구 1","Latitude":37.5,"Longitude":127.0}`
+	resp, err := http.Post(server.URL+"/restaurants", "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	message := stream.next(t)
+	assert.Equal(t, bus.StreamID(1), message.ID)
+	assert.Equal(t, events.RestaurantCreated, message.Event)
+	var created dto.RestaurantResponse
+	require.NoError(t, json.Unmarshal([]byte(message.Data), &created))
+	assert.Equal(t, "실시간 맛집", created.Name)
+
+	// 다른 팀의 투표 이벤트는 받지 않음
+	bus.Publish("beta", events.PollCreated, map[string]any{"id": 1})
+	bus.Publish("alpha", events.PollCreated, map[string]any{"id": 2})
+	message = stream.next(t)
+	assert.Equal(t, bus.StreamID(3), message.ID)
+	assert.Equal(t, events.PollCreated, message.Event)
+
+	// 연결이 끊긴 동안 삭제된 맛집은 Last-Event-ID로 재연결하면 받음
+	stream.cancel()
+	waitSubscribed(0)
+	req, _ := http.NewRequest("DELETE", fmt.Sprintf("%s/restaurants/%d", server.URL, created.ID), nil)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	stream = openEventStream(t, server.URL+"/events?team=alpha", bus.StreamID(3))
+	message = stream.next(t)
+	assert.Equal(t, bus.StreamID(4), message.ID)
+	assert.Equal(t, events.RestaurantDeleted, message.Event)
+	assert.JSONEq(t, fmt.Sprintf(`{"id":%d}`, created.ID), message.Data)
+	stream.cancel()
+
+	// 서버 재시작 전 ID는 현재보다 작아도 reset 후 현재 ID부터 이어서 받음
+	stream = openEventStream(t, server.URL+"/events?team=alpha", events.NewBus(1).StreamID(2))
+	message = stream.next(t)
+	assert.Equal(t, events.Reset, message.Event)
+	assert.Equal(t, bus.StreamID(4), message.ID)
+	stream.cancel()
+
+	stream = openEventStream(t, server.URL+"/events?team=alpha", "999")
+	assert.Equal(t, events.Reset, stream.next(t).Event, "형식이 틀린 ID")
+}
+
+func TestStreamEvents_PollTeam(t *testing.T) {
+	bus := events.NewBus(events.DefaultHistorySize)
+	SetEventBus(bus)
+	defer SetEventBus(events.NewBus(events.DefaultHistorySize))
+
+	now := time.Date(2024, 7, 3, 2, 0, 0, 0, time.UTC)
+	router := setupPollRouter(t, now)
+	restaurant := models.Restaurant{Name: "이벤트 투표 맛집", Address: "서울시 이벤트구 2"}
+	require.NoError(t, database.DB.Create(&restaurant).Error)
+
+	w := sendPollRequest(router, "POST", "/polls", "민수", dto.CreatePollRequest{
+		Team: "events-team", Deadline: now.Add(time.Hour), RestaurantIDs: []uint{restaurant.ID},
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	sub, replay := bus.Subscribe("events-team", "")
+	defer sub.Close()
+	assert.Equal(t, uint64(1), replay.LastID)
+	other, _ := bus.Subscribe(models.DefaultTeam, "")
+	defer other.Close()
+
+	var created dto.PollResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	w = sendPollRequest(router, "POST", fmt.Sprintf("/polls/%d/votes", created.ID), "민수", dto.VoteRequest{CandidateID: created.Candidates[0].ID})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	event := <-sub.Events()
+	assert.Equal(t, events.PollVoted, event.Type)
+	var voted dto.PollResponse
+	require.NoError(t, json.Unmarshal(event.Data, &voted))
+	assert.Equal(t, 1, voted.TotalVotes)
+	assert.Empty(t, other.Events(), "다른 팀은 투표 이벤트를 받지 않음")
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxExternalIDs 한 번에 조회할 수 있는 외부 장소 ID 수 (카카오 키워드 검색 한 페이지 최대 45건)
+const maxExternalIDs = 50
+
+// GetRestaurantByExternalID godoc
+// @Summary Get a restaurant by external place ID
+// @Description Look up the saved restaurant linked to a Kakao/Naver place ID
+// @Tags restaurants
+// @Produce json
+// @Param source path string true "kakao or naver"
+// @Param externalId path string true "Place ID of the source"
+// @Success 200 {object} dto.RestaurantResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /restaurants/external/{source}/{externalId} [get]
+func GetRestaurantByExternalID(c *gin.Context) {
+	source, ok := parseExternalSource(c)
+	if !ok {
+		return
+	}
+
+	var restaurant models.Restaurant
+	err := db(c).Where("external_source = ? AND external_id = ?", source, c.Param("externalId")).First(&restaurant).Error
+	if err != nil {
+		respondRestaurantLookupError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewRestaurantResponse(restaurant))
+}
+
+// ListRestaurantsByExternalIDs godoc
+// @Summary Find saved restaurants for external place IDs
+// @Description Batch lookup for map search results; only places that are already saved are returned
+// @Tags restaurants
+// @Produce json
+// @Param source path string true "kakao or naver"
+// @Param ids query string true "Comma-separated place IDs (max 50)"
+// @Success 200 {object} dto.ListResponse[dto.RestaurantResponse]
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /restaurants/external/{source} [get]
+func ListRestaurantsByExternalIDs(c *gin.Context) {
+	source, ok := parseExternalSource(c)
+	if !ok {
+		return
+	}
+
+	var ids []string
+	for _, id := range strings.Split(c.Query("ids"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 || len(ids) > maxExternalIDs {
+		respondValidationError(c, []apierror.FieldError{
+			fieldError(c, "ids", "invalid", i18n.RestaurantInvalidExternalIDs, maxExternalIDs),
+		})
+		return
+	}
+
+	var restaurants []models.Restaurant
+	if err := db(c).Where("external_source = ? AND external_id IN ?", source, ids).Order("id").Find(&restaurants).Error; err != nil {
+		respondInternalError(c, i18n.RestaurantLookupFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewRestaurantResponses(restaurants)))
+}
+
+// parseExternalSource 경로 파라미터 source 검증 (실패 시 검증 에러 응답 후 false)
+func parseExternalSource(c *gin.Context) (string, bool) {
+	source := c.Param("source")
+	if !models.IsExternalSource(source) {
+		respondValidationError(c, []apierror.FieldError{
+			fieldError(c, "source", "oneof", i18n.RestaurantExternalSourceInvalid),
+		})
+		return "", false
+	}
+	return source, true
+}
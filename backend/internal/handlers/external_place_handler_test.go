@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupExternalPlaceRouter() *gin.Engine {
+	router := setupRouter()
+	router.POST("/restaurants", CreateRestaurant)
+	router.GET("/restaurants/external/:source", ListRestaurantsByExternalIDs)
+	router.GET("/restaurants/external/:source/:externalId", GetRestaurantByExternalID)
+	return router
+}
+
+func postRestaurant(t *testing.T, router *gin.Engine, restaurant models.Restaurant) *httptest.ResponseRecorder {
+	t.Helper()
+	body, _ := json.Marshal(restaurant)
+	req, _ := http.NewRequest("POST", "/restaurants", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestExternalPlaceLinking(t *testing.T) {
+	router := setupExternalPlaceRouter()
+	restaurant := models.Restaurant{
+		Name: "카카오 연결 맛집", Address: "서울시 종로구 1", Latitude: 37.57, Longitude: 126.98,
+		ExternalSource: models.ExternalSourceKakao, ExternalID: "kakao-1001",
+	}
+	w := postRestaurant(t, router, restaurant)
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	// 이름·주소가 달라도 같은 장소 ID면 중복
+	renamed := restaurant
+	renamed.Name, renamed.Address = "카카오 연결 맛집 2호", "서울시 종로구 1-2"
+	w = postRestaurant(t, router, renamed)
+	require.Equal(t, http.StatusConflict, w.Code)
+	var errResponse apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errResponse))
+	assert.Equal(t, apierror.CodeDuplicateRestaurant, errResponse.Code)
+
+	// 출처가 다르면 같은 ID라도 다른 장소
+	naver := renamed
+	naver.ExternalSource = models.ExternalSourceNaver
+	w = postRestaurant(t, router, naver)
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	req, _ := http.NewRequest("GET", "/restaurants/external/kakao/kakao-1001", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	var found dto.RestaurantResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &found))
+	assert.Equal(t, "카카오 연결 맛집", found.Name)
+	assert.Equal(t, "kakao-1001", found.ExternalID)
+
+	req, _ = http.NewRequest("GET", "/restaurants/external/kakao/unknown", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	req, _ = http.NewRequest("GET", "/restaurants/external/kakao?ids=kakao-1001,unknown,%20", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	var list dto.ListResponse[dto.RestaurantResponse]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "kakao-1001", list.Items[0].ExternalID)
+}
+
+func TestExternalPlace_ValidationErrors(t *testing.T) {
+	router := setupExternalPlaceRouter()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   *models.Restaurant
+		field  string
+	}{
+		{"unknown source lookup", "GET", "/restaurants/external/google/1", nil, "source"},
+		{"missing ids", "GET", "/restaurants/external/kakao", nil, "ids"},
+		{"unknown source on create", "POST", "/restaurants", &models.Restaurant{
+			Name: "출처 오류 맛집", Address: "서울시", Latitude: 37.5, Longitude: 127, ExternalSource: "google", ExternalID: "1",
+		}, "ExternalSource"},
+		{"id without source", "POST", "/restaurants", &models.Restaurant{
+			Name: "짝 오류 맛집", Address: "서울시", Latitude: 37.5, Longitude: 127, ExternalID: "1",
+		}, "ExternalID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w *httptest.ResponseRecorder
+			if tt.body != nil {
+				w = postRestaurant(t, router, *tt.body)
+			} else {
+				req, _ := http.NewRequest(tt.method, tt.path, nil)
+				w = httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+			}
+			require.Equal(t, http.StatusBadRequest, w.Code)
+
+			var response apierror.Response
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, apierror.CodeValidationFailed, response.Code)
+			require.NotEmpty(t, response.Details)
+			assert.Equal(t, tt.field, response.Details[0].Field)
+		})
+	}
+}
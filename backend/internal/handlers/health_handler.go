@@ -15,7 +15,13 @@ type HealthResponse struct {
 	Version   string    `json:"version"`
 }
 
-// HealthCheck 헬스체크 핸들러
+// HealthCheck godoc
+// @Summary Health check
+// @Description Report service status for uptime monitoring
+// @Tags health
+// @Produce json
+// @Success 200 {object} HealthResponse
+// @Router /health [get]
 func HealthCheck(c *gin.Context) {
 	response := HealthResponse{
 		Status:    "healthy",
@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/hours"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetOpeningHours godoc
+// @Summary Get opening hours of a restaurant
+// @Description Weekly opening hours with break times and date overrides (holidays, special hours). Times are HH:MM in Asia/Seoul; a missing weekday is a regular closing day
+// @Tags restaurants
+// @Produce json
+// @Param id path int true "Restaurant ID"
+// @Success 200 {object} dto.OpeningHoursResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /restaurants/{id}/hours [get]
+func GetOpeningHours(c *gin.Context) {
+	restaurant, ok := findRestaurant(c)
+	if !ok {
+		return
+	}
+	respondOpeningHours(c, restaurant.ID)
+}
+
+// UpdateOpeningHours godoc
+// @Summary Replace weekly opening hours of a restaurant
+// @Description Replace all weekly opening hours. Weekdays left out are regular closing days; an empty list removes the hours. closes earlier than opens means open past midnight
+// @Tags restaurants
+// @Accept json
+// @Produce json
+// @Param id path int true "Restaurant ID"
+// @Param request body dto.UpdateOpeningHoursRequest true "Weekly opening hours"
+// @Success 200 {object} dto.OpeningHoursResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /restaurants/{id}/hours [put]
+func UpdateOpeningHours(c *gin.Context) {
+	restaurant, ok := findRestaurant(c)
+	if !ok {
+		return
+	}
+	var req dto.UpdateOpeningHoursRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindError(c, err)
+		return
+	}
+
+	var details []apierror.FieldError
+	seen := make(map[int]bool, len(req.Weekly))
+	for i, day := range req.Weekly {
+		field := fmt.Sprintf("weekly[%d]", i)
+		switch {
+		case day.Weekday < 0 || day.Weekday > 6:
+			details = append(details, fieldError(c, field+".weekday", "invalid", i18n.HoursInvalidWeekday))
+		case seen[day.Weekday]:
+			details = append(details, fieldError(c, field+".weekday", "unique", i18n.HoursDuplicateWeekday, day.Weekday))
+		}
+		seen[day.Weekday] = true
+		if detail, invalid := hoursFieldError(c, field+".", day.Opens, day.Closes, day.BreakStart, day.BreakEnd); invalid {
+			details = append(details, detail)
+		}
+	}
+	if len(details) > 0 {
+		respondValidationError(c, details)
+		return
+	}
+
+	if err := hours.ReplaceWeekly(db(c), restaurant.ID, req.ToModels()); err != nil {
+		respondInternalError(c, i18n.HoursSaveFailed, err)
+		return
+	}
+	respondOpeningHours(c, restaurant.ID)
+}
+
+// SetHoliday godoc
+// @Summary Set an opening hours override for a date
+// @Description Close the restaurant for the whole day (closed) or open only opens-closes on that date, instead of its weekly hours
+// @Tags restaurants
+// @Accept json
+// @Produce json
+// @Param id path int true "Restaurant ID"
+// @Param date path string true "Date (YYYY-MM-DD, Asia/Seoul)"
+// @Param request body dto.HolidayRequest true "Override"
+// @Success 200 {object} dto.HolidayResponse
+// @Success 201 {object} dto.HolidayResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /restaurants/{id}/holidays/{date} [put]
+func SetHoliday(c *gin.Context) {
+	restaurant, ok := findRestaurant(c)
+	if !ok {
+		return
+	}
+	date, ok := parseHolidayDate(c)
+	if !ok {
+		return
+	}
+	var req dto.HolidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindError(c, err)
+		return
+	}
+
+	holiday := models.RestaurantHoliday{Date: date, Closed: req.Closed, Note: req.Note}
+	if !req.Closed {
+		if detail, invalid := hoursFieldError(c, "", req.Opens, req.Closes, req.BreakStart, req.BreakEnd); invalid {
+			respondValidationError(c, []apierror.FieldError{detail})
+			return
+		}
+		holiday.Opens, holiday.Closes = req.Opens, req.Closes
+		holiday.BreakStart, holiday.BreakEnd = req.BreakStart, req.BreakEnd
+	}
+
+	saved, created, err := hours.SetHoliday(db(c), restaurant.ID, holiday)
+	if err != nil {
+		respondInternalError(c, i18n.HoursSaveFailed, err)
+		return
+	}
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	c.JSON(status, dto.NewHolidayResponse(saved))
+}
+
+// DeleteHoliday godoc
+// @Summary Remove an opening hours override
+// @Description The restaurant follows its weekly hours again on that date
+// @Tags restaurants
+// @Param id path int true "Restaurant ID"
+// @Param date path string true "Date (YYYY-MM-DD, Asia/Seoul)"
+// @Success 204
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /restaurants/{id}/holidays/{date} [delete]
+func DeleteHoliday(c *gin.Context) {
+	restaurant, ok := findRestaurant(c)
+	if !ok {
+		return
+	}
+	date, ok := parseHolidayDate(c)
+	if !ok {
+		return
+	}
+	result := db(c).Where("restaurant_id = ? AND date = ?", restaurant.ID, date).Delete(&models.RestaurantHoliday{})
+	if result.Error != nil {
+		respondInternalError(c, i18n.HoursDeleteFailed, result.Error)
+		return
+	}
+	if result.RowsAffected == 0 {
+		respondError(c, http.StatusNotFound, apierror.CodeHolidayNotFound, i18n.HoursHolidayNotFound)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// respondOpeningHours 맛집의 요일별 영업시간(요일순)과 날짜별 예외(날짜순) 응답
+func respondOpeningHours(c *gin.Context, restaurantID uint) {
+	var weekly []models.OpeningHours
+	if err := db(c).Where("restaurant_id = ?", restaurantID).Order("weekday").Find(&weekly).Error; err != nil {
+		respondInternalError(c, i18n.HoursLookupFailed, err)
+		return
+	}
+	var holidays []models.RestaurantHoliday
+	if err := db(c).Where("restaurant_id = ?", restaurantID).Order("date").Find(&holidays).Error; err != nil {
+		respondInternalError(c, i18n.HoursLookupFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewOpeningHoursResponse(restaurantID, weekly, holidays))
+}
+
+// hoursFieldError 하루 영업시간 검증 실패를 필드 에러로 변환 (prefix는 weekly[0]. 같은 필드 경로)
+func hoursFieldError(c *gin.Context, prefix, opens, closes, breakStart, breakEnd string) (apierror.FieldError, bool) {
+	err := hours.Validate(opens, closes, breakStart, breakEnd)
+	var clockErr *hours.ClockError
+	switch {
+	case err == nil:
+		return apierror.FieldError{}, false
+	case errors.As(err, &clockErr):
+		field := prefix + clockErr.Field
+		return fieldError(c, field, "invalid", i18n.HoursInvalidClock, field), true
+	case errors.Is(err, hours.ErrEmptyRange):
+		return fieldError(c, prefix+"closes", "invalid", i18n.HoursEmptyRange), true
+	case errors.Is(err, hours.ErrBreakPair):
+		return fieldError(c, prefix+"breakEnd", "required_with", i18n.HoursBreakPair), true
+	default:
+		return fieldError(c, prefix+"breakStart", "invalid", i18n.HoursBreakOutside), true
+	}
+}
+
+// parseHolidayDate 경로 파라미터 date (YYYY-MM-DD, Asia/Seoul) 해석, 실패 시 검증 에러 응답
+func parseHolidayDate(c *gin.Context) (time.Time, bool) {
+	date, err := kst.ParseDate(c.Param("date"))
+	if err != nil {
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "date", "invalid", i18n.HoursInvalidDate)})
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// parseOpenAt 쿼리 open_at 해석 (없으면 zero time, 잘못되면 검증 에러 응답 후 false)
+func parseOpenAt(c *gin.Context) (time.Time, bool) {
+	value := c.Query("open_at")
+	if value == "" {
+		return time.Time{}, true
+	}
+	openAt, err := hours.ParseTime(value, pollNow())
+	if err != nil {
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "open_at", "invalid", i18n.RestaurantInvalidOpenAt)})
+		return time.Time{}, false
+	}
+	return openAt, true
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupHoursRouter() *gin.Engine {
+	router := setupRouter()
+	router.GET("/restaurants", GetAllRestaurants)
+	router.GET("/restaurants/:id/hours", GetOpeningHours)
+	router.PUT("/restaurants/:id/hours", UpdateOpeningHours)
+	router.PUT("/restaurants/:id/holidays/:date", SetHoliday)
+	router.DELETE("/restaurants/:id/holidays/:date", DeleteHoliday)
+	router.GET("/v2/restaurants", ListRestaurantsV2)
+	router.GET("/v2/restaurants/nearby", NearbyRestaurantsV2)
+	router.GET("/v2/restaurants/recommendations", RecommendRestaurantsV2)
+	return router
+}
+
+func TestOpeningHours(t *testing.T) {
+	// 2024-07-01은 월요일
+	now := time.Date(2024, 7, 2, 12, 0, 0, 0, time.FixedZone("KST", 9*60*60))
+	pollNow = func() time.Time { return now }
+	t.Cleanup(func() { pollNow = time.Now })
+
+	router := setupHoursRouter()
+	lunch := models.Restaurant{Name: "백령 냉면", Address: "인천시 옹진군 백령면 1", Latitude: 37.9700, Longitude: 124.7100}
+	pub := models.Restaurant{Name: "백령 포차", Address: "인천시 옹진군 백령면 2", Latitude: 37.9720, Longitude: 124.7100}
+	require.NoError(t, database.DB.Create(&[]*models.Restaurant{&lunch, &pub}).Error)
+	hoursPath := func(r models.Restaurant) string { return fmt.Sprintf("/restaurants/%d/hours", r.ID) }
+
+	// 점심 냉면집: 월요일 휴무, 화~일 11:00~21:00, 브레이크타임 15:00~17:00
+	weekly := dto.UpdateOpeningHoursRequest{}
+	for weekday := 0; weekday <= 6; weekday++ {
+		if weekday != 1 {
+			weekly.Weekly = append(weekly.Weekly, dto.OpeningHoursDay{Weekday: weekday, Opens: "11:00", Closes: "21:00", BreakStart: "15:00", BreakEnd: "17:00"})
+		}
+	}
+	w := sendWebhookRequest(router, "PUT", hoursPath(lunch), weekly)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var hoursResponse dto.OpeningHoursResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &hoursResponse))
+	assert.Equal(t, "Asia/Seoul", hoursResponse.Timezone)
+	require.Len(t, hoursResponse.Weekly, 6)
+	assert.Equal(t, 0, hoursResponse.Weekly[0].Weekday)
+	assert.Equal(t, "15:00", hoursResponse.Weekly[0].BreakStart)
+
+	// 포차: 매일 18:00~02:00
+	w = sendWebhookRequest(router, "PUT", hoursPath(pub), dto.UpdateOpeningHoursRequest{Weekly: []dto.OpeningHoursDay{
+		{Weekday: 1, Opens: "18:00", Closes: "02:00"},
+		{Weekday: 2, Opens: "18:00", Closes: "02:00"},
+	}})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// 잘못된 영업시간은 필드별로 응답
+	w = sendWebhookRequest(router, "PUT", hoursPath(lunch), dto.UpdateOpeningHoursRequest{Weekly: []dto.OpeningHoursDay{
+		{Weekday: 2, Opens: "11:00", Closes: "21:00"},
+		{Weekday: 2, Opens: "11:00", Closes: "25:00"},
+		{Weekday: 7, Opens: "11:00", Closes: "21:00", BreakStart: "22:00", BreakEnd: "23:00"},
+	}})
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var errResponse apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errResponse))
+	fields := []string{}
+	for _, d := range errResponse.Details {
+		fields = append(fields, d.Field)
+	}
+	assert.Equal(t, []string{"weekly[1].weekday", "weekly[1].closes", "weekly[2].weekday", "weekly[2].breakStart"}, fields)
+
+	list := func(path string) []string {
+		t.Helper()
+		w := sendWebhookRequest(router, "GET", path, nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var response dto.ListResponse[dto.RestaurantResponse]
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		names := []string{}
+		for _, r := range response.Items {
+			names = append(names, r.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"백령 냉면"}, list("/v2/restaurants?open_at=now"))
+	assert.Empty(t, list("/v2/restaurants?open_at=2024-07-02T15:30"), "브레이크타임")
+	assert.Equal(t, []string{"백령 포차"}, list("/v2/restaurants?open_at=2024-07-02T01:00"), "월요일 밤부터 이어지는 영업")
+	assert.Empty(t, list("/v2/restaurants?open_at=2024-07-01T12:00"), "월요일 휴무")
+	assert.ElementsMatch(t, []string{"백령 냉면", "백령 포차"}, list("/v2/restaurants/recommendations?limit=10&open_at=2024-07-02T20:00"))
+
+	w = sendWebhookRequest(router, "GET", "/v2/restaurants?open_at=lunchtime", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = sendWebhookRequest(router, "GET", "/restaurants?open_at=2024-07-02T03:00:00Z", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var v1 []models.Restaurant
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &v1))
+	require.Len(t, v1, 1)
+	assert.Equal(t, lunch.ID, v1[0].ID)
+
+	// 날짜별 예외: 화요일 임시 휴업
+	holidayPath := fmt.Sprintf("/restaurants/%d/holidays/2024-07-02", lunch.ID)
+	w = sendWebhookRequest(router, "PUT", holidayPath, dto.HolidayRequest{Closed: true, Note: "임시 휴업"})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.Empty(t, list("/v2/restaurants?open_at=now"))
+
+	w = sendWebhookRequest(router, "PUT", holidayPath, dto.HolidayRequest{Opens: "11:00", Closes: "14:00", Note: "단축 영업"})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var holiday dto.HolidayResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &holiday))
+	assert.Equal(t, dto.HolidayResponse{Date: "2024-07-02", Opens: "11:00", Closes: "14:00", Note: "단축 영업"}, holiday)
+	assert.Equal(t, []string{"백령 냉면"}, list("/v2/restaurants?open_at=now"))
+
+	w = sendWebhookRequest(router, "PUT", holidayPath, dto.HolidayRequest{Opens: "11:00"})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = sendWebhookRequest(router, "PUT", fmt.Sprintf("/restaurants/%d/holidays/2024-7-2", lunch.ID), dto.HolidayRequest{Closed: true})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = sendWebhookRequest(router, "GET", hoursPath(lunch), nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &hoursResponse))
+	require.Len(t, hoursResponse.Holidays, 1)
+	assert.Equal(t, "2024-07-02", hoursResponse.Holidays[0].Date)
+
+	w = sendWebhookRequest(router, "DELETE", holidayPath, nil)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	w = sendWebhookRequest(router, "DELETE", holidayPath, nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errResponse))
+	assert.Equal(t, apierror.CodeHolidayNotFound, errResponse.Code)
+
+	w = sendWebhookRequest(router, "GET", "/restaurants/999999/hours", nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	// 근처 맛집 (가까운 순)
+	nearby := func(query string) dto.ListResponse[dto.NearbyRestaurantResponse] {
+		t.Helper()
+		w := sendWebhookRequest(router, "GET", "/v2/restaurants/nearby?lat=37.9700&lng=124.7100"+query, nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var response dto.ListResponse[dto.NearbyRestaurantResponse]
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response
+	}
+	all := nearby("&radius=500")
+	require.Len(t, all.Items, 2)
+	assert.Equal(t, lunch.ID, all.Items[0].Restaurant.ID)
+	assert.InDelta(t, 222, all.Items[1].DistanceMeters, 1)
+	assert.Len(t, nearby("&radius=100").Items, 1)
+	open := nearby("&open_at=2024-07-02T19:00")
+	require.Len(t, open.Items, 2)
+	open = nearby("&open_at=2024-07-02T22:00")
+	require.Len(t, open.Items, 1)
+	assert.Equal(t, pub.ID, open.Items[0].Restaurant.ID)
+
+	w = sendWebhookRequest(router, "GET", "/v2/restaurants/nearby?lat=91&radius=9000", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errResponse))
+	assert.Len(t, errResponse.Details, 3)
+	for _, query := range []string{"lat=NaN&lng=124.71", "lat=37.97&lng=Inf", "lat=37.97&lng=124.71&radius=NaN"} {
+		w = sendWebhookRequest(router, "GET", "/v2/restaurants/nearby?"+query, nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+	w = sendWebhookRequest(router, "GET", "/v2/restaurants/recommendations?limit=0", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
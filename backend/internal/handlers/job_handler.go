@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/scheduler"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// defaultJobRunList 실행 기록 목록 기본 건수
+	defaultJobRunList = 20
+	// maxJobRunList 실행 기록 목록 최대 건수
+	maxJobRunList = 100
+)
+
+// jobScheduler 예약 작업 실행기 (main에서 SetScheduler로 설정, 없으면 작업이 없는 것으로 응답)
+var jobScheduler *scheduler.Scheduler
+
+// SetScheduler 관리자 API가 보여 주고 실행할 예약 작업 실행기 설정
+func SetScheduler(s *scheduler.Scheduler) {
+	jobScheduler = s
+}
+
+// ListJobs godoc
+// @Summary List scheduled jobs (admin)
+// @Description Jobs run inside the API server with their cron schedule, next run, whether an instance is running them and the last run
+// @Tags admin
+// @Produce json
+// @Success 200 {object} dto.ListResponse[dto.JobResponse]
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/jobs [get]
+func ListJobs(c *gin.Context) {
+	if jobScheduler == nil {
+		c.JSON(http.StatusOK, dto.NewListResponse([]dto.JobResponse{}))
+		return
+	}
+	statuses, err := jobScheduler.Statuses(c.Request.Context())
+	if err != nil {
+		respondInternalError(c, i18n.JobLookupFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewJobResponses(statuses, jobScheduler.Location().String())))
+}
+
+// ListJobRuns godoc
+// @Summary List job runs (admin)
+// @Description Run history of a job, newest first
+// @Tags admin
+// @Produce json
+// @Param name path string true "Job name"
+// @Param limit query int false "Number of runs (default 20, max 100)"
+// @Success 200 {object} dto.ListResponse[dto.JobRunResponse]
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/jobs/{name}/runs [get]
+func ListJobRuns(c *gin.Context) {
+	limit := defaultJobRunList
+	if value := c.Query("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > maxJobRunList {
+			respondValidationError(c, []apierror.FieldError{fieldError(c, "limit", "invalid", i18n.ValidationInvalid, "limit")})
+			return
+		}
+		limit = n
+	}
+	if jobScheduler == nil {
+		respondError(c, http.StatusNotFound, apierror.CodeJobNotFound, i18n.JobNotFound)
+		return
+	}
+
+	runs, err := jobScheduler.Runs(c.Request.Context(), c.Param("name"), limit)
+	if errors.Is(err, scheduler.ErrNotFound) {
+		respondError(c, http.StatusNotFound, apierror.CodeJobNotFound, i18n.JobNotFound)
+		return
+	}
+	if err != nil {
+		respondInternalError(c, i18n.JobLookupFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewJobRunResponses(runs)))
+}
+
+// RunJob godoc
+// @Summary Run a job now (admin)
+// @Description Start the job in the background without changing its schedule. Works for jobs whose schedule is off. Follow the result in the run history
+// @Tags admin
+// @Produce json
+// @Param name path string true "Job name"
+// @Success 202 {object} dto.JobRunResponse
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/jobs/{name}/run [post]
+func RunJob(c *gin.Context) {
+	if jobScheduler == nil {
+		respondError(c, http.StatusNotFound, apierror.CodeJobNotFound, i18n.JobNotFound)
+		return
+	}
+	run, err := jobScheduler.Trigger(c.Request.Context(), c.Param("name"))
+	switch {
+	case errors.Is(err, scheduler.ErrNotFound):
+		respondError(c, http.StatusNotFound, apierror.CodeJobNotFound, i18n.JobNotFound)
+		return
+	case errors.Is(err, scheduler.ErrRunning):
+		respondError(c, http.StatusConflict, apierror.CodeJobRunning, i18n.JobRunning)
+		return
+	case err != nil:
+		respondInternalError(c, i18n.JobTriggerFailed, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, dto.NewJobRunResponse(run))
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/scheduler"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupJobRouter(t *testing.T, s *scheduler.Scheduler) *gin.Engine {
+	SetScheduler(s)
+	t.Cleanup(func() { SetScheduler(nil) })
+
+	router := setupRouter()
+	router.GET("/admin/jobs", ListJobs)
+	router.GET("/admin/jobs/:name/runs", ListJobRuns)
+	router.POST("/admin/jobs/:name/run", RunJob)
+	return router
+}
+
+func TestJobs(t *testing.T) {
+	s := scheduler.New(database.DB, scheduler.Config{Location: time.UTC, Owner: "test-instance"})
+	release := make(chan struct{})
+	require.NoError(t, s.Register(scheduler.Job{Name: "handler-nightly", Description: "테스트 작업", Spec: "0 4 * * *", Run: func(ctx context.Context) (string, error) {
+		<-release
+		return "deleted 3", nil
+	}}))
+	require.NoError(t, s.Register(scheduler.Job{Name: "handler-manual", Spec: scheduler.Off, Run: func(ctx context.Context) (string, error) {
+		return "", nil
+	}}))
+	s.Start(context.Background())
+	router := setupJobRouter(t, s)
+
+	w := sendWebhookRequest(router, "GET", "/admin/jobs", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var jobs dto.ListResponse[dto.JobResponse]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &jobs))
+	require.Len(t, jobs.Items, 2)
+	assert.Equal(t, "handler-nightly", jobs.Items[0].Name)
+	assert.Equal(t, "UTC", jobs.Items[0].Timezone)
+	assert.True(t, jobs.Items[0].Enabled)
+	require.NotNil(t, jobs.Items[0].NextRunAt)
+	assert.Equal(t, 4, jobs.Items[0].NextRunAt.UTC().Hour())
+	assert.False(t, jobs.Items[1].Enabled)
+	assert.Nil(t, jobs.Items[1].NextRunAt)
+	assert.Nil(t, jobs.Items[1].LastRun)
+
+	w = sendWebhookRequest(router, "POST", "/admin/jobs/handler-nightly/run", nil)
+	require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+	var run dto.JobRunResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &run))
+	assert.Equal(t, models.JobRunRunning, run.Status)
+	assert.Equal(t, models.JobTriggerManual, run.Trigger)
+	assert.Equal(t, "test-instance", run.Instance)
+	assert.Nil(t, run.DurationMs)
+
+	w = sendWebhookRequest(router, "POST", "/admin/jobs/handler-nightly/run", nil)
+	require.Equal(t, http.StatusConflict, w.Code)
+	var response apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierror.CodeJobRunning, response.Code)
+
+	w = sendWebhookRequest(router, "GET", "/admin/jobs", nil)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &jobs))
+	assert.True(t, jobs.Items[0].Running)
+	assert.Equal(t, "test-instance", jobs.Items[0].RunningOn)
+
+	close(release)
+	s.Wait()
+
+	w = sendWebhookRequest(router, "GET", "/admin/jobs/handler-nightly/runs", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var runs dto.ListResponse[dto.JobRunResponse]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &runs))
+	require.Len(t, runs.Items, 1)
+	assert.Equal(t, run.ID, runs.Items[0].ID)
+	assert.Equal(t, models.JobRunSucceeded, runs.Items[0].Status)
+	assert.Equal(t, "deleted 3", runs.Items[0].Summary)
+	assert.NotNil(t, runs.Items[0].DurationMs)
+
+	w = sendWebhookRequest(router, "GET", "/admin/jobs/handler-nightly/runs?limit=0", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = sendWebhookRequest(router, "GET", "/admin/jobs/unknown/runs", nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = sendWebhookRequest(router, "POST", "/admin/jobs/unknown/run", nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/kakao"
+	"lunch_app/backend/internal/logger"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/places"
+	"lunch_app/backend/internal/recommend"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	// kakaoRecommendCount "오늘 뭐 먹지"로 추천하는 맛집 수
+	kakaoRecommendCount = 5
+	// kakaoNearbyRadiusMeters "근처 맛집" 검색 반경
+	kakaoNearbyRadiusMeters = 1000
+	// kakaoRecentVisits "방문 기록"에 보여 주는 최근 방문 수
+	kakaoRecentVisits = 10
+	// defaultKakaoThumbnailURL basicCard 썸네일 기본값 (오픈빌더는 basicCard에 썸네일을 요구)
+	defaultKakaoThumbnailURL = "https://lunch-app-spd2.onrender.com/logo512.png"
+)
+
+// placeSearcher 위치 이름을 좌표로 바꾸는 장소 검색기 (main에서 SetPlaceSearcher로 설정, nil이면 근처 맛집 안내만 응답)
+var placeSearcher places.PlaceSearcher
+
+// SetPlaceSearcher 카카오톡 "근처 맛집"에서 위치를 찾을 장소 검색기 설정
+func SetPlaceSearcher(searcher places.PlaceSearcher) {
+	placeSearcher = searcher
+}
+
+// KakaoSkill godoc
+// @Summary KakaoTalk chatbot skill
+// @Description Kakao i Open Builder skill endpoint. Intents (by block name or utterance): "오늘 뭐 먹지" (recommend), "<location> 근처 맛집" (saved restaurants nearby), "방문 기록" (recent visits) and "방문 기록 <restaurant>" (log a visit). Responds with skill response 2.0 templates (simpleText, basicCard, carousel). Requests must carry X-Kakao-Skill-Token (KAKAO_SKILL_TOKEN)
+// @Tags kakao
+// @Accept json
+// @Produce json
+// @Param X-Kakao-Skill-Token header string true "KAKAO_SKILL_TOKEN"
+// @Param request body kakao.SkillRequest true "Skill request"
+// @Success 200 {object} kakao.Response
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Router /kakao/skill [post]
+func KakaoSkill(c *gin.Context) {
+	var req kakao.SkillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, apierror.CodeInvalidRequestBody, i18n.KakaoInvalidPayload)
+		return
+	}
+	setChatActor(c, "kakao:"+req.UserRequest.User.ID)
+	lang := kakaoLang(c, req)
+
+	command := kakao.ParseCommand(req)
+	var response kakao.Response
+	switch command.Type {
+	case kakao.CommandRecommend:
+		response = kakaoRecommend(c, lang)
+	case kakao.CommandNearby:
+		response = kakaoNearby(c, lang, command.Argument)
+	case kakao.CommandVisits:
+		if command.Argument == "" {
+			response = kakaoVisits(c, lang)
+		} else {
+			response = kakaoLogVisit(c, lang, command.Argument)
+		}
+	default:
+		response = kakao.HelpResponse(lang)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// kakaoRecommend 최근 방문하지 않은 맛집 우선으로 추천
+func kakaoRecommend(c *gin.Context, lang i18n.Lang) kakao.Response {
+	restaurants, err := recommend.Restaurants(db(c), recommend.Options{Limit: kakaoRecommendCount, Now: pollNow()})
+	if err != nil {
+		return kakaoFailed(c, lang, err)
+	}
+	if len(restaurants) == 0 {
+		return kakao.TextResponse(lang, i18n.T(lang, i18n.KakaoNoRestaurants))
+	}
+	return kakao.RecommendResponse(lang, restaurants, kakaoThumbnailURL())
+}
+
+// kakaoNearby 장소 검색으로 찾은 위치에서 kakaoNearbyRadiusMeters 안의 등록 맛집 (가까운 순)
+func kakaoNearby(c *gin.Context, lang i18n.Lang, location string) kakao.Response {
+	if location == "" {
+		return kakao.TextResponse(lang, i18n.T(lang, i18n.KakaoLocationRequired))
+	}
+	if placeSearcher == nil {
+		return kakao.TextResponse(lang, i18n.T(lang, i18n.KakaoNearbyUnavailable))
+	}
+	result, err := placeSearcher.SearchKeyword(c.Request.Context(), location, places.SearchOptions{Size: 1})
+	if err != nil {
+		return kakaoFailed(c, lang, err)
+	}
+	if len(result.Places) == 0 {
+		return kakao.TextResponse(lang, i18n.T(lang, i18n.KakaoLocationNotFound, location))
+	}
+	center := result.Places[0]
+
+	nearby, err := findNearbyRestaurants(db(c), center.Latitude, center.Longitude, kakaoNearbyRadiusMeters)
+	if err != nil {
+		return kakaoFailed(c, lang, err)
+	}
+	if len(nearby) > kakao.MaxCarouselItems {
+		nearby = nearby[:kakao.MaxCarouselItems]
+	}
+	return kakao.NearbyResponse(lang, center.Name, kakaoNearbyRadiusMeters, nearby, kakaoThumbnailURL())
+}
+
+// kakaoVisits 최근 방문 기록
+func kakaoVisits(c *gin.Context, lang i18n.Lang) kakao.Response {
+	var visits []models.Visit
+	if err := db(c).Order("visit_date DESC, id DESC").Limit(kakaoRecentVisits).Find(&visits).Error; err != nil {
+		return kakaoFailed(c, lang, err)
+	}
+	return kakao.VisitsResponse(lang, visits)
+}
+
+// kakaoLogVisit 맛집 이름 또는 "#ID"로 찾은 맛집의 방문 기록 (여러 곳이 나오면 고르는 카드)
+// 카카오톡 사용자 ID는 봇마다 다른 임의 값이라 방문자는 비워 둠
+func kakaoLogVisit(c *gin.Context, lang i18n.Lang, argument string) kakao.Response {
+	var matches []models.Restaurant
+	if id, ok := kakao.RestaurantRef(argument); ok {
+		var restaurant models.Restaurant
+		err := db(c).First(&restaurant, id).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return kakaoFailed(c, lang, err)
+		}
+		if err == nil {
+			matches = append(matches, restaurant)
+		}
+	} else {
+		found, err := findRestaurantsByName(c, argument)
+		if err != nil {
+			return kakaoFailed(c, lang, err)
+		}
+		matches = found
+	}
+
+	switch len(matches) {
+	case 0:
+		return kakao.TextResponse(lang, i18n.T(lang, i18n.KakaoRestaurantNotFound, argument))
+	case 1:
+		if err := recordChatVisit(c, "", matches[0]); err != nil {
+			return kakaoFailed(c, lang, err)
+		}
+		return kakao.TextResponse(lang, i18n.T(lang, i18n.KakaoVisitLogged, matches[0].Name))
+	default:
+		return kakao.ChooseRestaurantResponse(lang, argument, matches, kakaoThumbnailURL())
+	}
+}
+
+// kakaoFailed 내부 오류를 로그로 남기고 실패 안내 응답 반환
+// 오픈빌더는 200이 아닌 응답이면 봇의 기본 오류 메시지를 보여 주므로 에러도 200 응답으로 알림
+func kakaoFailed(c *gin.Context, lang i18n.Lang, err error) kakao.Response {
+	ctx := c.Request.Context()
+	logger.FromContext(ctx).ErrorContext(ctx, "카카오톡 스킬 요청 처리 실패", "error", err)
+	return kakao.TextResponse(lang, i18n.T(lang, i18n.KakaoFailed))
+}
+
+// kakaoLang 스킬 요청의 언어 (없으면 Accept-Language)
+func kakaoLang(c *gin.Context, req kakao.SkillRequest) i18n.Lang {
+	switch {
+	case strings.HasPrefix(req.UserRequest.Lang, "en"):
+		return i18n.EN
+	case strings.HasPrefix(req.UserRequest.Lang, "ko"):
+		return i18n.KO
+	}
+	return i18n.FromRequest(c.Request)
+}
+
+// kakaoThumbnailURL basicCard 썸네일 주소 (KAKAO_CARD_THUMBNAIL_URL, 없으면 프론트엔드 로고)
+func kakaoThumbnailURL() string {
+	if url := os.Getenv("KAKAO_CARD_THUMBNAIL_URL"); url != "" {
+		return url
+	}
+	return defaultKakaoThumbnailURL
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/kakao"
+	"lunch_app/backend/internal/middleware"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/places"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKakaoToken = "test-skill-token"
+
+func setupKakaoRouter(t *testing.T) *gin.Engine {
+	t.Setenv("KAKAO_SKILL_TOKEN", testKakaoToken)
+	t.Setenv("KAKAO_CARD_THUMBNAIL_URL", "https://example.com/logo.png")
+	// 다른 테스트의 방문 기록보다 최근이 되도록 먼 미래로 고정
+	now := time.Date(2099, 7, 5, 3, 0, 0, 0, time.UTC)
+	pollNow = func() time.Time { return now }
+	SetPlaceSearcher(places.NewFake(places.Place{ID: "1", Name: "제주시청", Latitude: 33.4996, Longitude: 126.5312}))
+	t.Cleanup(func() {
+		pollNow = time.Now
+		SetPlaceSearcher(nil)
+	})
+
+	router := setupRouter()
+	router.POST("/kakao/skill", middleware.KakaoSkill(), KakaoSkill)
+	return router
+}
+
+// sendKakaoSkill 녹화한 스킬 요청의 블록 이름과 발화만 바꿔서 전송
+func sendKakaoSkill(t *testing.T, router *gin.Engine, block, utterance string) kakao.Response {
+	t.Helper()
+	recorded, err := os.ReadFile("testdata/kakao/skill_request.json")
+	require.NoError(t, err)
+	body := strings.NewReplacer("{{block}}", block, "{{utterance}}", utterance, "{{lang}}", "ko").Replace(string(recorded))
+
+	req, _ := http.NewRequest("POST", "/kakao/skill", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(kakao.TokenHeader, testKakaoToken)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var response kakao.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, kakao.Version, response.Version)
+	return response
+}
+
+func outputText(response kakao.Response) string {
+	if len(response.Template.Outputs) == 0 || response.Template.Outputs[0].SimpleText == nil {
+		return ""
+	}
+	return response.Template.Outputs[0].SimpleText.Text
+}
+
+func TestKakaoSkillRecommendAndNearby(t *testing.T) {
+	router := setupKakaoRouter(t)
+	restaurants := []models.Restaurant{
+		{Name: "카카오 흑돼지", Address: "제주시 1", Category: "고기", Latitude: 33.5006, Longitude: 126.5312},
+		{Name: "카카오 고기국수", Address: "제주시 2", Category: "국수", Latitude: 33.5036, Longitude: 126.5312},
+		{Name: "카카오 먼 식당", Address: "제주시 3", Latitude: 33.5196, Longitude: 126.5312},
+	}
+	require.NoError(t, database.DB.Create(&restaurants).Error)
+
+	response := sendKakaoSkill(t, router, "오늘 뭐 먹지", "오늘 뭐 먹지")
+	require.Len(t, response.Template.Outputs, 2)
+	carousel := response.Template.Outputs[1].Carousel
+	require.NotNil(t, carousel)
+	assert.GreaterOrEqual(t, len(carousel.Items), len(restaurants))
+	assert.LessOrEqual(t, len(carousel.Items), kakaoRecommendCount)
+	assert.Equal(t, "https://example.com/logo.png", carousel.Items[0].Thumbnail.ImageURL)
+	assert.Len(t, response.Template.QuickReplies, 2)
+
+	// 가까운 순, 반경 밖은 제외
+	response = sendKakaoSkill(t, router, "", "제주시청 근처 맛집")
+	assert.Equal(t, "제주시청 근처 1000m 안의 맛집이에요", outputText(response))
+	carousel = response.Template.Outputs[1].Carousel
+	require.NotNil(t, carousel)
+	require.Len(t, carousel.Items, 2)
+	assert.Equal(t, "카카오 흑돼지", carousel.Items[0].Title)
+	assert.Equal(t, "고기 · 111m\n제주시 1", carousel.Items[0].Description)
+	assert.Equal(t, "카카오 고기국수", carousel.Items[1].Title)
+
+	assert.Equal(t, "'없는 동네' 위치를 찾을 수 없습니다", outputText(sendKakaoSkill(t, router, "", "없는 동네 근처 맛집")))
+	assert.Contains(t, outputText(sendKakaoSkill(t, router, "근처 맛집", "근처 맛집")), "어디 근처인지")
+	assert.Contains(t, outputText(sendKakaoSkill(t, router, "폴백 블록", "안녕")), "오늘 뭐 먹지 — 맛집 추천")
+
+	SetPlaceSearcher(nil)
+	assert.Equal(t, "위치 검색을 사용할 수 없습니다", outputText(sendKakaoSkill(t, router, "", "제주시청 근처 맛집")))
+}
+
+func TestKakaoSkillVisits(t *testing.T) {
+	router := setupKakaoRouter(t)
+	restaurants := []models.Restaurant{
+		{Name: "카카오 칼국수", Address: "서울시 카카오구 1"},
+		{Name: "카카오 칼국수 2호점", Address: "서울시 카카오구 2"},
+	}
+	require.NoError(t, database.DB.Create(&restaurants).Error)
+
+	assert.Equal(t, "카카오 칼국수 방문을 기록했습니다", outputText(sendKakaoSkill(t, router, "방문 기록", "방문 기록 카카오 칼국수")))
+	var visit models.Visit
+	require.NoError(t, database.DB.Where("restaurant_id = ?", restaurants[0].ID).First(&visit).Error)
+	assert.Empty(t, visit.Visitor)
+	var log models.AuditLog
+	require.NoError(t, database.DB.Where("entity_type = ? AND entity_id = ?", "visits", visit.ID).First(&log).Error)
+	assert.True(t, strings.HasPrefix(log.Actor, "kakao:"), log.Actor)
+
+	// 여러 곳이 나오면 ID를 보내는 버튼으로 선택
+	response := sendKakaoSkill(t, router, "", "방문 기록 카카오 칼국")
+	carousel := response.Template.Outputs[1].Carousel
+	require.NotNil(t, carousel)
+	require.Len(t, carousel.Items, 2)
+	choice := carousel.Items[1].Buttons[1].MessageText
+	assert.True(t, strings.HasPrefix(choice, "방문 기록 #"), choice)
+	assert.Equal(t, "카카오 칼국수 2호점 방문을 기록했습니다", outputText(sendKakaoSkill(t, router, "", choice)))
+
+	response = sendKakaoSkill(t, router, "방문 기록", "방문 기록")
+	lines := strings.Split(outputText(response), "\n")
+	require.GreaterOrEqual(t, len(lines), 3)
+	assert.Equal(t, []string{"최근 방문 기록", "• 2099-07-05 카카오 칼국수 2호점", "• 2099-07-05 카카오 칼국수"}, lines[:3])
+
+	assert.Equal(t, "'없는 맛집' 맛집을 찾을 수 없습니다", outputText(sendKakaoSkill(t, router, "", "방문 기록 없는 맛집")))
+	assert.Equal(t, "'#999999' 맛집을 찾을 수 없습니다", outputText(sendKakaoSkill(t, router, "", "방문 기록 #999999")))
+}
+
+func TestKakaoSkillAuth(t *testing.T) {
+	router := setupKakaoRouter(t)
+	send := func(token, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("POST", "/kakao/skill", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set(kakao.TokenHeader, token)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, send("", "{}").Code)
+	assert.Equal(t, http.StatusUnauthorized, send("wrong", "{}").Code)
+	assert.Equal(t, http.StatusBadRequest, send(testKakaoToken, "{").Code)
+
+	t.Setenv("KAKAO_SKILL_TOKEN", "")
+	w := send(testKakaoToken, "{}")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	var response apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierror.CodeKakaoDisabled, response.Code)
+}
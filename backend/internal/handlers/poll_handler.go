@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/middleware"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/poll"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxPollList 투표 목록 최대 건수
+const maxPollList = 50
+
+// pollNow 현재 시각 (테스트에서 고정 시각 주입용)
+var pollNow = time.Now
+
+// CreatePoll godoc
+// @Summary Create a lunch poll
+// @Description Create today's poll. Candidates are restaurantIds in order, followed by autoSeed recommended restaurants (not visited in the last 7 days first)
+// @Tags polls
+// @Accept json
+// @Produce json
+// @Param X-Actor header string false "Poll creator"
+// @Param poll body dto.CreatePollRequest true "Poll"
+// @Success 201 {object} dto.PollResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /polls [post]
+func CreatePoll(c *gin.Context) {
+	var req dto.CreatePollRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindError(c, err)
+		return
+	}
+
+	created, err := poll.Create(db(c), poll.CreateInput{
+		Team:          strings.TrimSpace(req.Team),
+		Title:         strings.TrimSpace(req.Title),
+		CreatedBy:     actorName(c),
+		Deadline:      req.Deadline,
+		RestaurantIDs: req.RestaurantIDs,
+		AutoSeed:      req.AutoSeed,
+	}, pollNow())
+	var notFound *poll.RestaurantNotFoundError
+	switch {
+	case errors.Is(err, poll.ErrInvalidDeadline):
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "deadline", "invalid", i18n.PollInvalidDeadline)})
+		return
+	case errors.Is(err, poll.ErrTooManyCandidates):
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "restaurantIds", "max", i18n.PollTooManyCandidates, poll.MaxCandidates)})
+		return
+	case errors.Is(err, poll.ErrNoCandidates):
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "restaurantIds", "required", i18n.PollNoCandidates)})
+		return
+	case errors.As(err, &notFound):
+		apierror.Abort(c, http.StatusNotFound, apierror.CodeRestaurantNotFound, i18n.Message(c, i18n.PollRestaurantNotFound, notFound.ID))
+		return
+	case err != nil:
+		respondInternalError(c, i18n.PollCreateFailed, err)
+		return
+	}
+	response := dto.NewPollResponse(created)
+	publishEvent(c, created.Team, events.PollCreated, response)
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListPolls godoc
+// @Summary List lunch polls
+// @Description Newest first, at most 50
+// @Tags polls
+// @Produce json
+// @Param team query string false "Team"
+// @Param status query string false "open or closed"
+// @Param date query string false "Lunch date (YYYY-MM-DD, Asia/Seoul)"
+// @Success 200 {object} dto.ListResponse[dto.PollResponse]
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /polls [get]
+func ListPolls(c *gin.Context) {
+	query := db(c).Scopes(poll.WithDetails)
+
+	var details []apierror.FieldError
+	if team := c.Query("team"); team != "" {
+		query = query.Where("team = ?", team)
+	}
+	if status := c.Query("status"); status != "" {
+		if status != models.PollStatusOpen && status != models.PollStatusClosed {
+			details = append(details, fieldError(c, "status", "oneof", i18n.PollInvalidStatus))
+		}
+		query = query.Where("status = ?", status)
+	}
+	if value := c.Query("date"); value != "" {
+		date, err := kst.ParseDate(value)
+		if err != nil {
+			details = append(details, fieldError(c, "date", "invalid", i18n.PollInvalidDate))
+		}
+		query = query.Where("date = ?", date)
+	}
+	if len(details) > 0 {
+		respondValidationError(c, details)
+		return
+	}
+
+	var polls []models.Poll
+	if err := query.Order("id DESC").Limit(maxPollList).Find(&polls).Error; err != nil {
+		respondInternalError(c, i18n.PollLookupFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewPollResponses(polls)))
+}
+
+// GetPoll godoc
+// @Summary Get a lunch poll
+// @Description Poll with candidates, vote counts and voters
+// @Tags polls
+// @Produce json
+// @Param id path int true "Poll ID"
+// @Success 200 {object} dto.PollResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /polls/{id} [get]
+func GetPoll(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+	found, err := poll.Get(db(c), id)
+	if err != nil {
+		respondPollError(c, err, i18n.PollLookupFailed)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewPollResponse(found))
+}
+
+// VotePoll godoc
+// @Summary Vote in a lunch poll
+// @Description One vote per voter; voting again moves the vote. voter defaults to the X-Actor header
+// @Tags polls
+// @Accept json
+// @Produce json
+// @Param id path int true "Poll ID"
+// @Param X-Actor header string false "Voter name when voter is omitted"
+// @Param vote body dto.VoteRequest true "Vote"
+// @Success 200 {object} dto.PollResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /polls/{id}/votes [post]
+func VotePoll(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+	var req dto.VoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindError(c, err)
+		return
+	}
+	voter := strings.TrimSpace(req.Voter)
+	if voter == "" {
+		voter = actorName(c)
+	}
+	if voter == "" {
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "voter", "required", i18n.PollVoterRequired)})
+		return
+	}
+
+	if _, err := poll.Vote(db(c), id, req.CandidateID, voter, pollNow()); err != nil {
+		if errors.Is(err, poll.ErrCandidateNotFound) {
+			respondValidationError(c, []apierror.FieldError{fieldError(c, "candidateId", "invalid", i18n.PollCandidateNotFound)})
+			return
+		}
+		respondPollError(c, err, i18n.PollVoteFailed)
+		return
+	}
+
+	updated, err := poll.Get(db(c), id)
+	if err != nil {
+		respondPollError(c, err, i18n.PollLookupFailed)
+		return
+	}
+	response := dto.NewPollResponse(updated)
+	publishEvent(c, updated.Team, events.PollVoted, response)
+	c.JSON(http.StatusOK, response)
+}
+
+// ClosePoll godoc
+// @Summary Close a lunch poll
+// @Description Close the poll now and record a visit at the winning restaurant for every voter. Polls are also closed automatically at their deadline
+// @Tags polls
+// @Produce json
+// @Param id path int true "Poll ID"
+// @Success 200 {object} dto.ClosePollResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /polls/{id}/close [post]
+func ClosePoll(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+	result, err := poll.Close(db(c), id, pollNow())
+	if err != nil {
+		respondPollError(c, err, i18n.PollCloseFailed)
+		return
+	}
+	response := dto.NewClosePollResponse(result)
+	publishEvent(c, result.Poll.Team, events.PollClosed, response)
+	c.JSON(http.StatusOK, response)
+}
+
+// respondPollError 투표 공통 에러(없음, 마감됨)를 응답으로 변환하고 그 외에는 500
+func respondPollError(c *gin.Context, err error, failed i18n.Key) {
+	switch {
+	case errors.Is(err, poll.ErrNotFound):
+		respondError(c, http.StatusNotFound, apierror.CodePollNotFound, i18n.PollNotFound)
+	case errors.Is(err, poll.ErrClosed):
+		respondError(c, http.StatusConflict, apierror.CodePollClosed, i18n.PollClosed)
+	default:
+		respondInternalError(c, failed, err)
+	}
+}
+
+// actorName X-Actor 헤더의 사용자 이름 (없으면 빈 문자열)
+func actorName(c *gin.Context) string {
+	name := strings.TrimSpace(c.GetHeader(middleware.ActorHeader))
+	if len(name) > 64 {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/middleware"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPollRouter(t *testing.T, now time.Time) *gin.Engine {
+	pollNow = func() time.Time { return now }
+	t.Cleanup(func() { pollNow = time.Now })
+
+	router := setupRouter()
+	router.GET("/polls", ListPolls)
+	router.POST("/polls", CreatePoll)
+	router.GET("/polls/:id", GetPoll)
+	router.POST("/polls/:id/votes", VotePoll)
+	router.POST("/polls/:id/close", ClosePoll)
+	return router
+}
+
+func sendPollRequest(router *gin.Engine, method, path, actor string, body any) *httptest.ResponseRecorder {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req, _ := http.NewRequest(method, path, bytes.NewBuffer(payload))
+	req.Header.Set("Content-Type", "application/json")
+	if actor != "" {
+		req.Header.Set(middleware.ActorHeader, actor)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestPollLifecycle(t *testing.T) {
+	// 2024-07-01 11:00 KST
+	now := time.Date(2024, 7, 1, 2, 0, 0, 0, time.UTC)
+	router := setupPollRouter(t, now)
+
+	restaurants := []models.Restaurant{
+		{Name: "투표 국밥집", Address: "서울시 투표구 1", Category: "한식"},
+		{Name: "투표 초밥집", Address: "서울시 투표구 2", Category: "일식"},
+	}
+	require.NoError(t, database.DB.Create(&restaurants).Error)
+
+	w := sendPollRequest(router, "POST", "/polls", "민수", dto.CreatePollRequest{
+		Team:          "poll-test",
+		Title:         "월요일 점심",
+		Deadline:      now.Add(time.Hour),
+		RestaurantIDs: []uint{restaurants[0].ID, restaurants[1].ID},
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created dto.PollResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, "2024-07-01", created.Date)
+	assert.Equal(t, "민수", created.CreatedBy)
+	assert.Equal(t, models.PollStatusOpen, created.Status)
+	require.Len(t, created.Candidates, 2)
+	gukbap, sushi := created.Candidates[0].ID, created.Candidates[1].ID
+
+	pollPath := fmt.Sprintf("/polls/%d", created.ID)
+	// voter를 생략하면 X-Actor 헤더의 이름으로 투표
+	w = sendPollRequest(router, "POST", pollPath+"/votes", "민수", dto.VoteRequest{CandidateID: sushi})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	w = sendPollRequest(router, "POST", pollPath+"/votes", "", dto.VoteRequest{CandidateID: gukbap, Voter: "지영"})
+	require.Equal(t, http.StatusOK, w.Code)
+	w = sendPollRequest(router, "POST", pollPath+"/votes", "", dto.VoteRequest{CandidateID: gukbap, Voter: "철수"})
+	require.Equal(t, http.StatusOK, w.Code)
+	var voted dto.PollResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &voted))
+	assert.Equal(t, 3, voted.TotalVotes)
+	assert.Equal(t, 2, voted.Candidates[0].Votes)
+	assert.ElementsMatch(t, []string{"지영", "철수"}, voted.Candidates[0].Voters)
+
+	w = sendPollRequest(router, "GET", "/polls?team=poll-test&status=open&date=2024-07-01", "", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var list dto.ListResponse[dto.PollResponse]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	require.Equal(t, 1, list.Total)
+	assert.Equal(t, created.ID, list.Items[0].ID)
+
+	w = sendPollRequest(router, "POST", pollPath+"/close", "", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var closed dto.ClosePollResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &closed))
+	assert.Equal(t, models.PollStatusClosed, closed.Poll.Status)
+	require.NotNil(t, closed.Poll.WinnerCandidateID)
+	assert.Equal(t, gukbap, *closed.Poll.WinnerCandidateID)
+	require.Len(t, closed.VisitIDs, 3)
+
+	var visits []models.Visit
+	require.NoError(t, database.DB.Where("poll_id = ?", created.ID).Order("visitor").Find(&visits).Error)
+	require.Len(t, visits, 3)
+	for _, visit := range visits {
+		assert.Equal(t, restaurants[0].ID, visit.RestaurantID)
+	}
+	assert.Equal(t, []string{"민수", "지영", "철수"}, []string{visits[0].Visitor, visits[1].Visitor, visits[2].Visitor})
+
+	// 마감 후 투표와 재마감은 409
+	for _, path := range []string{pollPath + "/votes", pollPath + "/close"} {
+		w = sendPollRequest(router, "POST", path, "영희", dto.VoteRequest{CandidateID: gukbap})
+		require.Equal(t, http.StatusConflict, w.Code, path)
+		var errResponse apierror.Response
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errResponse))
+		assert.Equal(t, apierror.CodePollClosed, errResponse.Code)
+	}
+}
+
+func TestPoll_Errors(t *testing.T) {
+	now := time.Date(2024, 7, 2, 2, 0, 0, 0, time.UTC)
+	router := setupPollRouter(t, now)
+
+	restaurant := models.Restaurant{Name: "투표 에러 맛집", Address: "서울시 투표구 3"}
+	require.NoError(t, database.DB.Create(&restaurant).Error)
+
+	errorCode := func(w *httptest.ResponseRecorder) (apierror.Code, []string) {
+		var response apierror.Response
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		fields := make([]string, 0, len(response.Details))
+		for _, detail := range response.Details {
+			fields = append(fields, detail.Field+":"+detail.Reason)
+		}
+		return response.Code, fields
+	}
+
+	w := sendPollRequest(router, "POST", "/polls", "", dto.CreatePollRequest{Deadline: now.Add(-time.Minute), RestaurantIDs: []uint{restaurant.ID}})
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	code, fields := errorCode(w)
+	assert.Equal(t, apierror.CodeValidationFailed, code)
+	assert.Equal(t, []string{"deadline:invalid"}, fields)
+
+	w = sendPollRequest(router, "POST", "/polls", "", dto.CreatePollRequest{Deadline: now.Add(time.Hour), RestaurantIDs: []uint{999999}})
+	require.Equal(t, http.StatusNotFound, w.Code)
+	code, _ = errorCode(w)
+	assert.Equal(t, apierror.CodeRestaurantNotFound, code)
+
+	w = sendPollRequest(router, "GET", "/polls/999999", "", nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+	code, _ = errorCode(w)
+	assert.Equal(t, apierror.CodePollNotFound, code)
+
+	w = sendPollRequest(router, "GET", "/polls?status=pending&date=2024/07/02", "", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	_, fields = errorCode(w)
+	assert.Equal(t, []string{"status:oneof", "date:invalid"}, fields)
+
+	w = sendPollRequest(router, "POST", "/polls", "", dto.CreatePollRequest{Deadline: now.Add(time.Hour), RestaurantIDs: []uint{restaurant.ID}})
+	require.Equal(t, http.StatusCreated, w.Code)
+	var created dto.PollResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	votePath := fmt.Sprintf("/polls/%d/votes", created.ID)
+
+	w = sendPollRequest(router, "POST", votePath, "", dto.VoteRequest{CandidateID: created.Candidates[0].ID})
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	_, fields = errorCode(w)
+	assert.Equal(t, []string{"voter:required"}, fields)
+
+	w = sendPollRequest(router, "POST", votePath, "민수", dto.VoteRequest{CandidateID: 999999})
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	_, fields = errorCode(w)
+	assert.Equal(t, []string{"candidateId:invalid"}, fields)
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/logger"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+func init() {
+	// 검증 에러 details의 field를 Go 필드명 대신 JSON 키로 표시
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "" || name == "-" {
+				return field.Name
+			}
+			return name
+		})
+	}
+}
+
+// MessageResponse 처리 결과 메시지만 담는 응답
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+// db 요청 컨텍스트(요청 ID 포함)가 연결된 DB 핸들 반환
+func db(c *gin.Context) *gorm.DB {
+	return database.DB.WithContext(c.Request.Context())
+}
+
+// respondError 요청 언어에 맞는 메시지로 공통 에러 형식 응답
+func respondError(c *gin.Context, status int, code apierror.Code, key i18n.Key, details ...apierror.FieldError) {
+	apierror.Abort(c, status, code, i18n.Message(c, key), details...)
+}
+
+// respondInternalError 원인 에러를 로그로 남기고 500 에러 응답 반환
+func respondInternalError(c *gin.Context, key i18n.Key, err error) {
+	ctx := c.Request.Context()
+	logger.FromContext(ctx).ErrorContext(ctx, i18n.T(i18n.DefaultLang, key), "error", err, "message_key", key)
+	respondError(c, http.StatusInternalServerError, apierror.CodeInternal, key)
+}
+
+// fieldError 요청 언어로 번역된 필드 검증 실패 정보 생성
+func fieldError(c *gin.Context, field, reason string, key i18n.Key, args ...any) apierror.FieldError {
+	return apierror.FieldError{Field: field, Reason: reason, Message: i18n.Message(c, key, args...)}
+}
+
+// respondValidationError 필드 검증 실패 응답 (첫 번째 필드 메시지를 대표 메시지로 사용)
+func respondValidationError(c *gin.Context, details []apierror.FieldError) {
+	apierror.Abort(c, http.StatusBadRequest, apierror.CodeValidationFailed, details[0].Message, details...)
+}
+
+// respondBindError ShouldBindJSON 실패를 검증 에러 또는 잘못된 요청 본문 에러로 변환
+// validator 원문 메시지는 노출하지 않음
+func respondBindError(c *gin.Context, err error) {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		respondError(c, http.StatusBadRequest, apierror.CodeInvalidRequestBody, i18n.RequestInvalidBody)
+		return
+	}
+
+	details := make([]apierror.FieldError, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		key := i18n.ValidationInvalid
+		if fe.Tag() == "required" {
+			key = i18n.ValidationRequired
+		}
+		details = append(details, fieldError(c, fe.Field(), fe.Tag(), key, fe.Field()))
+	}
+	respondValidationError(c, details)
+}
+
+// parseID 경로 파라미터 id를 양의 정수로 변환, 실패 시 검증 에러 응답
+func parseID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		respondValidationError(c, []apierror.FieldError{
+			fieldError(c, "id", "invalid", i18n.ValidationInvalidID),
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
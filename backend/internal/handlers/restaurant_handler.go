@@ -1,11 +1,17 @@
 package handlers
 
 import (
-	"lunch_app/backend/internal/database"
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/hours"
+	"lunch_app/backend/internal/i18n"
 	"lunch_app/backend/internal/models"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 // GetAllRestaurants godoc
@@ -13,11 +19,25 @@ import (
 // @Description Get a list of all restaurants
 // @Tags restaurants
 // @Produce json
+// @Param open_at query string false "Only restaurants open at this time (now, RFC 3339 or YYYY-MM-DDTHH:MM in Asia/Seoul)"
 // @Success 200 {array} models.Restaurant
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /restaurants [get]
 func GetAllRestaurants(c *gin.Context) {
+	openAt, ok := parseOpenAt(c)
+	if !ok {
+		return
+	}
 	var restaurants []models.Restaurant
-	database.DB.Find(&restaurants)
+	db(c).Find(&restaurants)
+	if !openAt.IsZero() {
+		var err error
+		if restaurants, err = hours.FilterOpen(db(c), restaurants, openAt); err != nil {
+			respondInternalError(c, i18n.HoursLookupFailed, err)
+			return
+		}
+	}
 	c.JSON(http.StatusOK, restaurants)
 }
 
@@ -28,12 +48,12 @@ func GetAllRestaurants(c *gin.Context) {
 // @Produce json
 // @Param id path int true "Restaurant ID"
 // @Success 200 {object} models.Restaurant
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
 // @Router /restaurants/{id} [get]
 func GetRestaurantByID(c *gin.Context) {
-	id := c.Param("id")
-	var restaurant models.Restaurant
-	if err := database.DB.First(&restaurant, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Restaurant not found"})
+	restaurant, ok := findRestaurant(c)
+	if !ok {
 		return
 	}
 	c.JSON(http.StatusOK, restaurant)
@@ -47,36 +67,66 @@ func GetRestaurantByID(c *gin.Context) {
 // @Produce json
 // @Param restaurant body models.Restaurant true "Restaurant object"
 // @Success 201 {object} models.Restaurant
-// @Failure 400 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Failure 400 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /restaurants [post]
 func CreateRestaurant(c *gin.Context) {
 	var restaurant models.Restaurant
 	if err := c.ShouldBindJSON(&restaurant); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondBindError(c, err)
 		return
 	}
 
-	// 데이터 검증
-	if restaurant.Name == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "맛집 이름은 필수입니다"})
+	if !createRestaurant(c, &restaurant, restaurantFieldsV1) {
 		return
 	}
+
+	c.JSON(http.StatusCreated, restaurant)
+}
+
+// restaurantFields 검증 에러 details에 표시할 필드 이름 (API 버전별 표기)
+type restaurantFields struct {
+	Name, Address, Location, ExternalSource, ExternalID string
+}
+
+var (
+	restaurantFieldsV1 = restaurantFields{Name: "Name", Address: "Address", Location: "Latitude", ExternalSource: "ExternalSource", ExternalID: "ExternalID"}
+	restaurantFieldsV2 = restaurantFields{Name: "name", Address: "address", Location: "latitude", ExternalSource: "externalSource", ExternalID: "externalId"}
+)
+
+// createRestaurant 맛집 검증, 중복 검사, 기본값 설정 후 저장 (실패 시 에러 응답 후 false)
+func createRestaurant(c *gin.Context, restaurant *models.Restaurant, fields restaurantFields) bool {
+	// 데이터 검증 - 실패한 필드를 모두 모아서 응답
+	var details []apierror.FieldError
+	if restaurant.Name == "" {
+		details = append(details, fieldError(c, fields.Name, "required", i18n.RestaurantNameRequired))
+	}
 	if restaurant.Address == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "맛집 주소는 필수입니다"})
-		return
+		details = append(details, fieldError(c, fields.Address, "required", i18n.RestaurantAddressRequired))
 	}
 	if restaurant.Latitude == 0 && restaurant.Longitude == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "맛집 위치 정보는 필수입니다"})
-		return
+		details = append(details, fieldError(c, fields.Location, "required", i18n.RestaurantLocationRequired))
+	}
+	if restaurant.ExternalSource != "" && !models.IsExternalSource(restaurant.ExternalSource) {
+		details = append(details, fieldError(c, fields.ExternalSource, "oneof", i18n.RestaurantExternalSourceInvalid))
+	}
+	if (restaurant.ExternalSource == "") != (restaurant.ExternalID == "") {
+		details = append(details, fieldError(c, fields.ExternalID, "required_with", i18n.RestaurantExternalIDPair))
+	}
+	if len(details) > 0 {
+		respondValidationError(c, details)
+		return false
 	}
 
-	// 중복 검사 (이름과 주소로 검사) - soft delete된 항목 제외
+	// 중복 검사 (이름과 주소, 외부 장소 ID로 검사) - soft delete된 항목은 기본 스코프에서 제외됨
+	// 휴지통의 같은 맛집과는 복원 시점에 충돌을 검사 (trash.RestoreRestaurant)
+	// 동시에 같은 맛집을 등록하는 경우는 이 검사를 통과하므로 유니크 인덱스 위반으로 다시 걸러냄
 	var existingRestaurant models.Restaurant
-	result := database.DB.Where("name = ? AND address = ?", restaurant.Name, restaurant.Address).Where("deleted_at IS NULL").First(&existingRestaurant)
+	result := db(c).Scopes(models.SameRestaurant(*restaurant)).First(&existingRestaurant)
 	if result.Error == nil {
-		c.JSON(http.StatusConflict, gin.H{"error": "이미 등록된 맛집입니다"})
-		return
+		respondError(c, http.StatusConflict, apierror.CodeDuplicateRestaurant, i18n.RestaurantDuplicate)
+		return false
 	}
 
 	// 기본값 설정
@@ -87,30 +137,73 @@ func CreateRestaurant(c *gin.Context) {
 		restaurant.Phone = "전화번호 없음"
 	}
 
-	if err := database.DB.Create(&restaurant).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create restaurant"})
+	if err := db(c).Create(restaurant).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			respondError(c, http.StatusConflict, apierror.CodeDuplicateRestaurant, i18n.RestaurantDuplicate)
+			return false
+		}
+		respondInternalError(c, i18n.RestaurantCreateFailed, err)
+		return false
+	}
+	publishEvent(c, "", events.RestaurantCreated, dto.NewRestaurantResponse(*restaurant))
+	return true
+}
+
+// DeleteRestaurant godoc
+// @Summary Delete a restaurant
+// @Description Soft-delete a restaurant (visit history is kept)
+// @Tags restaurants
+// @Produce json
+// @Param id path int true "Restaurant ID"
+// @Success 200 {object} MessageResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /restaurants/{id} [delete]
+func DeleteRestaurant(c *gin.Context) {
+	if !deleteRestaurant(c) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, restaurant)
+	c.JSON(http.StatusOK, MessageResponse{Message: i18n.Message(c, i18n.RestaurantDeleted)})
 }
 
-// DeleteRestaurant 함수 추가
-func DeleteRestaurant(c *gin.Context) {
-	id := c.Param("id")
+// findRestaurant 경로 파라미터 id의 맛집 조회 (실패 시 에러 응답 후 false)
+func findRestaurant(c *gin.Context) (models.Restaurant, bool) {
+	var restaurant models.Restaurant
+	id, ok := parseID(c)
+	if !ok {
+		return restaurant, false
+	}
+	if err := db(c).First(&restaurant, id).Error; err != nil {
+		respondRestaurantLookupError(c, err)
+		return restaurant, false
+	}
+	return restaurant, true
+}
 
+// deleteRestaurant 경로 파라미터 id의 맛집을 soft delete (실패 시 에러 응답 후 false)
+func deleteRestaurant(c *gin.Context) bool {
 	// 먼저 해당 맛집이 존재하는지 확인
-	var restaurant models.Restaurant
-	if err := database.DB.First(&restaurant, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Restaurant not found"})
-		return
+	restaurant, ok := findRestaurant(c)
+	if !ok {
+		return false
 	}
 
 	// 맛집 삭제 (방문 기록은 유지됨)
-	if err := database.DB.Delete(&restaurant).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete restaurant"})
-		return
+	if err := db(c).Delete(&restaurant).Error; err != nil {
+		respondInternalError(c, i18n.RestaurantDeleteFailed, err)
+		return false
 	}
+	publishEvent(c, "", events.RestaurantDeleted, dto.DeletedEvent{ID: restaurant.ID})
+	return true
+}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Restaurant deleted successfully"})
+// respondRestaurantLookupError 맛집 조회 실패를 404 또는 500으로 구분해 응답
+func respondRestaurantLookupError(c *gin.Context, err error) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		respondError(c, http.StatusNotFound, apierror.CodeRestaurantNotFound, i18n.RestaurantNotFound)
+		return
+	}
+	respondInternalError(c, i18n.RestaurantLookupFailed, err)
 }
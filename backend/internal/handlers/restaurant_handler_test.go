@@ -3,11 +3,15 @@ package handlers
 import (
 	"bytes"
 	"encoding/json"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/audit"
 	"lunch_app/backend/internal/database"
 	"lunch_app/backend/internal/models"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -18,15 +22,27 @@ import (
 
 func setupTestDB() {
 	// 테스트용 메모리 DB 설정
-	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	database.DB = openTestDB(":memory:")
+}
+
+// openTestDB 운영 환경과 같은 설정(에러 변환, 감사 로그 플러그인, 부분 유니크 인덱스)으로 테스트 DB 연결
+func openTestDB(dsn string) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		panic("failed to connect to test database")
 	}
 
+	// 운영 환경과 같이 감사 로그 플러그인 등록
+	if err := db.Use(audit.Plugin{}); err != nil {
+		panic("failed to register audit plugin")
+	}
+
 	// 테이블 마이그레이션
-	db.AutoMigrate(&models.Restaurant{}, &models.Visit{})
-	
-	database.DB = db
+	db.AutoMigrate(&models.Restaurant{}, &models.Visit{}, &models.Review{}, &models.ReviewImage{}, &models.Bookmark{}, &models.AuditLog{}, &models.Poll{}, &models.PollCandidate{}, &models.PollVote{}, &models.Draw{}, &models.DrawCandidate{}, &models.Webhook{}, &models.WebhookDelivery{}, &models.DigestSubscription{}, &models.JobLease{}, &models.JobRun{}, &models.OpeningHours{}, &models.RestaurantHoliday{})
+	if err := database.CreateIndexes(db); err != nil {
+		panic("failed to create indexes: " + err.Error())
+	}
+	return db
 }
 
 func setupRouter() *gin.Engine {
@@ -105,10 +121,68 @@ func TestCreateRestaurant_Duplicate(t *testing.T) {
 
 	assert.Equal(t, http.StatusConflict, w2.Code)
 
-	var response map[string]string
+	var response apierror.Response
 	err := json.Unmarshal(w2.Body.Bytes(), &response)
 	assert.NoError(t, err)
-	assert.Equal(t, "이미 등록된 맛집입니다", response["error"])
+	assert.Equal(t, apierror.CodeDuplicateRestaurant, response.Code)
+	assert.Equal(t, "이미 등록된 맛집입니다", response.Error)
+}
+
+func TestCreateRestaurant_ConcurrentDuplicates(t *testing.T) {
+	// 여러 연결이 같은 DB를 보도록 파일 DB 사용 (쓰기 잠금은 트랜잭션 시작 시 획득)
+	original := database.DB
+	database.DB = openTestDB(filepath.Join(t.TempDir(), "concurrent.db") + "?_txlock=immediate&_busy_timeout=5000")
+	defer func() { database.DB = original }()
+
+	router := setupRouter()
+	router.POST("/restaurants", CreateRestaurant)
+
+	jsonData, _ := json.Marshal(models.Restaurant{
+		Name:      "동시 등록 맛집",
+		Address:   "서울시 성동구 동시동",
+		Latitude:  37.54,
+		Longitude: 127.05,
+	})
+
+	const requests = 10
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	statuses := make(chan int, requests)
+	codes := make(chan apierror.Code, requests)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest("POST", "/restaurants", bytes.NewBuffer(jsonData))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			<-start
+			router.ServeHTTP(w, req)
+			statuses <- w.Code
+			if w.Code != http.StatusCreated {
+				var response apierror.Response
+				json.Unmarshal(w.Body.Bytes(), &response)
+				codes <- response.Code
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(statuses)
+	close(codes)
+
+	counts := map[int]int{}
+	for status := range statuses {
+		counts[status]++
+	}
+	assert.Equal(t, map[int]int{http.StatusCreated: 1, http.StatusConflict: requests - 1}, counts)
+	for code := range codes {
+		assert.Equal(t, apierror.CodeDuplicateRestaurant, code)
+	}
+
+	var stored int64
+	database.DB.Model(&models.Restaurant{}).Where("name = ?", "동시 등록 맛집").Count(&stored)
+	assert.Equal(t, int64(1), stored)
 }
 
 func TestCreateRestaurant_ValidationErrors(t *testing.T) {
@@ -120,6 +194,7 @@ func TestCreateRestaurant_ValidationErrors(t *testing.T) {
 		restaurant     models.Restaurant
 		expectedStatus int
 		expectedError  string
+		expectedField  string
 	}{
 		{
 			name: "이름 없음",
@@ -130,6 +205,7 @@ func TestCreateRestaurant_ValidationErrors(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  "맛집 이름은 필수입니다",
+			expectedField:  "Name",
 		},
 		{
 			name: "주소 없음",
@@ -140,6 +216,7 @@ func TestCreateRestaurant_ValidationErrors(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  "맛집 주소는 필수입니다",
+			expectedField:  "Address",
 		},
 		{
 			name: "위치 정보 없음",
@@ -149,6 +226,7 @@ func TestCreateRestaurant_ValidationErrors(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  "맛집 위치 정보는 필수입니다",
+			expectedField:  "Latitude",
 		},
 	}
 
@@ -163,10 +241,85 @@ func TestCreateRestaurant_ValidationErrors(t *testing.T) {
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
 
-			var response map[string]string
+			var response apierror.Response
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, apierror.CodeValidationFailed, response.Code)
+			assert.Equal(t, tt.expectedError, response.Error)
+			if assert.Len(t, response.Details, 1) {
+				assert.Equal(t, tt.expectedField, response.Details[0].Field)
+			}
+		})
+	}
+}
+
+func TestCreateRestaurant_EnglishMessages(t *testing.T) {
+	router := setupRouter()
+	router.POST("/restaurants", CreateRestaurant)
+
+	jsonData, _ := json.Marshal(models.Restaurant{Address: "서울시 강남구"})
+	req, _ := http.NewRequest("POST", "/restaurants", bytes.NewBuffer(jsonData))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response apierror.Response
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Restaurant name is required", response.Error)
+	if assert.Len(t, response.Details, 2) {
+		assert.Equal(t, "Restaurant location is required", response.Details[1].Message)
+	}
+}
+
+func TestCreateRestaurant_MalformedBody(t *testing.T) {
+	router := setupRouter()
+	router.POST("/restaurants", CreateRestaurant)
+
+	req, _ := http.NewRequest("POST", "/restaurants", bytes.NewBufferString(`{"Name": 123`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response apierror.Response
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, apierror.CodeInvalidRequestBody, response.Code)
+}
+
+func TestGetRestaurantByID_NotFound(t *testing.T) {
+	router := setupRouter()
+	router.GET("/restaurants/:id", GetRestaurantByID)
+
+	tests := []struct {
+		name         string
+		id           string
+		expectedCode apierror.Code
+		expectedHTTP int
+	}{
+		{name: "존재하지 않는 ID", id: "999999", expectedCode: apierror.CodeRestaurantNotFound, expectedHTTP: http.StatusNotFound},
+		{name: "숫자가 아닌 ID", id: "abc", expectedCode: apierror.CodeValidationFailed, expectedHTTP: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "/restaurants/"+tt.id, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedHTTP, w.Code)
+
+			var response apierror.Response
 			err := json.Unmarshal(w.Body.Bytes(), &response)
 			assert.NoError(t, err)
-			assert.Equal(t, tt.expectedError, response["error"])
+			assert.Equal(t, tt.expectedCode, response.Code)
 		})
 	}
 }
@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/geo"
+	"lunch_app/backend/internal/hours"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/kakao"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/recommend"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultNearbyRadiusMeters 근처 맛집 기본 검색 반경
+	defaultNearbyRadiusMeters = 1000
+	// maxNearbyRadiusMeters 근처 맛집 최대 검색 반경
+	maxNearbyRadiusMeters = 5000
+	// defaultRecommendCount 추천 맛집 기본 수
+	defaultRecommendCount = 3
+	// maxRecommendCount 추천 맛집 최대 수
+	maxRecommendCount = 10
+)
+
+// ListRestaurantsV2 godoc
+// @Summary List restaurants (v2)
+// @Tags restaurants-v2
+// @Produce json
+// @Param open_at query string false "Only restaurants open at this time (now, RFC 3339 or YYYY-MM-DDTHH:MM in Asia/Seoul)"
+// @Success 200 {object} dto.ListResponse[dto.RestaurantResponse]
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /v2/restaurants [get]
+func ListRestaurantsV2(c *gin.Context) {
+	openAt, ok := parseOpenAt(c)
+	if !ok {
+		return
+	}
+	var restaurants []models.Restaurant
+	if err := db(c).Order("id").Find(&restaurants).Error; err != nil {
+		respondInternalError(c, i18n.RestaurantLookupFailed, err)
+		return
+	}
+	if !openAt.IsZero() {
+		var err error
+		if restaurants, err = hours.FilterOpen(db(c), restaurants, openAt); err != nil {
+			respondInternalError(c, i18n.HoursLookupFailed, err)
+			return
+		}
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewRestaurantResponses(restaurants)))
+}
+
+// NearbyRestaurantsV2 godoc
+// @Summary List restaurants near a location (v2)
+// @Description Saved restaurants within the radius of lat/lng, nearest first
+// @Tags restaurants-v2
+// @Produce json
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param radius query number false "Radius in meters (default 1000, max 5000)"
+// @Param open_at query string false "Only restaurants open at this time (now, RFC 3339 or YYYY-MM-DDTHH:MM in Asia/Seoul)"
+// @Success 200 {object} dto.ListResponse[dto.NearbyRestaurantResponse]
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /v2/restaurants/nearby [get]
+func NearbyRestaurantsV2(c *gin.Context) {
+	var details []apierror.FieldError
+	latitude := coordinateQuery(c, "lat", 90, i18n.DirectionsInvalidLatitude, &details)
+	longitude := coordinateQuery(c, "lng", 180, i18n.DirectionsInvalidLongitude, &details)
+	radius := float64(defaultNearbyRadiusMeters)
+	if value := c.Query("radius"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) || parsed <= 0 || parsed > maxNearbyRadiusMeters {
+			details = append(details, fieldError(c, "radius", "invalid", i18n.RestaurantInvalidRadius, float64(maxNearbyRadiusMeters)))
+		} else {
+			radius = parsed
+		}
+	}
+	if len(details) > 0 {
+		respondValidationError(c, details)
+		return
+	}
+	openAt, ok := parseOpenAt(c)
+	if !ok {
+		return
+	}
+
+	nearby, err := findNearbyRestaurants(db(c), latitude, longitude, radius)
+	if err != nil {
+		respondInternalError(c, i18n.RestaurantLookupFailed, err)
+		return
+	}
+	var open map[uint]bool
+	if !openAt.IsZero() {
+		ids := make([]uint, 0, len(nearby))
+		for _, n := range nearby {
+			ids = append(ids, n.Restaurant.ID)
+		}
+		if open, err = hours.OpenIDs(db(c), ids, openAt); err != nil {
+			respondInternalError(c, i18n.HoursLookupFailed, err)
+			return
+		}
+	}
+
+	responses := make([]dto.NearbyRestaurantResponse, 0, len(nearby))
+	for _, n := range nearby {
+		if open != nil && !open[n.Restaurant.ID] {
+			continue
+		}
+		responses = append(responses, dto.NearbyRestaurantResponse{
+			Restaurant:     dto.NewRestaurantResponse(n.Restaurant),
+			DistanceMeters: n.DistanceMeters,
+		})
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(responses))
+}
+
+// RecommendRestaurantsV2 godoc
+// @Summary Recommend restaurants (v2)
+// @Description Random picks, preferring restaurants not visited in the last 7 days
+// @Tags restaurants-v2
+// @Produce json
+// @Param limit query int false "Number of restaurants (default 3, max 10)"
+// @Param open_at query string false "Only restaurants open at this time (now, RFC 3339 or YYYY-MM-DDTHH:MM in Asia/Seoul)"
+// @Success 200 {object} dto.ListResponse[dto.RestaurantResponse]
+// @Failure 400 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /v2/restaurants/recommendations [get]
+func RecommendRestaurantsV2(c *gin.Context) {
+	limit := defaultRecommendCount
+	if value := c.Query("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > maxRecommendCount {
+			respondValidationError(c, []apierror.FieldError{fieldError(c, "limit", "invalid", i18n.ValidationInvalid, "limit")})
+			return
+		}
+		limit = n
+	}
+	openAt, ok := parseOpenAt(c)
+	if !ok {
+		return
+	}
+
+	restaurants, err := recommend.Restaurants(db(c), recommend.Options{Limit: limit, Now: pollNow(), OpenAt: openAt})
+	if err != nil {
+		respondInternalError(c, i18n.RestaurantLookupFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewRestaurantResponses(restaurants)))
+}
+
+// findNearbyRestaurants 기준 위치에서 radius 미터 안의 맛집 (가까운 순)
+// 위도 범위로 후보를 좁힌 뒤 실제 거리로 거름
+func findNearbyRestaurants(tx *gorm.DB, latitude, longitude, radius float64) ([]kakao.NearbyRestaurant, error) {
+	latitudeDelta := geo.LatitudeDelta(radius)
+	var restaurants []models.Restaurant
+	err := tx.Where("latitude BETWEEN ? AND ?", latitude-latitudeDelta, latitude+latitudeDelta).
+		Find(&restaurants).Error
+	if err != nil {
+		return nil, err
+	}
+	nearby := []kakao.NearbyRestaurant{}
+	for _, r := range restaurants {
+		distance := geo.DistanceMeters(latitude, longitude, r.Latitude, r.Longitude)
+		if distance <= radius {
+			nearby = append(nearby, kakao.NearbyRestaurant{Restaurant: r, DistanceMeters: distance})
+		}
+	}
+	sort.SliceStable(nearby, func(i, j int) bool {
+		return nearby[i].DistanceMeters < nearby[j].DistanceMeters
+	})
+	return nearby, nil
+}
+
+// GetRestaurantV2 godoc
+// @Summary Get a restaurant by ID (v2)
+// @Tags restaurants-v2
+// @Produce json
+// @Param id path int true "Restaurant ID"
+// @Success 200 {object} dto.RestaurantResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Router /v2/restaurants/{id} [get]
+func GetRestaurantV2(c *gin.Context) {
+	restaurant, ok := findRestaurant(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewRestaurantResponse(restaurant))
+}
+
+// CreateRestaurantV2 godoc
+// @Summary Create a restaurant (v2)
+// @Tags restaurants-v2
+// @Accept json
+// @Produce json
+// @Param restaurant body dto.CreateRestaurantRequest true "Restaurant"
+// @Success 201 {object} dto.RestaurantResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /v2/restaurants [post]
+func CreateRestaurantV2(c *gin.Context) {
+	var input dto.CreateRestaurantRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		respondBindError(c, err)
+		return
+	}
+
+	restaurant := input.ToModel()
+	if !createRestaurant(c, &restaurant, restaurantFieldsV2) {
+		return
+	}
+	c.JSON(http.StatusCreated, dto.NewRestaurantResponse(restaurant))
+}
+
+// DeleteRestaurantV2 godoc
+// @Summary Delete a restaurant (v2)
+// @Tags restaurants-v2
+// @Param id path int true "Restaurant ID"
+// @Success 204
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /v2/restaurants/{id} [delete]
+func DeleteRestaurantV2(c *gin.Context) {
+	if !deleteRestaurant(c) {
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"encoding/json"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/lunchroom"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// roomMaxMessageBytes 클라이언트 메시지 최대 크기
+	roomMaxMessageBytes = 4096
+	// roomWriteTimeout 메시지 한 건 전송 제한 시간
+	roomWriteTimeout = 10 * time.Second
+)
+
+// roomManager 점심 방 관리자 (main에서 SetRoomManager로 설정)
+var roomManager *lunchroom.Manager
+
+// SetRoomManager 점심 방 관리자 설정
+func SetRoomManager(manager *lunchroom.Manager) {
+	roomManager = manager
+}
+
+// JoinRoom godoc
+// @Summary Join a lunch room (WebSocket)
+// @Description Upgrade to WebSocket and join the room. Send {"type":"vote","restaurantId":1}, {"type":"unvote"} or {"type":"spin"}; receive state (members and votes), spin (result shared by everyone, revealed at revealAt) and error messages
+// @Tags rooms
+// @Param room path string true "Room name"
+// @Param name query string true "Member name"
+// @Success 101 {string} string "Switching Protocols"
+// @Failure 400 {object} apierror.Response
+// @Router /rooms/{room}/ws [get]
+func JoinRoom(c *gin.Context) {
+	roomName, ok := roomNameParam(c)
+	if !ok {
+		return
+	}
+	member := strings.TrimSpace(c.Query("name"))
+	if member == "" || len(member) > 64 {
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "name", "required", i18n.RoomMemberRequired)})
+		return
+	}
+	lang := i18n.FromRequest(c.Request)
+
+	// 인증 정보(쿠키)를 쓰지 않으므로 Origin 검사 없이 연결 허용
+	server := websocket.Server{Handler: func(ws *websocket.Conn) {
+		ws.MaxPayloadBytes = roomMaxMessageBytes
+		client := lunchroom.NewClient(member, lang)
+		room := roomManager.Join(roomName, client)
+		defer roomManager.Leave(room, client)
+
+		go writeRoomMessages(ws, client)
+
+		for {
+			var data []byte
+			if err := websocket.Message.Receive(ws, &data); err != nil {
+				return
+			}
+			var message lunchroom.ClientMessage
+			if err := json.Unmarshal(data, &message); err != nil {
+				message = lunchroom.ClientMessage{}
+			}
+			room.Handle(c.Request.Context(), client, message)
+		}
+	}}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// writeRoomMessages 방에서 온 메시지를 WebSocket으로 전송 (채널이 닫히면 연결 종료)
+// 전송에 실패하면 연결을 닫아 읽기 루프가 방을 나가도록 하고, 채널이 닫힐 때까지 비움
+func writeRoomMessages(ws *websocket.Conn, client *lunchroom.Client) {
+	defer ws.Close()
+	failed := false
+	for message := range client.Messages() {
+		if failed {
+			continue
+		}
+		ws.SetWriteDeadline(time.Now().Add(roomWriteTimeout))
+		if err := websocket.JSON.Send(ws, message); err != nil {
+			failed = true
+			ws.Close()
+		}
+	}
+}
+
+// GetRoom godoc
+// @Summary Get lunch room state
+// @Description Members currently connected and their votes. Empty when nobody is in the room
+// @Tags rooms
+// @Produce json
+// @Param room path string true "Room name"
+// @Success 200 {object} lunchroom.State
+// @Failure 400 {object} apierror.Response
+// @Router /rooms/{room} [get]
+func GetRoom(c *gin.Context) {
+	roomName, ok := roomNameParam(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, roomManager.Snapshot(roomName))
+}
+
+// roomNameParam 경로의 방 이름 검증 (실패 시 에러 응답 후 false)
+func roomNameParam(c *gin.Context) (string, bool) {
+	name := strings.TrimSpace(c.Param("room"))
+	if name == "" || len(name) > 64 {
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "room", "invalid", i18n.RoomInvalidName)})
+		return "", false
+	}
+	return name, true
+}
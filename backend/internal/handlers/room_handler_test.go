@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/json"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/lunchroom"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+func setupRoomServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	manager := lunchroom.NewManager(database.DB)
+	manager.SpinDuration = 10 * time.Millisecond
+	SetRoomManager(manager)
+
+	router := setupRouter()
+	router.GET("/rooms/:room", GetRoom)
+	router.GET("/rooms/:room/ws", JoinRoom)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func dialRoom(t *testing.T, server *httptest.Server, room, name string) *websocket.Conn {
+	t.Helper()
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/rooms/" + url.PathEscape(room) + "/ws?name=" + url.QueryEscape(name)
+	ws, err := websocket.Dial(wsURL, "", "http://localhost/")
+	require.NoError(t, err)
+	t.Cleanup(func() { ws.Close() })
+	return ws
+}
+
+// receiveRoom 원하는 종류의 메시지가 올 때까지 읽음
+func receiveRoom(t *testing.T, ws *websocket.Conn, messageType string) lunchroom.ServerMessage {
+	t.Helper()
+	ws.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		var message lunchroom.ServerMessage
+		require.NoError(t, websocket.JSON.Receive(ws, &message))
+		if message.Type == messageType {
+			return message
+		}
+	}
+}
+
+func TestJoinRoom(t *testing.T) {
+	server := setupRoomServer(t)
+	restaurant := models.Restaurant{Name: "방 투표 맛집", Address: "서울시 웹소켓구 1"}
+	require.NoError(t, database.DB.Create(&restaurant).Error)
+
+	minsu := dialRoom(t, server, "점심방", "민수")
+	assert.Equal(t, []string{"민수"}, receiveRoom(t, minsu, lunchroom.MessageState).State.Members)
+	jiyoung := dialRoom(t, server, "점심방", "지영")
+	assert.Equal(t, []string{"민수", "지영"}, receiveRoom(t, jiyoung, lunchroom.MessageState).State.Members)
+
+	require.NoError(t, websocket.JSON.Send(minsu, lunchroom.ClientMessage{Type: lunchroom.MessageVote, RestaurantID: restaurant.ID}))
+	for {
+		state := receiveRoom(t, jiyoung, lunchroom.MessageState).State
+		if len(state.Votes) > 0 {
+			assert.Equal(t, []lunchroom.Tally{{RestaurantID: restaurant.ID, Name: "방 투표 맛집", Voters: []string{"민수"}}}, state.Votes)
+			break
+		}
+	}
+
+	resp, err := http.Get(server.URL + "/rooms/" + url.PathEscape("점심방"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var snapshot lunchroom.State
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&snapshot))
+	assert.Equal(t, []string{"민수", "지영"}, snapshot.Members)
+
+	// JSON이 아닌 메시지는 보낸 사람에게만 에러
+	require.NoError(t, websocket.Message.Send(jiyoung, "hello"))
+	assert.Equal(t, apierror.CodeInvalidMessage, receiveRoom(t, jiyoung, lunchroom.MessageError).Error.Code)
+
+	// 룰렛 결과는 모두에게 같게 전달
+	require.NoError(t, websocket.JSON.Send(jiyoung, lunchroom.ClientMessage{Type: lunchroom.MessageSpin}))
+	first := receiveRoom(t, minsu, lunchroom.MessageSpin).Spin
+	second := receiveRoom(t, jiyoung, lunchroom.MessageSpin).Spin
+	assert.Equal(t, first, second)
+	assert.Equal(t, "지영", first.SpunBy)
+	assert.Equal(t, restaurant.ID, first.Result.RestaurantID)
+
+	// 연결이 끊기면 접속자와 표에서 빠짐
+	minsu.Close()
+	for {
+		state := receiveRoom(t, jiyoung, lunchroom.MessageState).State
+		if len(state.Members) == 1 {
+			assert.Equal(t, []string{"지영"}, state.Members)
+			assert.Empty(t, state.Votes)
+			break
+		}
+	}
+}
+
+func TestJoinRoom_Validation(t *testing.T) {
+	server := setupRoomServer(t)
+
+	resp, err := http.Get(server.URL + "/rooms/점심방/ws")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	var body apierror.Response
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, apierror.CodeValidationFailed, body.Code)
+	require.Len(t, body.Details, 1)
+	assert.Equal(t, "name", body.Details[0].Field)
+
+	resp, err = http.Get(server.URL + "/rooms/" + strings.Repeat("a", 65))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
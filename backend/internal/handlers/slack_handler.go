@@ -0,0 +1,328 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/logger"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/poll"
+	"lunch_app/backend/internal/recommend"
+	"lunch_app/backend/internal/slack"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	// slackRecommendCount /lunch recommend로 추천하는 맛집 수 (투표 자동 후보 수와 같음)
+	slackRecommendCount = 3
+	// slackPollMinutes /lunch poll의 기본 마감 시간(분)
+	slackPollMinutes = 30
+)
+
+// slackHTTPClient response_url 전송용 클라이언트
+var slackHTTPClient = &http.Client{Timeout: 3 * time.Second}
+
+// slackResponseURLPrefix 요청에 담긴 response_url로 임의 주소에 요청하지 않도록 허용하는 접두사 (테스트에서 교체)
+var slackResponseURLPrefix = "https://hooks.slack.com/"
+
+// slackActions 응답 후 고루틴에서 처리 중인 버튼 동작 (테스트에서 끝날 때까지 기다림)
+var slackActions sync.WaitGroup
+
+// SlackCommand godoc
+// @Summary Slack /lunch slash command
+// @Description Subcommands: (empty) or recommend, poll [minutes] [title], log <restaurant name>, help. Responds with a Block Kit message. Requests must carry a valid Slack signature (SLACK_SIGNING_SECRET)
+// @Tags slack
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param X-Slack-Request-Timestamp header string true "Request timestamp (Unix seconds)"
+// @Param X-Slack-Signature header string true "v0=HMAC-SHA256 signature"
+// @Success 200 {object} slack.Message
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Router /slack/commands [post]
+func SlackCommand(c *gin.Context) {
+	if err := c.Request.ParseForm(); err != nil {
+		respondError(c, http.StatusBadRequest, apierror.CodeInvalidRequestBody, i18n.SlackInvalidPayload)
+		return
+	}
+	command := slack.ParseCommand(c.Request.PostForm)
+	setChatActor(c, "slack:"+command.UserName)
+	lang := i18n.FromRequest(c.Request)
+
+	var message slack.Message
+	switch name, args := command.Subcommand(); name {
+	case "", "recommend", "추천":
+		message = slackRecommend(c, lang)
+	case "poll", "투표":
+		message = slackStartPoll(c, lang, command.TeamID, command.UserName, args, nil)
+	case "log", "방문":
+		message = slackLogVisitByName(c, lang, command.UserName, args)
+	default:
+		message = slack.EphemeralText(i18n.T(lang, i18n.SlackHelp))
+	}
+	c.JSON(http.StatusOK, message)
+}
+
+// SlackInteraction godoc
+// @Summary Slack interactive message actions
+// @Description Handles buttons from /lunch messages (log_visit, start_poll, poll_vote, poll_close). Acknowledges with 200 right away and posts the result to the payload's response_url. Requests must carry a valid Slack signature
+// @Tags slack
+// @Accept x-www-form-urlencoded
+// @Param X-Slack-Request-Timestamp header string true "Request timestamp (Unix seconds)"
+// @Param X-Slack-Signature header string true "v0=HMAC-SHA256 signature"
+// @Param payload formData string true "Interaction payload JSON"
+// @Success 200
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Router /slack/interactions [post]
+func SlackInteraction(c *gin.Context) {
+	if err := c.Request.ParseForm(); err != nil {
+		respondError(c, http.StatusBadRequest, apierror.CodeInvalidRequestBody, i18n.SlackInvalidPayload)
+		return
+	}
+	interaction, err := slack.ParseInteraction(c.Request.PostForm)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, apierror.CodeInvalidRequestBody, i18n.SlackInvalidPayload)
+		return
+	}
+	if len(interaction.Actions) == 0 {
+		c.Status(http.StatusOK)
+		return
+	}
+	// Slack은 3초 안에 응답을 받아야 하므로 먼저 응답하고, 처리 결과는 response_url로 따로 보냄
+	c.Status(http.StatusOK)
+	bg := c.Copy()
+	bg.Request = c.Request.WithContext(context.WithoutCancel(c.Request.Context()))
+	slackActions.Add(1)
+	go func() {
+		defer slackActions.Done()
+		handleSlackAction(bg, interaction)
+	}()
+}
+
+// handleSlackAction 버튼 동작을 처리하고 결과 메시지를 response_url로 보냄 (응답 후 고루틴에서 실행)
+func handleSlackAction(c *gin.Context, interaction slack.Interaction) {
+	user := interaction.UserName()
+	setChatActor(c, "slack:"+user)
+	lang := i18n.FromRequest(c.Request)
+
+	action := interaction.Actions[0]
+	var message slack.Message
+	switch action.ActionID {
+	case slack.ActionLogVisit:
+		message = slackLogVisit(c, lang, user, action.Value)
+	case slack.ActionStartPoll:
+		ids := []uint{}
+		for _, value := range strings.Split(action.Value, ",") {
+			if id, err := strconv.ParseUint(value, 10, 64); err == nil {
+				ids = append(ids, uint(id))
+			}
+		}
+		message = slackStartPoll(c, lang, interaction.Team.ID, user, "", ids)
+	case slack.ActionVote:
+		message = slackVote(c, lang, user, action.Value)
+	case slack.ActionClosePoll:
+		message = slackClosePoll(c, lang, action.Value)
+	default:
+		return
+	}
+	postSlackResponse(c, interaction.ResponseURL, message)
+}
+
+// slackRecommend 최근 방문하지 않은 맛집 우선으로 추천
+func slackRecommend(c *gin.Context, lang i18n.Lang) slack.Message {
+	restaurants, err := recommend.Restaurants(db(c), recommend.Options{Limit: slackRecommendCount, Now: pollNow()})
+	if err != nil {
+		return slackFailed(c, lang, err)
+	}
+	if len(restaurants) == 0 {
+		return slack.EphemeralText(i18n.T(lang, i18n.SlackNoRestaurants))
+	}
+	return slack.RecommendMessage(lang, restaurants)
+}
+
+// slackStartPoll 채널 투표 생성 (restaurantIDs가 없으면 추천 맛집으로 후보를 채움)
+// args는 "[마감까지 분] [제목]"
+func slackStartPoll(c *gin.Context, lang i18n.Lang, teamID, user, args string, restaurantIDs []uint) slack.Message {
+	minutes := slackPollMinutes
+	title := args
+	if first, rest, _ := strings.Cut(args, " "); first != "" {
+		if n, err := strconv.Atoi(first); err == nil {
+			minutes = n
+			title = strings.TrimSpace(rest)
+		}
+	}
+	if minutes < 1 || time.Duration(minutes)*time.Minute > poll.MaxDuration {
+		return slack.EphemeralText(i18n.T(lang, i18n.SlackInvalidMinutes))
+	}
+	if len([]rune(title)) > 100 {
+		title = string([]rune(title)[:100])
+	}
+	autoSeed := 0
+	if len(restaurantIDs) == 0 {
+		autoSeed = slackRecommendCount
+	}
+
+	now := pollNow()
+	created, err := poll.Create(db(c), poll.CreateInput{
+		Team:          slackTeam(teamID),
+		Title:         title,
+		CreatedBy:     user,
+		Deadline:      now.Add(time.Duration(minutes) * time.Minute),
+		RestaurantIDs: restaurantIDs,
+		AutoSeed:      autoSeed,
+	}, now)
+	var notFound *poll.RestaurantNotFoundError
+	switch {
+	case errors.Is(err, poll.ErrNoCandidates):
+		return slack.EphemeralText(i18n.T(lang, i18n.SlackNoRestaurants))
+	case errors.Is(err, poll.ErrTooManyCandidates):
+		return slack.EphemeralText(i18n.T(lang, i18n.PollTooManyCandidates, poll.MaxCandidates))
+	case errors.As(err, &notFound):
+		return slack.EphemeralText(i18n.T(lang, i18n.PollRestaurantNotFound, notFound.ID))
+	case err != nil:
+		return slackFailed(c, lang, err)
+	}
+	publishEvent(c, created.Team, events.PollCreated, dto.NewPollResponse(created))
+	return slack.PollMessage(lang, created)
+}
+
+// slackVote value("투표ID:후보ID")의 후보에 투표하고 갱신된 투표 메시지로 원래 메시지를 교체
+func slackVote(c *gin.Context, lang i18n.Lang, voter, value string) slack.Message {
+	pollPart, candidatePart, _ := strings.Cut(value, ":")
+	pollID, err := strconv.ParseUint(pollPart, 10, 64)
+	if err != nil {
+		return slack.EphemeralText(i18n.T(lang, i18n.PollNotFound))
+	}
+	candidateID, err := strconv.ParseUint(candidatePart, 10, 64)
+	if err != nil {
+		return slack.EphemeralText(i18n.T(lang, i18n.PollCandidateNotFound))
+	}
+
+	if _, err := poll.Vote(db(c), uint(pollID), uint(candidateID), voter, pollNow()); err != nil {
+		return slackPollError(c, lang, err)
+	}
+	updated, err := poll.Get(db(c), uint(pollID))
+	if err != nil {
+		return slackPollError(c, lang, err)
+	}
+	publishEvent(c, updated.Team, events.PollVoted, dto.NewPollResponse(updated))
+	message := slack.PollMessage(lang, updated)
+	message.ReplaceOriginal = true
+	return message
+}
+
+// slackClosePoll 투표를 마감하고 결과 메시지로 원래 메시지를 교체
+func slackClosePoll(c *gin.Context, lang i18n.Lang, value string) slack.Message {
+	pollID, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return slack.EphemeralText(i18n.T(lang, i18n.PollNotFound))
+	}
+	result, err := poll.Close(db(c), uint(pollID), pollNow())
+	if err != nil {
+		return slackPollError(c, lang, err)
+	}
+	publishEvent(c, result.Poll.Team, events.PollClosed, dto.NewClosePollResponse(result))
+	message := slack.PollMessage(lang, result.Poll)
+	message.ReplaceOriginal = true
+	return message
+}
+
+// slackPollError 투표 공통 에러를 본인에게만 보이는 메시지로 변환
+func slackPollError(c *gin.Context, lang i18n.Lang, err error) slack.Message {
+	switch {
+	case errors.Is(err, poll.ErrNotFound):
+		return slack.EphemeralText(i18n.T(lang, i18n.PollNotFound))
+	case errors.Is(err, poll.ErrClosed):
+		return slack.EphemeralText(i18n.T(lang, i18n.PollClosed))
+	case errors.Is(err, poll.ErrCandidateNotFound):
+		return slack.EphemeralText(i18n.T(lang, i18n.PollCandidateNotFound))
+	default:
+		return slackFailed(c, lang, err)
+	}
+}
+
+// slackLogVisitByName 이름이 정확히 같은 맛집, 없으면 이름에 query가 들어간 맛집의 방문 기록
+// 여러 곳이 나오면 고르는 버튼을 보여 줌
+func slackLogVisitByName(c *gin.Context, lang i18n.Lang, visitor, query string) slack.Message {
+	if query == "" {
+		return slack.EphemeralText(i18n.T(lang, i18n.SlackLogNameRequired))
+	}
+	matches, err := findRestaurantsByName(c, query)
+	if err != nil {
+		return slackFailed(c, lang, err)
+	}
+	switch len(matches) {
+	case 0:
+		return slack.EphemeralText(i18n.T(lang, i18n.SlackRestaurantNotFound, query))
+	case 1:
+		return slackRecordVisit(c, lang, visitor, matches[0])
+	default:
+		return slack.ChooseRestaurantMessage(lang, query, matches)
+	}
+}
+
+// slackLogVisit 버튼 value(맛집 ID)의 맛집 방문 기록
+func slackLogVisit(c *gin.Context, lang i18n.Lang, visitor, value string) slack.Message {
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return slack.EphemeralText(i18n.T(lang, i18n.SlackRestaurantNotFound, value))
+	}
+	var restaurant models.Restaurant
+	err = db(c).First(&restaurant, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return slack.EphemeralText(i18n.T(lang, i18n.SlackRestaurantNotFound, value))
+	}
+	if err != nil {
+		return slackFailed(c, lang, err)
+	}
+	return slackRecordVisit(c, lang, visitor, restaurant)
+}
+
+// slackRecordVisit 지금 시각으로 visitor의 방문 기록을 남기고 완료 메시지 반환
+func slackRecordVisit(c *gin.Context, lang i18n.Lang, visitor string, restaurant models.Restaurant) slack.Message {
+	if err := recordChatVisit(c, visitor, restaurant); err != nil {
+		return slackFailed(c, lang, err)
+	}
+	return slack.EphemeralText(i18n.T(lang, i18n.SlackVisitLogged, restaurant.Name))
+}
+
+// slackFailed 내부 오류를 로그로 남기고 본인에게만 보이는 실패 메시지 반환
+// Slack은 200이 아닌 응답을 그대로 보여 주므로 에러도 200 메시지로 응답
+func slackFailed(c *gin.Context, lang i18n.Lang, err error) slack.Message {
+	ctx := c.Request.Context()
+	logger.FromContext(ctx).ErrorContext(ctx, "Slack 요청 처리 실패", "error", err)
+	return slack.EphemeralText(i18n.T(lang, i18n.SlackFailed))
+}
+
+// postSlackResponse 인터랙션 결과를 response_url로 전송 (Slack 주소가 아니면 보내지 않음)
+func postSlackResponse(c *gin.Context, responseURL string, message slack.Message) {
+	ctx := c.Request.Context()
+	if !strings.HasPrefix(responseURL, slackResponseURLPrefix) {
+		logger.FromContext(ctx).WarnContext(ctx, "Slack response_url 무시", "url", responseURL)
+		return
+	}
+	if err := slack.PostResponse(ctx, slackHTTPClient, responseURL, message); err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "Slack 응답 전송 실패", "error", err)
+	}
+}
+
+// slackTeam Slack 워크스페이스의 투표 팀 이름
+func slackTeam(teamID string) string {
+	if teamID == "" {
+		return models.DefaultTeam
+	}
+	return "slack-" + teamID
+}
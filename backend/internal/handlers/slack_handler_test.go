@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/middleware"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/slack"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSlackSecret = "test-signing-secret"
+
+// slackResponses response_url 대역 서버가 받은 메시지
+type slackResponses struct {
+	server   *httptest.Server
+	messages chan slack.Message
+}
+
+func setupSlackRouter(t *testing.T, now time.Time) (*gin.Engine, *slackResponses) {
+	t.Setenv("SLACK_SIGNING_SECRET", testSlackSecret)
+	pollNow = func() time.Time { return now }
+
+	responses := &slackResponses{messages: make(chan slack.Message, 8)}
+	responses.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var message slack.Message
+		json.NewDecoder(r.Body).Decode(&message)
+		responses.messages <- message
+	}))
+	slackResponseURLPrefix = responses.server.URL
+	t.Cleanup(func() {
+		slackActions.Wait()
+		pollNow = time.Now
+		slackResponseURLPrefix = "https://hooks.slack.com/"
+		responses.server.Close()
+	})
+
+	router := setupRouter()
+	router.POST("/slack/commands", middleware.SlackSignature(), SlackCommand)
+	router.POST("/slack/interactions", middleware.SlackSignature(), SlackInteraction)
+	return router, responses
+}
+
+// sendSlackRequest Slack처럼 본문에 서명해서 전송
+func sendSlackRequest(router *gin.Engine, path, body string) *httptest.ResponseRecorder {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req, _ := http.NewRequest("POST", path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(slack.TimestampHeader, timestamp)
+	req.Header.Set(slack.SignatureHeader, slack.Sign(testSlackSecret, timestamp, []byte(body)))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// sendSlackAction 녹화한 block_actions payload의 버튼·값·response_url만 바꿔서 전송
+func sendSlackAction(t *testing.T, router *gin.Engine, responses *slackResponses, actionID, value string) slack.Message {
+	t.Helper()
+	recorded, err := os.ReadFile("testdata/slack/block_actions.json")
+	require.NoError(t, err)
+	payload := strings.NewReplacer(
+		"{{response_url}}", responses.server.URL+"/actions/T0LUNCH01",
+		"{{action_id}}", actionID,
+		"{{value}}", value,
+	).Replace(string(recorded))
+
+	w := sendSlackRequest(router, "/slack/interactions", url.Values{"payload": {payload}}.Encode())
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	select {
+	case message := <-responses.messages:
+		return message
+	case <-time.After(2 * time.Second):
+		t.Fatal("response_url 메시지 대기 시간 초과")
+		return slack.Message{}
+	}
+}
+
+func decodeSlackMessage(t *testing.T, w *httptest.ResponseRecorder) slack.Message {
+	t.Helper()
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var message slack.Message
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &message))
+	return message
+}
+
+// slackButtons 메시지 안의 버튼 (section 오른쪽 버튼과 actions 블록의 버튼)
+func slackButtons(message slack.Message) []slack.Button {
+	var buttons []slack.Button
+	for _, block := range message.Blocks {
+		if block.Accessory != nil {
+			buttons = append(buttons, *block.Accessory)
+		}
+		for _, element := range block.Elements {
+			if element, ok := element.(map[string]any); ok && element["type"] == "button" {
+				buttons = append(buttons, slack.Button{ActionID: element["action_id"].(string), Value: element["value"].(string)})
+			}
+		}
+	}
+	return buttons
+}
+
+func TestSlackPollFlow(t *testing.T) {
+	// 2024-07-05 11:30 KST
+	now := time.Date(2024, 7, 5, 2, 30, 0, 0, time.UTC)
+	router, responses := setupSlackRouter(t, now)
+	require.NoError(t, database.DB.Create(&[]models.Restaurant{
+		{Name: "슬랙 국밥집", Address: "서울시 슬랙구 1"},
+		{Name: "슬랙 초밥집", Address: "서울시 슬랙구 2"},
+		{Name: "슬랙 쌀국수", Address: "서울시 슬랙구 5"},
+	}).Error)
+
+	recorded, err := os.ReadFile("testdata/slack/command_recommend.txt")
+	require.NoError(t, err)
+	message := decodeSlackMessage(t, sendSlackRequest(router, "/slack/commands", string(recorded)))
+	assert.Equal(t, slack.Ephemeral, message.ResponseType)
+	buttons := slackButtons(message)
+	require.Len(t, buttons, 4, "추천 3곳의 방문 버튼과 투표 시작 버튼")
+	assert.Equal(t, slack.ActionStartPoll, buttons[3].ActionID)
+
+	// /lunch poll 45 금요일 점심
+	recorded, err = os.ReadFile("testdata/slack/command_poll.txt")
+	require.NoError(t, err)
+	message = decodeSlackMessage(t, sendSlackRequest(router, "/slack/commands", string(recorded)))
+	assert.Equal(t, slack.InChannel, message.ResponseType)
+	assert.Equal(t, "금요일 점심", message.Text)
+	var created models.Poll
+	require.NoError(t, database.DB.Order("id DESC").First(&created).Error)
+	assert.Equal(t, "slack-T0LUNCH01", created.Team)
+	assert.Equal(t, "minsu", created.CreatedBy)
+	assert.Equal(t, now.Add(45*time.Minute), created.Deadline.UTC())
+
+	buttons = slackButtons(message)
+	require.Len(t, buttons, 4, "후보 3곳의 투표 버튼과 마감 버튼")
+	assert.Equal(t, slack.ActionVote, buttons[1].ActionID)
+
+	// 버튼을 누르면 response_url로 갱신된 메시지가 원래 메시지를 대체
+	updated := sendSlackAction(t, router, responses, slack.ActionVote, buttons[1].Value)
+	assert.True(t, updated.ReplaceOriginal)
+	assert.Contains(t, updated.Blocks[4].Text.Text, "1표 · jiyoung")
+
+	closed := sendSlackAction(t, router, responses, slack.ActionClosePoll, buttons[3].Value)
+	assert.True(t, closed.ReplaceOriginal)
+	assert.Contains(t, closed.Text, "투표자 1명 방문 기록")
+	assert.Empty(t, slackButtons(closed))
+	var visit models.Visit
+	require.NoError(t, database.DB.Where("poll_id = ?", created.ID).First(&visit).Error)
+	assert.Equal(t, "jiyoung", visit.Visitor)
+
+	again := sendSlackAction(t, router, responses, slack.ActionVote, buttons[1].Value)
+	assert.Equal(t, slack.Ephemeral, again.ResponseType)
+	assert.Equal(t, "이미 마감된 투표입니다", again.Text)
+}
+
+func TestSlackLogVisit(t *testing.T) {
+	router, responses := setupSlackRouter(t, time.Now())
+	restaurants := []models.Restaurant{
+		{Name: "슬랙 방문 칼국수", Address: "서울시 슬랙구 3"},
+		{Name: "슬랙 방문 칼국수 2호점", Address: "서울시 슬랙구 4"},
+	}
+	require.NoError(t, database.DB.Create(&restaurants).Error)
+	command := func(text string) slack.Message {
+		body := url.Values{"command": {"/lunch"}, "text": {text}, "team_id": {"T0LUNCH01"}, "user_name": {"minsu"}}.Encode()
+		return decodeSlackMessage(t, sendSlackRequest(router, "/slack/commands", body))
+	}
+
+	// 이름이 정확히 같으면 바로 기록
+	message := command("log 슬랙 방문 칼국수")
+	assert.Equal(t, "*슬랙 방문 칼국수* 방문을 기록했습니다", message.Text)
+	var visit models.Visit
+	require.NoError(t, database.DB.Where("restaurant_id = ?", restaurants[0].ID).First(&visit).Error)
+	assert.Equal(t, "minsu", visit.Visitor)
+
+	// 여러 곳이 나오면 버튼으로 선택
+	message = command("log 방문 칼국")
+	buttons := slackButtons(message)
+	require.Len(t, buttons, 2)
+	logged := sendSlackAction(t, router, responses, slack.ActionLogVisit, buttons[1].Value)
+	assert.Equal(t, slack.Ephemeral, logged.ResponseType)
+	assert.Equal(t, "*슬랙 방문 칼국수 2호점* 방문을 기록했습니다", logged.Text)
+
+	assert.Equal(t, "'없는 맛집' 맛집을 찾을 수 없습니다", command("log 없는 맛집").Text)
+	assert.Contains(t, command("log").Text, "/lunch log")
+	assert.Contains(t, command("help").Text, "*점심 명령어*")
+	assert.Contains(t, command("poll 0").Text, "1~1440")
+}
+
+func TestSlackSignature(t *testing.T) {
+	router, _ := setupSlackRouter(t, time.Now())
+	body := "command=%2Flunch&text=help"
+
+	req, _ := http.NewRequest("POST", "/slack/commands", strings.NewReader(body))
+	req.Header.Set(slack.TimestampHeader, strconv.FormatInt(time.Now().Unix(), 10))
+	req.Header.Set(slack.SignatureHeader, "v0=0000")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// 5분보다 오래된 요청은 서명이 맞아도 거부
+	old := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+	req, _ = http.NewRequest("POST", "/slack/commands", strings.NewReader(body))
+	req.Header.Set(slack.TimestampHeader, old)
+	req.Header.Set(slack.SignatureHeader, slack.Sign(testSlackSecret, old, []byte(body)))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// response_url이 Slack 주소가 아니면 보내지 않음
+	w = sendSlackRequest(router, "/slack/interactions", url.Values{"payload": {fmt.Sprintf(`{"type":"block_actions","response_url":"http://169.254.169.254/","actions":[{"action_id":%q,"value":"1"}]}`, slack.ActionLogVisit)}}.Encode())
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = sendSlackRequest(router, "/slack/interactions", "payload=%7B")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	t.Setenv("SLACK_SIGNING_SECRET", "")
+	w = sendSlackRequest(router, "/slack/commands", body)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	var response apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierror.CodeSlackDisabled, response.Code)
+}
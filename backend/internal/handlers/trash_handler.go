@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/trash"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultTrashRetentionDays TRASH_RETENTION_DAYS 미설정 시 휴지통 보관 기간
+const defaultTrashRetentionDays = 30
+
+// ListTrashedRestaurants godoc
+// @Summary List deleted restaurants
+// @Description List soft-deleted restaurants, most recently deleted first
+// @Tags trash
+// @Produce json
+// @Success 200 {object} dto.ListResponse[dto.TrashedRestaurantResponse]
+// @Failure 500 {object} apierror.Response
+// @Router /trash/restaurants [get]
+func ListTrashedRestaurants(c *gin.Context) {
+	restaurants, err := trash.ListRestaurants(db(c))
+	if err != nil {
+		respondInternalError(c, i18n.TrashListFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewTrashedRestaurantResponses(restaurants)))
+}
+
+// RestoreRestaurant godoc
+// @Summary Restore a deleted restaurant
+// @Description Undo a soft delete and re-link visits whose restaurant reference was cleared
+// @Tags trash
+// @Produce json
+// @Param id path int true "Restaurant ID"
+// @Success 200 {object} dto.RestoreRestaurantResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /trash/restaurants/{id}/restore [post]
+func RestoreRestaurant(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+
+	restaurant, relinked, err := trash.RestoreRestaurant(db(c), id)
+	var conflict *trash.ConflictError
+	switch {
+	case errors.Is(err, trash.ErrNotInTrash):
+		respondError(c, http.StatusNotFound, apierror.CodeRestaurantNotFound, i18n.TrashNotFound)
+		return
+	case errors.As(err, &conflict):
+		// 같은 이름·주소의 맛집이 다시 등록된 경우 중복이 생기지 않도록 복원 거부
+		message := i18n.Message(c, i18n.TrashRestoreConflict, conflict.ExistingID)
+		apierror.Abort(c, http.StatusConflict, apierror.CodeRestoreConflict, message,
+			apierror.FieldError{Field: "id", Reason: "conflict", Message: message})
+		return
+	case err != nil:
+		respondInternalError(c, i18n.TrashRestoreFailed, err)
+		return
+	}
+
+	response := dto.RestoreRestaurantResponse{
+		Restaurant:     dto.NewRestaurantResponse(restaurant),
+		RelinkedVisits: relinked,
+	}
+	publishEvent(c, "", events.RestaurantRestored, response)
+	c.JSON(http.StatusOK, response)
+}
+
+// PurgeTrashedRestaurants godoc
+// @Summary Purge deleted restaurants (admin)
+// @Description Hard-delete restaurants that have been in the trash longer than the retention period
+// @Tags admin
+// @Produce json
+// @Param olderThanDays query int false "Retention in days (default TRASH_RETENTION_DAYS or 30)"
+// @Success 200 {object} dto.PurgeResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/trash/restaurants [delete]
+func PurgeTrashedRestaurants(c *gin.Context) {
+	days := trashRetentionDays()
+	if value := c.Query("olderThanDays"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			respondValidationError(c, []apierror.FieldError{
+				fieldError(c, "olderThanDays", "invalid", i18n.TrashInvalidRetention),
+			})
+			return
+		}
+		days = parsed
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -days)
+	purged, err := trash.PurgeRestaurants(db(c), cutoff)
+	if err != nil {
+		respondInternalError(c, i18n.TrashPurgeFailed, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PurgeResponse{Purged: purged, Cutoff: cutoff})
+}
+
+// trashRetentionDays TRASH_RETENTION_DAYS 환경변수 (잘못된 값이면 기본값)
+func trashRetentionDays() int {
+	if days, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS")); err == nil && days >= 0 {
+		return days
+	}
+	return defaultTrashRetentionDays
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"encoding/json"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTrashRouter() *gin.Engine {
+	router := setupRouter()
+	router.GET("/trash/restaurants", ListTrashedRestaurants)
+	router.POST("/trash/restaurants/:id/restore", RestoreRestaurant)
+	router.DELETE("/admin/trash/restaurants", PurgeTrashedRestaurants)
+	return router
+}
+
+func createDeletedRestaurant(t *testing.T, name string) (models.Restaurant, models.Visit) {
+	restaurant := models.Restaurant{Name: name, Address: "서울시 용산구 휴지통동", Latitude: 37.53, Longitude: 126.97}
+	assert.NoError(t, database.DB.Create(&restaurant).Error)
+	visit := models.Visit{RestaurantID: restaurant.ID, RestaurantSnapshot: restaurant.Snapshot(), VisitDate: time.Now()}
+	assert.NoError(t, database.DB.Create(&visit).Error)
+	assert.NoError(t, database.DB.Delete(&restaurant).Error)
+	return restaurant, visit
+}
+
+func TestTrash_ListAndRestore(t *testing.T) {
+	router := setupTrashRouter()
+	restaurant, _ := createDeletedRestaurant(t, "복원 테스트 맛집")
+
+	req, _ := http.NewRequest("GET", "/trash/restaurants", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var list dto.ListResponse[dto.TrashedRestaurantResponse]
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	found := false
+	for _, item := range list.Items {
+		if item.ID == restaurant.ID {
+			found = true
+			assert.False(t, item.DeletedAt.IsZero())
+		}
+	}
+	assert.True(t, found)
+
+	req, _ = http.NewRequest("POST", "/trash/restaurants/"+strconv.FormatUint(uint64(restaurant.ID), 10)+"/restore", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var restored models.Restaurant
+	assert.NoError(t, database.DB.First(&restored, restaurant.ID).Error)
+}
+
+func TestTrash_RestoreConflict(t *testing.T) {
+	router := setupTrashRouter()
+	restaurant, _ := createDeletedRestaurant(t, "충돌 테스트 맛집")
+
+	// 삭제 후 같은 이름·주소로 다시 등록된 경우
+	again := models.Restaurant{Name: restaurant.Name, Address: restaurant.Address, Latitude: 37.53, Longitude: 126.97}
+	assert.NoError(t, database.DB.Create(&again).Error)
+
+	req, _ := http.NewRequest("POST", "/trash/restaurants/"+strconv.FormatUint(uint64(restaurant.ID), 10)+"/restore", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response apierror.Response
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierror.CodeRestoreConflict, response.Code)
+}
+
+func TestTrash_PurgeKeepsVisitSnapshot(t *testing.T) {
+	router := setupTrashRouter()
+	restaurant, visit := createDeletedRestaurant(t, "영구 삭제 테스트 맛집")
+
+	req, _ := http.NewRequest("DELETE", "/admin/trash/restaurants?olderThanDays=0", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var count int64
+	database.DB.Unscoped().Model(&models.Restaurant{}).Where("id = ?", restaurant.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	var kept models.Visit
+	assert.NoError(t, database.DB.First(&kept, visit.ID).Error)
+	assert.Equal(t, uint(0), kept.RestaurantID)
+	assert.Equal(t, "영구 삭제 테스트 맛집", kept.RestaurantSnapshot.Name)
+
+	// 같은 맛집을 다시 등록한 뒤 휴지통에서 복원하면 끊긴 방문 기록이 다시 연결됨
+	relinkTarget, _ := createDeletedRestaurant(t, "영구 삭제 테스트 맛집")
+	req, _ = http.NewRequest("POST", "/trash/restaurants/"+strconv.FormatUint(uint64(relinkTarget.ID), 10)+"/restore", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var restored dto.RestoreRestaurantResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &restored))
+	assert.Equal(t, int64(1), restored.RelinkedVisits)
+}
+
+func TestTrash_PurgeRejectsInvalidRetention(t *testing.T) {
+	router := setupTrashRouter()
+
+	req, _ := http.NewRequest("DELETE", "/admin/trash/restaurants?olderThanDays=-1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"lunch_app/backend/internal/dto"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupV2Router() *gin.Engine {
+	router := setupRouter()
+	router.GET("/v2/restaurants", ListRestaurantsV2)
+	router.POST("/v2/restaurants", CreateRestaurantV2)
+	router.DELETE("/v2/restaurants/:id", DeleteRestaurantV2)
+	router.GET("/v2/visits", ListVisitsV2)
+	router.POST("/v2/visits", CreateVisitV2)
+	return router
+}
+
+func TestV2_RestaurantAndVisitUseCamelCaseDTOs(t *testing.T) {
+	router := setupV2Router()
+
+	body := `{"name":"v2 테스트 맛집","address":"서울시 마포구 v2동","latitude":37.55,"longitude":126.92}`
+	req, _ := http.NewRequest("POST", "/v2/restaurants", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.NotContains(t, w.Body.String(), "DeletedAt")
+
+	var restaurant dto.RestaurantResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &restaurant))
+	assert.Equal(t, "v2 테스트 맛집", restaurant.Name)
+	assert.Equal(t, "음식점", restaurant.Category)
+
+	visitBody, _ := json.Marshal(map[string]any{"restaurantId": restaurant.ID, "visitedAt": "2025-01-02T03:04:05Z"})
+	req, _ = http.NewRequest("POST", "/v2/visits", bytes.NewBuffer(visitBody))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var visit dto.VisitResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &visit))
+	assert.Equal(t, restaurant.ID, visit.Restaurant.ID)
+	assert.Equal(t, "2025-01-02", visit.Date)
+	assert.Equal(t, "12:04", visit.Time)
+	assert.False(t, visit.Restaurant.IsDeleted)
+
+	// 맛집 삭제 후에도 방문 기록은 방문 당시 맛집 정보와 함께 남고 삭제 표시됨
+	req, _ = http.NewRequest("DELETE", "/v2/restaurants/"+strconv.FormatUint(uint64(restaurant.ID), 10), nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	req, _ = http.NewRequest("GET", "/v2/visits", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var visits dto.ListResponse[dto.VisitResponse]
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &visits))
+	found := false
+	for _, v := range visits.Items {
+		if v.ID == visit.ID {
+			found = true
+			assert.True(t, v.Restaurant.IsDeleted)
+			assert.Equal(t, "v2 테스트 맛집", v.Restaurant.Name)
+			assert.Equal(t, "서울시 마포구 v2동", v.Restaurant.Address)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestV2_ValidationDetailsUseJSONFieldNames(t *testing.T) {
+	router := setupV2Router()
+
+	req, _ := http.NewRequest("POST", "/v2/visits", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"field":"restaurantId"`)
+	assert.Contains(t, w.Body.String(), `"field":"visitedAt"`)
+}
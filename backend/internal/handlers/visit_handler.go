@@ -1,166 +1,302 @@
 package handlers
 
 import (
-	"lunch_app/backend/internal/database"
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/i18n"
 	"lunch_app/backend/internal/models"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
-// 방문 기록 생성
-func CreateVisit(c *gin.Context) {
-	var input struct {
-		RestaurantID uint      `json:"RestaurantID" binding:"required"`
-		VisitDate    time.Time `json:"VisitDate" binding:"required"`
-	}
+// CreateVisitRequest 방문 기록 생성 요청
+type CreateVisitRequest struct {
+	RestaurantID uint      `json:"RestaurantID" binding:"required"`
+	VisitDate    time.Time `json:"VisitDate" binding:"required"`
+}
 
-	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
+// UpdateVisitRequest 방문 기록 수정 요청
+type UpdateVisitRequest struct {
+	VisitDate time.Time `json:"VisitDate" binding:"required"`
+}
 
-	// 레스토랑 존재 여부 확인
-	var restaurant models.Restaurant
-	if err := database.DB.First(&restaurant, input.RestaurantID).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Restaurant not found"})
-		return
-	}
+// VisitResponse 방문 기록 목록 응답 항목
+type VisitResponse struct {
+	ID                uint   `json:"ID"`
+	RestaurantID      uint   `json:"RestaurantID"`
+	RestaurantName    string `json:"restaurantName"`
+	RestaurantAddress string `json:"restaurantAddress"`
+	Date              string `json:"date"`
+	Time              string `json:"time"`
+	IsDeleted         bool   `json:"isDeleted"`
+}
 
-	// 한국 시간대로 변환하여 저장
-	koreaLocation, _ := time.LoadLocation("Asia/Seoul")
-	visitDateKST := input.VisitDate.In(koreaLocation)
+// UpdateVisitResponse 방문 기록 수정 응답
+type UpdateVisitResponse struct {
+	ID                uint   `json:"ID"`
+	RestaurantID      uint   `json:"RestaurantID"`
+	RestaurantName    string `json:"RestaurantName"`
+	RestaurantAddress string `json:"RestaurantAddress"`
+	Date              string `json:"Date"`
+	Time              string `json:"Time"`
+	IsDeleted         bool   `json:"IsDeleted"`
+	Message           string `json:"message"`
+}
 
-	visit := models.Visit{
-		RestaurantID: input.RestaurantID,
-		VisitDate:    visitDateKST,
+// CreateVisit godoc
+// @Summary Record a visit
+// @Description Record a visit to a restaurant (stored in Asia/Seoul time)
+// @Tags visits
+// @Accept json
+// @Produce json
+// @Param visit body CreateVisitRequest true "Visit object"
+// @Success 201 {object} models.Visit
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /visits [post]
+func CreateVisit(c *gin.Context) {
+	var input CreateVisitRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		respondBindError(c, err)
+		return
 	}
 
-	if err := database.DB.Create(&visit).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to record visit"})
+	visit, ok := createVisit(c, input.RestaurantID, input.VisitDate)
+	if !ok {
 		return
 	}
-
-	// 레스토랑 정보와 함께 방문 기록 반환
-	database.DB.Preload("Restaurant").First(&visit, visit.ID)
 	c.JSON(http.StatusCreated, visit)
 }
 
-// 모든 방문 기록 조회
+// GetAllVisits godoc
+// @Summary Get all visits
+// @Description Get visit history, newest first, including visits to deleted restaurants
+// @Tags visits
+// @Produce json
+// @Success 200 {array} VisitResponse
+// @Failure 500 {object} apierror.Response
+// @Router /visits [get]
 func GetAllVisits(c *gin.Context) {
-	var visits []models.Visit
-
-	// Restaurant 정보를 함께 가져오기 (삭제된 맛집도 포함)
-	result := database.DB.Preload("Restaurant").Order("visit_date desc").Find(&visits)
-	if result.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch visits"})
+	visits, ok := listVisits(c)
+	if !ok {
 		return
 	}
 
 	// 클라이언트에 보내기 쉬운 형태로 데이터 가공
-	type VisitResponse struct {
-		ID                uint   `json:"ID"`
-		RestaurantID      uint   `json:"RestaurantID"`
-		RestaurantName    string `json:"restaurantName"`
-		RestaurantAddress string `json:"restaurantAddress"`
-		Date              string `json:"date"`
-		Time              string `json:"time"`
-		IsDeleted         bool   `json:"isDeleted"`
-	}
-
 	var response []VisitResponse
-	
-	// 한국 시간대 설정
-	koreaLocation, _ := time.LoadLocation("Asia/Seoul")
-	
 	for _, visit := range visits {
-		// 한국 시간대로 변환 후 포맷팅
-		koreaTime := visit.VisitDate.In(koreaLocation)
-		date := koreaTime.Format("2006-01-02")
-		timeStr := koreaTime.Format("15:04")
-
-		// 맛집이 삭제되었는지 확인
-		isDeleted := visit.Restaurant.ID == 0
-		restaurantName := visit.Restaurant.Name
-		restaurantAddress := visit.Restaurant.Address
-
-		if isDeleted {
-			restaurantName = "삭제된 맛집"
-			restaurantAddress = "주소 정보 없음"
-		}
-
+		place := visitPlaceOf(c, visit)
 		response = append(response, VisitResponse{
 			ID:                visit.ID,
 			RestaurantID:      visit.RestaurantID,
-			RestaurantName:    restaurantName,
-			RestaurantAddress: restaurantAddress,
-			Date:              date,
-			Time:              timeStr,
-			IsDeleted:         isDeleted,
+			RestaurantName:    place.Name,
+			RestaurantAddress: place.Address,
+			Date:              place.Date,
+			Time:              place.Time,
+			IsDeleted:         place.IsDeleted,
 		})
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
-// 방문 기록 수정
+// UpdateVisit godoc
+// @Summary Update a visit
+// @Description Change the date of a visit record
+// @Tags visits
+// @Accept json
+// @Produce json
+// @Param id path int true "Visit ID"
+// @Param visit body UpdateVisitRequest true "New visit date"
+// @Success 200 {object} UpdateVisitResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /visits/{id} [put]
 func UpdateVisit(c *gin.Context) {
-	id := c.Param("id")
-	
-	var input struct {
-		VisitDate time.Time `json:"VisitDate" binding:"required"`
-	}
-
-	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	id, ok := parseID(c)
+	if !ok {
 		return
 	}
 
-	// 기존 방문 기록 찾기
-	var visit models.Visit
-	if err := database.DB.First(&visit, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Visit record not found"})
+	var input UpdateVisitRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		respondBindError(c, err)
 		return
 	}
 
-	// 방문 일자를 한국 시간대로 변환하여 업데이트
-	koreaLocation, _ := time.LoadLocation("Asia/Seoul")
-	visitDateKST := input.VisitDate.In(koreaLocation)
-	visit.VisitDate = visitDateKST
-	if err := database.DB.Save(&visit).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update visit record"})
+	visit, ok := updateVisitDate(c, id, input.VisitDate)
+	if !ok {
 		return
 	}
 
-	// 업데이트된 방문 기록을 레스토랑 정보와 함께 반환
-	database.DB.Preload("Restaurant").First(&visit, visit.ID)
-	
 	// 응답 형태로 변환
+	place := visitPlaceOf(c, visit)
+	koreaLocation, _ := time.LoadLocation("Asia/Seoul")
 	koreaTime := visit.VisitDate.In(koreaLocation)
-	
-	response := gin.H{
-		"ID":                visit.ID,
-		"RestaurantID":      visit.RestaurantID,
-		"RestaurantName":    visit.Restaurant.Name,
-		"RestaurantAddress": visit.Restaurant.Address,
-		"Date":              koreaTime.Format("2006-01-02"),
-		"Time":              koreaTime.Format("15:04"),
-		"IsDeleted":         visit.Restaurant.ID == 0,
-		"message":           "Visit record updated successfully",
+
+	response := UpdateVisitResponse{
+		ID:                visit.ID,
+		RestaurantID:      visit.RestaurantID,
+		RestaurantName:    place.Name,
+		RestaurantAddress: place.Address,
+		Date:              koreaTime.Format("2006-01-02"),
+		Time:              koreaTime.Format("15:04"),
+		IsDeleted:         place.IsDeleted,
+		Message:           i18n.Message(c, i18n.VisitUpdated),
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
-// 방문 기록 삭제
+// DeleteVisit godoc
+// @Summary Delete a visit
+// @Description Soft-delete a visit record
+// @Tags visits
+// @Produce json
+// @Param id path int true "Visit ID"
+// @Success 200 {object} MessageResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /visits/{id} [delete]
 func DeleteVisit(c *gin.Context) {
-	id := c.Param("id")
-
-	if err := database.DB.Delete(&models.Visit{}, id).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete visit record"})
+	if !deleteVisit(c) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Visit record deleted successfully"})
+	c.JSON(http.StatusOK, MessageResponse{Message: i18n.Message(c, i18n.VisitDeleted)})
+}
+
+// createVisit 맛집 존재 여부 확인 후 방문 기록 저장 (실패 시 에러 응답 후 false)
+func createVisit(c *gin.Context, restaurantID uint, visitDate time.Time) (models.Visit, bool) {
+	// 레스토랑 존재 여부 확인
+	var restaurant models.Restaurant
+	if err := db(c).First(&restaurant, restaurantID).Error; err != nil {
+		respondRestaurantLookupError(c, err)
+		return models.Visit{}, false
+	}
+
+	// 한국 시간대로 변환하여 저장, 방문 시점의 맛집 정보도 함께 보관
+	koreaLocation, _ := time.LoadLocation("Asia/Seoul")
+	visit := models.Visit{
+		RestaurantID:       restaurantID,
+		RestaurantSnapshot: restaurant.Snapshot(),
+		VisitDate:          visitDate.In(koreaLocation),
+	}
+
+	if err := db(c).Create(&visit).Error; err != nil {
+		respondInternalError(c, i18n.VisitCreateFailed, err)
+		return models.Visit{}, false
+	}
+
+	// 레스토랑 정보와 함께 방문 기록 반환
+	db(c).Scopes(withRestaurant).First(&visit, visit.ID)
+	publishEvent(c, "", events.VisitCreated, newVisitResponseV2(c, visit))
+	return visit, true
+}
+
+// listVisits 맛집 정보를 포함한 전체 방문 기록을 최신순으로 조회
+func listVisits(c *gin.Context) ([]models.Visit, bool) {
+	var visits []models.Visit
+
+	// Restaurant 정보를 함께 가져오기 (삭제된 맛집도 포함)
+	if err := db(c).Scopes(withRestaurant).Order("visit_date desc").Find(&visits).Error; err != nil {
+		respondInternalError(c, i18n.VisitListFailed, err)
+		return nil, false
+	}
+	return visits, true
+}
+
+// updateVisitDate 방문 일자를 한국 시간대로 변환하여 수정
+func updateVisitDate(c *gin.Context, id uint, visitDate time.Time) (models.Visit, bool) {
+	// 기존 방문 기록 찾기
+	var visit models.Visit
+	if err := db(c).First(&visit, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			respondError(c, http.StatusNotFound, apierror.CodeVisitNotFound, i18n.VisitNotFound)
+			return visit, false
+		}
+		respondInternalError(c, i18n.VisitLookupFailed, err)
+		return visit, false
+	}
+
+	koreaLocation, _ := time.LoadLocation("Asia/Seoul")
+	visit.VisitDate = visitDate.In(koreaLocation)
+	if err := db(c).Save(&visit).Error; err != nil {
+		respondInternalError(c, i18n.VisitUpdateFailed, err)
+		return visit, false
+	}
+
+	// 업데이트된 방문 기록을 레스토랑 정보와 함께 반환
+	db(c).Scopes(withRestaurant).First(&visit, visit.ID)
+	publishEvent(c, "", events.VisitUpdated, newVisitResponseV2(c, visit))
+	return visit, true
+}
+
+// deleteVisit 경로 파라미터 id의 방문 기록을 soft delete
+func deleteVisit(c *gin.Context) bool {
+	id, ok := parseID(c)
+	if !ok {
+		return false
+	}
+
+	result := db(c).Delete(&models.Visit{}, id)
+	if result.Error != nil {
+		respondInternalError(c, i18n.VisitDeleteFailed, result.Error)
+		return false
+	}
+	if result.RowsAffected == 0 {
+		respondError(c, http.StatusNotFound, apierror.CodeVisitNotFound, i18n.VisitNotFound)
+		return false
+	}
+	publishEvent(c, "", events.VisitDeleted, dto.DeletedEvent{ID: id})
+	return true
+}
+
+// withRestaurant soft delete된 맛집까지 포함해 Restaurant를 Preload
+func withRestaurant(tx *gorm.DB) *gorm.DB {
+	return tx.Preload("Restaurant", func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped()
+	})
+}
+
+// visitPlace 방문 기록 표시용 맛집 정보와 한국 시간 기준 날짜/시각
+type visitPlace struct {
+	models.RestaurantSnapshot
+	IsDeleted bool
+	Date      string
+	Time      string
+}
+
+// visitPlaceOf 방문 시점 스냅샷 → 현재 맛집 정보 → 대체 문구 순서로 맛집 정보를 채워서 반환
+// 스냅샷이 없는 기존 기록은 (삭제된 맛집 포함) 현재 맛집 정보로 보완
+func visitPlaceOf(c *gin.Context, visit models.Visit) visitPlace {
+	// 한국 시간대로 변환 후 포맷팅
+	koreaLocation, _ := time.LoadLocation("Asia/Seoul")
+	koreaTime := visit.VisitDate.In(koreaLocation)
+
+	place := visitPlace{
+		RestaurantSnapshot: visit.RestaurantSnapshot,
+		// 맛집이 삭제되었는지 확인 (soft delete 또는 레코드 없음)
+		IsDeleted: visit.Restaurant.ID == 0 || visit.Restaurant.DeletedAt.Valid,
+		Date:      koreaTime.Format("2006-01-02"),
+		Time:      koreaTime.Format("15:04"),
+	}
+	if place.Name == "" && visit.Restaurant.ID != 0 {
+		place.RestaurantSnapshot = visit.Restaurant.Snapshot()
+	}
+	if place.Name == "" {
+		place.Name = i18n.Message(c, i18n.VisitDeletedRestaurantName)
+	}
+	if place.Address == "" {
+		place.Address = i18n.Message(c, i18n.VisitNoAddress)
+	}
+	return place
 }
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListVisitsV2 godoc
+// @Summary List visits (v2)
+// @Tags visits-v2
+// @Produce json
+// @Success 200 {object} dto.ListResponse[dto.VisitResponse]
+// @Failure 500 {object} apierror.Response
+// @Router /v2/visits [get]
+func ListVisitsV2(c *gin.Context) {
+	visits, ok := listVisits(c)
+	if !ok {
+		return
+	}
+
+	responses := make([]dto.VisitResponse, 0, len(visits))
+	for _, visit := range visits {
+		responses = append(responses, newVisitResponseV2(c, visit))
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(responses))
+}
+
+// CreateVisitV2 godoc
+// @Summary Record a visit (v2)
+// @Tags visits-v2
+// @Accept json
+// @Produce json
+// @Param visit body dto.CreateVisitRequest true "Visit"
+// @Success 201 {object} dto.VisitResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /v2/visits [post]
+func CreateVisitV2(c *gin.Context) {
+	var input dto.CreateVisitRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		respondBindError(c, err)
+		return
+	}
+
+	visit, ok := createVisit(c, input.RestaurantID, input.VisitedAt)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusCreated, newVisitResponseV2(c, visit))
+}
+
+// UpdateVisitV2 godoc
+// @Summary Update a visit (v2)
+// @Tags visits-v2
+// @Accept json
+// @Produce json
+// @Param id path int true "Visit ID"
+// @Param visit body dto.UpdateVisitRequest true "New visit time"
+// @Success 200 {object} dto.VisitResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /v2/visits/{id} [put]
+func UpdateVisitV2(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+
+	var input dto.UpdateVisitRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		respondBindError(c, err)
+		return
+	}
+
+	visit, ok := updateVisitDate(c, id, input.VisitedAt)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, newVisitResponseV2(c, visit))
+}
+
+// DeleteVisitV2 godoc
+// @Summary Delete a visit (v2)
+// @Tags visits-v2
+// @Param id path int true "Visit ID"
+// @Success 204
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /v2/visits/{id} [delete]
+func DeleteVisitV2(c *gin.Context) {
+	if !deleteVisit(c) {
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// newVisitResponseV2 방문 기록 모델을 v2 응답으로 변환
+func newVisitResponseV2(c *gin.Context, visit models.Visit) dto.VisitResponse {
+	place := visitPlaceOf(c, visit)
+	koreaLocation, _ := time.LoadLocation("Asia/Seoul")
+
+	return dto.VisitResponse{
+		ID: visit.ID,
+		Restaurant: dto.VisitRestaurant{
+			ID:        visit.RestaurantID,
+			Name:      place.Name,
+			Address:   place.Address,
+			Category:  place.Category,
+			Latitude:  place.Latitude,
+			Longitude: place.Longitude,
+			IsDeleted: place.IsDeleted,
+		},
+		VisitedAt: visit.VisitDate.In(koreaLocation),
+		Date:      place.Date,
+		Time:      place.Time,
+		Visitor:   visit.Visitor,
+		PollID:    visit.PollID,
+		CreatedAt: visit.CreatedAt,
+		UpdatedAt: visit.UpdatedAt,
+	}
+}
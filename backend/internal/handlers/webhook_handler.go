@@ -0,0 +1,305 @@
+package handlers
+
+import (
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/webhook"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// maxWebhookDeliveryList 배달 기록 목록 최대 건수
+const maxWebhookDeliveryList = 50
+
+// webhookWorker 웹훅 발송 워커 (main에서 SetWebhookWorker로 설정, 없으면 다음 주기에 보냄)
+var webhookWorker interface{ Wake() }
+
+// SetWebhookWorker 수동 재전송을 바로 보낼 워커 설정
+func SetWebhookWorker(worker interface{ Wake() }) {
+	webhookWorker = worker
+}
+
+// ListWebhooks godoc
+// @Summary List webhooks (admin)
+// @Description Outgoing webhooks, oldest first. Secrets are not included
+// @Tags admin
+// @Produce json
+// @Param team query string false "Team"
+// @Success 200 {object} dto.ListResponse[dto.WebhookResponse]
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/webhooks [get]
+func ListWebhooks(c *gin.Context) {
+	query := db(c)
+	if team := c.Query("team"); team != "" {
+		query = query.Where("team = ?", team)
+	}
+	var hooks []models.Webhook
+	if err := query.Order("id").Find(&hooks).Error; err != nil {
+		respondInternalError(c, i18n.WebhookLookupFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewWebhookResponses(hooks)))
+}
+
+// CreateWebhook godoc
+// @Summary Register a webhook (admin)
+// @Description Subscribe a team to change events. Each delivery is a signed JSON POST; the secret is returned only in this response
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param webhook body dto.CreateWebhookRequest true "Webhook"
+// @Success 201 {object} dto.CreateWebhookResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/webhooks [post]
+func CreateWebhook(c *gin.Context) {
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindError(c, err)
+		return
+	}
+	if !validateWebhook(c, req.URL, req.Events) {
+		return
+	}
+
+	hook := models.Webhook{
+		Team:   strings.TrimSpace(req.Team),
+		URL:    req.URL,
+		Secret: req.Secret,
+		Active: req.Active == nil || *req.Active,
+	}
+	if hook.Secret == "" {
+		hook.Secret = webhook.NewSecret()
+	}
+	hook.SetEvents(req.Events)
+	if err := db(c).Create(&hook).Error; err != nil {
+		respondInternalError(c, i18n.WebhookSaveFailed, err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.CreateWebhookResponse{WebhookResponse: dto.NewWebhookResponse(hook), Secret: hook.Secret})
+}
+
+// GetWebhook godoc
+// @Summary Get a webhook (admin)
+// @Tags admin
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} dto.WebhookResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/webhooks/{id} [get]
+func GetWebhook(c *gin.Context) {
+	hook, ok := findWebhook(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewWebhookResponse(hook))
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook (admin)
+// @Description Change the URL, subscribed events or active flag. Omitted fields are kept; the secret cannot be changed
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param webhook body dto.UpdateWebhookRequest true "Fields to change"
+// @Success 200 {object} dto.WebhookResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/webhooks/{id} [put]
+func UpdateWebhook(c *gin.Context) {
+	hook, ok := findWebhook(c)
+	if !ok {
+		return
+	}
+	var req dto.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindError(c, err)
+		return
+	}
+
+	if req.URL != nil {
+		hook.URL = *req.URL
+	}
+	if req.Events != nil {
+		hook.SetEvents(req.Events)
+	}
+	if req.Active != nil {
+		hook.Active = *req.Active
+	}
+	if !validateWebhook(c, hook.URL, hook.Events()) {
+		return
+	}
+	if err := db(c).Save(&hook).Error; err != nil {
+		respondInternalError(c, i18n.WebhookSaveFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewWebhookResponse(hook))
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook (admin)
+// @Description Delete the webhook and its delivery log. Pending deliveries are not sent
+// @Tags admin
+// @Param id path int true "Webhook ID"
+// @Success 204
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/webhooks/{id} [delete]
+func DeleteWebhook(c *gin.Context) {
+	id, ok := parseID(c)
+	if !ok {
+		return
+	}
+	err := webhook.DeleteWebhook(db(c), id)
+	if errors.Is(err, webhook.ErrNotFound) {
+		respondError(c, http.StatusNotFound, apierror.CodeWebhookNotFound, i18n.WebhookNotFound)
+		return
+	}
+	if err != nil {
+		respondInternalError(c, i18n.WebhookDeleteFailed, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries godoc
+// @Summary List webhook deliveries (admin)
+// @Description Delivery log of a webhook, newest first, at most 50
+// @Tags admin
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param status query string false "pending, succeeded or failed"
+// @Success 200 {object} dto.ListResponse[dto.WebhookDeliveryResponse]
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/webhooks/{id}/deliveries [get]
+func ListWebhookDeliveries(c *gin.Context) {
+	hook, ok := findWebhook(c)
+	if !ok {
+		return
+	}
+	query := db(c).Where("webhook_id = ?", hook.ID)
+	if status := c.Query("status"); status != "" {
+		if !slices.Contains([]string{models.WebhookDeliveryPending, models.WebhookDeliverySucceeded, models.WebhookDeliveryFailed}, status) {
+			respondValidationError(c, []apierror.FieldError{fieldError(c, "status", "oneof", i18n.WebhookInvalidStatus)})
+			return
+		}
+		query = query.Where("status = ?", status)
+	}
+
+	var deliveries []models.WebhookDelivery
+	if err := query.Order("id DESC").Limit(maxWebhookDeliveryList).Find(&deliveries).Error; err != nil {
+		respondInternalError(c, i18n.WebhookLookupFailed, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewListResponse(dto.NewWebhookDeliveryResponses(deliveries)))
+}
+
+// RedeliverWebhook godoc
+// @Summary Redeliver a webhook delivery (admin)
+// @Description Queue a new delivery with the same payload as a finished one. The original log entry is kept
+// @Tags admin
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param deliveryId path int true "Delivery ID"
+// @Success 202 {object} dto.WebhookDeliveryResponse
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /admin/webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func RedeliverWebhook(c *gin.Context) {
+	hook, ok := findWebhook(c)
+	if !ok {
+		return
+	}
+	deliveryID, err := strconv.ParseUint(c.Param("deliveryId"), 10, 64)
+	if err != nil || deliveryID == 0 {
+		respondValidationError(c, []apierror.FieldError{fieldError(c, "deliveryId", "invalid", i18n.ValidationInvalidID)})
+		return
+	}
+
+	redelivery, err := webhook.Redeliver(db(c), hook.ID, uint(deliveryID), time.Now())
+	switch {
+	case errors.Is(err, webhook.ErrNotFound):
+		respondError(c, http.StatusNotFound, apierror.CodeDeliveryNotFound, i18n.WebhookDeliveryNotFound)
+		return
+	case errors.Is(err, webhook.ErrDeliveryPending):
+		respondError(c, http.StatusConflict, apierror.CodeDeliveryPending, i18n.WebhookDeliveryPending)
+		return
+	case err != nil:
+		respondInternalError(c, i18n.WebhookRedeliverFailed, err)
+		return
+	}
+	if webhookWorker != nil {
+		webhookWorker.Wake()
+	}
+	c.JSON(http.StatusAccepted, dto.NewWebhookDeliveryResponse(redelivery))
+}
+
+// findWebhook 경로의 ID로 웹훅 조회 (실패 시 에러 응답 후 false)
+func findWebhook(c *gin.Context) (models.Webhook, bool) {
+	id, ok := parseID(c)
+	if !ok {
+		return models.Webhook{}, false
+	}
+	var hook models.Webhook
+	err := db(c).First(&hook, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		respondError(c, http.StatusNotFound, apierror.CodeWebhookNotFound, i18n.WebhookNotFound)
+		return models.Webhook{}, false
+	}
+	if err != nil {
+		respondInternalError(c, i18n.WebhookLookupFailed, err)
+		return models.Webhook{}, false
+	}
+	return hook, true
+}
+
+// validateWebhook URL 스킴과 이벤트 종류 검증 (실패 시 검증 에러 응답 후 false)
+func validateWebhook(c *gin.Context, rawURL string, eventTypes []string) bool {
+	var details []apierror.FieldError
+	if parsed, err := url.Parse(rawURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		details = append(details, fieldError(c, "url", "scheme", i18n.WebhookInvalidURL))
+	}
+	for i, eventType := range eventTypes {
+		if !webhook.IsEventType(eventType) {
+			details = append(details, fieldError(c, "events["+strconv.Itoa(i)+"]", "oneof", i18n.WebhookInvalidEvent, eventType))
+		}
+	}
+	if len(details) > 0 {
+		respondValidationError(c, details)
+		return false
+	}
+	return true
+}
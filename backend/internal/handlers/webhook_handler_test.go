@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/database"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupWebhookRouter() *gin.Engine {
+	router := setupRouter()
+	router.GET("/admin/webhooks", ListWebhooks)
+	router.POST("/admin/webhooks", CreateWebhook)
+	router.GET("/admin/webhooks/:id", GetWebhook)
+	router.PUT("/admin/webhooks/:id", UpdateWebhook)
+	router.DELETE("/admin/webhooks/:id", DeleteWebhook)
+	router.GET("/admin/webhooks/:id/deliveries", ListWebhookDeliveries)
+	router.POST("/admin/webhooks/:id/deliveries/:deliveryId/redeliver", RedeliverWebhook)
+	return router
+}
+
+func sendWebhookRequest(router *gin.Engine, method, path string, body any) *httptest.ResponseRecorder {
+	var reader *bytes.Reader
+	if body != nil {
+		data, _ := json.Marshal(body)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	req, _ := http.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+type fakeWaker struct{ woken int }
+
+func (f *fakeWaker) Wake() { f.woken++ }
+
+func TestWebhooks_CRUD(t *testing.T) {
+	router := setupWebhookRouter()
+
+	w := sendWebhookRequest(router, "POST", "/admin/webhooks", map[string]any{
+		"team":   "webhook-crud",
+		"url":    "https://example.com/lunch",
+		"events": []string{events.VisitCreated, events.PollClosed},
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created dto.CreateWebhookResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.True(t, strings.HasPrefix(created.Secret, "whsec_"))
+	assert.True(t, created.Active, "active 생략 시 활성")
+	assert.Equal(t, []string{events.VisitCreated, events.PollClosed}, created.Events)
+
+	w = sendWebhookRequest(router, "GET", "/admin/webhooks?team=webhook-crud", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), created.Secret, "조회 응답에는 서명 키가 없음")
+	var list dto.ListResponse[dto.WebhookResponse]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, created.ID, list.Items[0].ID)
+
+	path := fmt.Sprintf("/admin/webhooks/%d", created.ID)
+	w = sendWebhookRequest(router, "PUT", path, map[string]any{"active": false, "events": []string{events.DrawCreated}})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var updated dto.WebhookResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+	assert.False(t, updated.Active)
+	assert.Equal(t, "https://example.com/lunch", updated.URL, "생략한 필드는 그대로")
+	assert.Equal(t, []string{events.DrawCreated}, updated.Events)
+
+	w = sendWebhookRequest(router, "DELETE", path, nil)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	w = sendWebhookRequest(router, "GET", path, nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	var response apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierror.CodeWebhookNotFound, response.Code)
+}
+
+func TestCreateWebhook_Validation(t *testing.T) {
+	router := setupWebhookRouter()
+
+	w := sendWebhookRequest(router, "POST", "/admin/webhooks", map[string]any{
+		"team":   "webhook-invalid",
+		"url":    "ftp://example.com/lunch",
+		"events": []string{events.VisitCreated, "lunch.eaten"},
+	})
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierror.CodeValidationFailed, response.Code)
+	require.Len(t, response.Details, 2)
+	assert.Equal(t, "url", response.Details[0].Field)
+	assert.Equal(t, "events[1]", response.Details[1].Field)
+
+	w = sendWebhookRequest(router, "POST", "/admin/webhooks", map[string]any{
+		"team": "webhook-invalid", "url": "https://example.com/lunch", "events": []string{},
+	})
+	assert.Equal(t, http.StatusBadRequest, w.Code, "이벤트를 하나 이상 구독해야 함")
+}
+
+func TestWebhookDeliveries_ListAndRedeliver(t *testing.T) {
+	router := setupWebhookRouter()
+	waker := &fakeWaker{}
+	SetWebhookWorker(waker)
+	defer SetWebhookWorker(nil)
+
+	hook := models.Webhook{Team: "webhook-deliveries", URL: "https://example.com/lunch", Secret: "test-secret", Active: true}
+	hook.SetEvents([]string{events.PollClosed})
+	require.NoError(t, database.DB.Create(&hook).Error)
+	failed := models.WebhookDelivery{WebhookID: hook.ID, EventType: events.PollClosed, Payload: `{"event":"poll.closed"}`, Status: models.WebhookDeliveryFailed, Attempts: 8, ResponseStatus: 500, LastError: "unexpected status 500"}
+	pending := models.WebhookDelivery{WebhookID: hook.ID, EventType: events.PollClosed, Payload: `{"event":"poll.closed"}`, Status: models.WebhookDeliveryPending}
+	require.NoError(t, database.DB.Create(&[]*models.WebhookDelivery{&failed, &pending}).Error)
+
+	base := fmt.Sprintf("/admin/webhooks/%d/deliveries", hook.ID)
+	w := sendWebhookRequest(router, "GET", base+"?status=failed", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var list dto.ListResponse[dto.WebhookDeliveryResponse]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, failed.ID, list.Items[0].ID)
+	assert.Nil(t, list.Items[0].NextAttemptAt, "끝난 배달은 다음 시도 없음")
+	assert.JSONEq(t, failed.Payload, string(list.Items[0].Payload))
+
+	w = sendWebhookRequest(router, "GET", base+"?status=lost", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = sendWebhookRequest(router, "POST", fmt.Sprintf("%s/%d/redeliver", base, failed.ID), nil)
+	require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+	var redelivery dto.WebhookDeliveryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &redelivery))
+	assert.Equal(t, models.WebhookDeliveryPending, redelivery.Status)
+	assert.Equal(t, &failed.ID, redelivery.RedeliveryOf)
+	assert.NotNil(t, redelivery.NextAttemptAt)
+	assert.Equal(t, 1, waker.woken, "재전송은 워커를 바로 깨움")
+
+	w = sendWebhookRequest(router, "POST", fmt.Sprintf("%s/%d/redeliver", base, pending.ID), nil)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	var response apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierror.CodeDeliveryPending, response.Code)
+
+	w = sendWebhookRequest(router, "POST", fmt.Sprintf("%s/%d/redeliver", base, failed.ID+1000), nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierror.CodeDeliveryNotFound, response.Code)
+}
@@ -0,0 +1,266 @@
+package hours
+
+import (
+	"errors"
+	"fmt"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// minutesPerDay 하루의 분 (다음 날 새벽까지 이어지는 영업은 이 값을 넘음)
+const minutesPerDay = 24 * 60
+
+var (
+	// ErrEmptyRange 시작과 종료가 같음
+	ErrEmptyRange = errors.New("hours: opens and closes must differ")
+	// ErrBreakPair 브레이크타임 시작과 끝 중 하나만 있음
+	ErrBreakPair = errors.New("hours: breakStart and breakEnd must be set together")
+	// ErrBreakOutside 브레이크타임이 영업시간 안에 있지 않음
+	ErrBreakOutside = errors.New("hours: break must be within opening hours")
+)
+
+// ClockError 시각이 "HH:MM" 형식이 아님 (24:00은 종료 시각에만)
+type ClockError struct {
+	// Field opens, closes, breakStart, breakEnd
+	Field string
+}
+
+func (e *ClockError) Error() string {
+	return fmt.Sprintf("hours: %s must be HH:MM", e.Field)
+}
+
+// ParseTime open_at 값 해석: "now", RFC 3339, 또는 시간대 없는 "YYYY-MM-DDTHH:MM" (Asia/Seoul)
+func ParseTime(value string, now time.Time) (time.Time, error) {
+	if strings.EqualFold(value, "now") {
+		return now, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02T15:04", value, kst.Location())
+}
+
+// Validate 하루 영업시간 검증 (브레이크타임은 없으면 빈 문자열)
+func Validate(opens, closes, breakStart, breakEnd string) error {
+	_, err := newSpan(opens, closes, breakStart, breakEnd)
+	return err
+}
+
+// span 하루 영업 구간 (그날 자정부터의 분, 다음 날 새벽까지면 close가 minutesPerDay를 넘음)
+type span struct {
+	open, close          int
+	breakStart, breakEnd int
+}
+
+func newSpan(opens, closes, breakStart, breakEnd string) (span, error) {
+	var s span
+	var ok bool
+	if s.open, ok = parseClock(opens, false); !ok {
+		return span{}, &ClockError{Field: "opens"}
+	}
+	if s.close, ok = parseClock(closes, true); !ok {
+		return span{}, &ClockError{Field: "closes"}
+	}
+	if s.open == s.close {
+		return span{}, ErrEmptyRange
+	}
+	if s.close < s.open {
+		s.close += minutesPerDay
+	}
+
+	if (breakStart == "") != (breakEnd == "") {
+		return span{}, ErrBreakPair
+	}
+	if breakStart == "" {
+		return s, nil
+	}
+	if s.breakStart, ok = parseClock(breakStart, false); !ok {
+		return span{}, &ClockError{Field: "breakStart"}
+	}
+	if s.breakEnd, ok = parseClock(breakEnd, true); !ok {
+		return span{}, &ClockError{Field: "breakEnd"}
+	}
+	// 자정을 넘긴 영업의 새벽 브레이크타임
+	if s.breakStart < s.open {
+		s.breakStart += minutesPerDay
+	}
+	if s.breakEnd <= s.breakStart {
+		s.breakEnd += minutesPerDay
+	}
+	if s.breakStart <= s.open || s.breakEnd >= s.close {
+		return span{}, ErrBreakOutside
+	}
+	return s, nil
+}
+
+// contains 그날 자정부터 minute분이 영업 중인지 (브레이크타임 제외)
+func (s span) contains(minute int) bool {
+	if minute < s.open || minute >= s.close {
+		return false
+	}
+	return minute < s.breakStart || minute >= s.breakEnd
+}
+
+// parseClock "HH:MM"을 자정부터의 분으로 변환 (end면 24:00 허용)
+func parseClock(value string, end bool) (int, bool) {
+	if len(value) != 5 || value[2] != ':' || strings.Trim(value[:2]+value[3:], "0123456789") != "" {
+		return 0, false
+	}
+	hour, _ := strconv.Atoi(value[:2])
+	minute, _ := strconv.Atoi(value[3:])
+	if hour == 24 && minute == 0 && end {
+		return minutesPerDay, true
+	}
+	if hour > 23 || minute > 59 {
+		return 0, false
+	}
+	return hour*60 + minute, true
+}
+
+// Schedule 맛집 하나의 영업시간 (요일별 영업시간과 날짜별 예외)
+type Schedule struct {
+	Weekly   []models.OpeningHours
+	Holidays []models.RestaurantHoliday
+}
+
+// OpenAt t에 영업 중인지 Asia/Seoul 기준으로 판단 (전날 밤부터 이어지는 영업 포함)
+// 영업시간이 없는 요일과 휴무로 지정한 날짜는 영업하지 않는 것으로 봄
+func (s Schedule) OpenAt(t time.Time) bool {
+	local := t.In(kst.Location())
+	date := kst.Date(local)
+	minute := local.Hour()*60 + local.Minute()
+	if today, ok := s.day(date); ok && today.contains(minute) {
+		return true
+	}
+	yesterday, ok := s.day(date.AddDate(0, 0, -1))
+	return ok && yesterday.contains(minute+minutesPerDay)
+}
+
+// day date의 영업 구간 (날짜별 예외가 요일별 영업시간보다 우선, 쉬는 날이면 false)
+func (s Schedule) day(date time.Time) (span, bool) {
+	key := date.Format(kst.DateLayout)
+	for _, h := range s.Holidays {
+		if h.Date.In(kst.Location()).Format(kst.DateLayout) != key {
+			continue
+		}
+		if h.Closed {
+			return span{}, false
+		}
+		sp, err := newSpan(h.Opens, h.Closes, h.BreakStart, h.BreakEnd)
+		return sp, err == nil
+	}
+	for _, w := range s.Weekly {
+		if w.Weekday == int(date.Weekday()) {
+			sp, err := newSpan(w.Opens, w.Closes, w.BreakStart, w.BreakEnd)
+			return sp, err == nil
+		}
+	}
+	return span{}, false
+}
+
+// Load ids 맛집의 영업시간 (날짜별 예외는 t의 전날과 당일만 읽음)
+func Load(db *gorm.DB, ids []uint, t time.Time) (map[uint]Schedule, error) {
+	schedules := make(map[uint]Schedule, len(ids))
+	if len(ids) == 0 {
+		return schedules, nil
+	}
+
+	var weekly []models.OpeningHours
+	if err := db.Where("restaurant_id IN ?", ids).Find(&weekly).Error; err != nil {
+		return nil, err
+	}
+	date := kst.Date(t)
+	var holidays []models.RestaurantHoliday
+	err := db.Where("restaurant_id IN ? AND date IN ?", ids, []time.Time{date.AddDate(0, 0, -1), date}).
+		Find(&holidays).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range weekly {
+		s := schedules[w.RestaurantID]
+		s.Weekly = append(s.Weekly, w)
+		schedules[w.RestaurantID] = s
+	}
+	for _, h := range holidays {
+		s := schedules[h.RestaurantID]
+		s.Holidays = append(s.Holidays, h)
+		schedules[h.RestaurantID] = s
+	}
+	return schedules, nil
+}
+
+// OpenIDs ids 중 t에 영업 중인 맛집 (영업시간을 등록하지 않은 맛집은 제외)
+func OpenIDs(db *gorm.DB, ids []uint, t time.Time) (map[uint]bool, error) {
+	schedules, err := Load(db, ids, t)
+	if err != nil {
+		return nil, err
+	}
+	open := make(map[uint]bool, len(schedules))
+	for id, s := range schedules {
+		if s.OpenAt(t) {
+			open[id] = true
+		}
+	}
+	return open, nil
+}
+
+// FilterOpen restaurants 중 t에 영업 중인 맛집만 순서대로 남김
+func FilterOpen(db *gorm.DB, restaurants []models.Restaurant, t time.Time) ([]models.Restaurant, error) {
+	ids := make([]uint, 0, len(restaurants))
+	for _, r := range restaurants {
+		ids = append(ids, r.ID)
+	}
+	open, err := OpenIDs(db, ids, t)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]models.Restaurant, 0, len(open))
+	for _, r := range restaurants {
+		if open[r.ID] {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered, nil
+}
+
+// ReplaceWeekly 맛집의 요일별 영업시간을 모두 바꿈 (빈 목록이면 삭제)
+func ReplaceWeekly(db *gorm.DB, restaurantID uint, weekly []models.OpeningHours) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("restaurant_id = ?", restaurantID).Delete(&models.OpeningHours{}).Error; err != nil {
+			return err
+		}
+		if len(weekly) == 0 {
+			return nil
+		}
+		for i := range weekly {
+			weekly[i].ID = 0
+			weekly[i].RestaurantID = restaurantID
+		}
+		return tx.Create(&weekly).Error
+	})
+}
+
+// SetHoliday 맛집의 holiday.Date 예외를 만들거나 바꿈 (새로 만들었으면 true)
+func SetHoliday(db *gorm.DB, restaurantID uint, holiday models.RestaurantHoliday) (models.RestaurantHoliday, bool, error) {
+	date := kst.Date(holiday.Date)
+	var existing models.RestaurantHoliday
+	err := db.Where("restaurant_id = ? AND date = ?", restaurantID, date).First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		holiday.ID = 0
+		holiday.RestaurantID = restaurantID
+		holiday.Date = date
+		err = db.Create(&holiday).Error
+		return holiday, err == nil, err
+	}
+	if err != nil {
+		return existing, false, err
+	}
+	err = db.Model(&existing).Select("Closed", "Opens", "Closes", "BreakStart", "BreakEnd", "Note").Updates(holiday).Error
+	return existing, false, err
+}
@@ -0,0 +1,152 @@
+package hours
+
+import (
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// at 2024-07-xx HH:MM Asia/Seoul (7월 1일이 월요일)
+func at(day, hour, minute int) time.Time {
+	return time.Date(2024, 7, day, hour, minute, 0, 0, kst.Location())
+}
+
+func TestSchedule_OpenAt(t *testing.T) {
+	s := Schedule{
+		Weekly: []models.OpeningHours{
+			// 화~금 11:00~21:00, 브레이크타임 15:00~17:00 (월요일 정기 휴무)
+			{Weekday: 2, Opens: "11:00", Closes: "21:00", BreakStart: "15:00", BreakEnd: "17:00"},
+			{Weekday: 3, Opens: "11:00", Closes: "21:00", BreakStart: "15:00", BreakEnd: "17:00"},
+			{Weekday: 4, Opens: "11:00", Closes: "21:00", BreakStart: "15:00", BreakEnd: "17:00"},
+			{Weekday: 5, Opens: "11:00", Closes: "21:00", BreakStart: "15:00", BreakEnd: "17:00"},
+			// 토요일은 새벽 2시까지
+			{Weekday: 6, Opens: "17:00", Closes: "02:00"},
+		},
+		Holidays: []models.RestaurantHoliday{
+			{Date: at(10, 0, 0), Closed: true},
+			{Date: at(11, 0, 0), Opens: "11:00", Closes: "14:00"},
+		},
+	}
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"월요일 휴무", at(1, 12, 0), false},
+		{"영업 시작", at(2, 11, 0), true},
+		{"영업 전", at(2, 10, 59), false},
+		{"브레이크타임", at(2, 15, 30), false},
+		{"브레이크타임 끝", at(2, 17, 0), true},
+		{"마감", at(2, 21, 0), false},
+		{"토요일 밤", at(6, 23, 30), true},
+		{"일요일 새벽까지 이어짐", at(7, 1, 59), true},
+		{"일요일 새벽 마감", at(7, 2, 0), false},
+		{"휴무일", at(10, 12, 0), false},
+		{"단축 영업", at(11, 13, 0), true},
+		{"단축 영업 마감 후", at(11, 18, 0), false},
+		{"UTC로 준 시각도 서울 기준", time.Date(2024, 7, 2, 3, 30, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, s.OpenAt(tt.at))
+		})
+	}
+	assert.False(t, Schedule{}.OpenAt(at(2, 12, 0)), "영업시간이 없으면 영업하지 않는 것으로 봄")
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("11:00", "21:00", "15:00", "17:00"))
+	assert.NoError(t, Validate("00:00", "24:00", "", ""))
+	assert.NoError(t, Validate("18:00", "03:00", "00:00", "00:30"), "자정을 넘긴 브레이크타임")
+
+	var clockErr *ClockError
+	require.ErrorAs(t, Validate("11:00", "25:00", "", ""), &clockErr)
+	assert.Equal(t, "closes", clockErr.Field)
+	require.ErrorAs(t, Validate("24:00", "23:00", "", ""), &clockErr)
+	assert.Equal(t, "opens", clockErr.Field)
+	require.ErrorAs(t, Validate("11:00", "21:00", "3pm", "17:00"), &clockErr)
+	assert.Equal(t, "breakStart", clockErr.Field)
+	assert.ErrorAs(t, Validate("9:00", "21:00", "", ""), &clockErr)
+
+	assert.ErrorIs(t, Validate("11:00", "11:00", "", ""), ErrEmptyRange)
+	assert.ErrorIs(t, Validate("11:00", "21:00", "15:00", ""), ErrBreakPair)
+	assert.ErrorIs(t, Validate("11:00", "21:00", "10:00", "12:00"), ErrBreakOutside)
+	assert.ErrorIs(t, Validate("11:00", "21:00", "20:00", "21:00"), ErrBreakOutside)
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2024, 7, 2, 3, 0, 0, 0, time.UTC)
+	got, err := ParseTime("now", now)
+	require.NoError(t, err)
+	assert.Equal(t, now, got)
+
+	got, err = ParseTime("2024-07-02T12:30", now)
+	require.NoError(t, err)
+	assert.True(t, at(2, 12, 30).Equal(got), "시간대가 없으면 Asia/Seoul")
+
+	got, err = ParseTime("2024-07-02T03:30:00Z", now)
+	require.NoError(t, err)
+	assert.True(t, at(2, 12, 30).Equal(got))
+
+	_, err = ParseTime("tomorrow", now)
+	assert.Error(t, err)
+}
+
+func TestFilterOpen(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{TranslateError: true})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Restaurant{}, &models.OpeningHours{}, &models.RestaurantHoliday{}))
+
+	restaurants := []models.Restaurant{{Name: "국밥집"}, {Name: "이자카야"}, {Name: "영업시간 모름"}}
+	require.NoError(t, db.Create(&restaurants).Error)
+	require.NoError(t, ReplaceWeekly(db, restaurants[0].ID, []models.OpeningHours{
+		{Weekday: 1, Opens: "00:00", Closes: "24:00"},
+		{Weekday: 2, Opens: "00:00", Closes: "24:00"},
+	}))
+	require.NoError(t, ReplaceWeekly(db, restaurants[1].ID, []models.OpeningHours{
+		{Weekday: 1, Opens: "18:00", Closes: "02:00"},
+	}))
+
+	names := func(rs []models.Restaurant) []string {
+		out := []string{}
+		for _, r := range rs {
+			out = append(out, r.Name)
+		}
+		return out
+	}
+
+	open, err := FilterOpen(db, restaurants, at(2, 1, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"국밥집", "이자카야"}, names(open), "월요일 밤 영업이 화요일 새벽까지")
+
+	// 월요일 휴무로 지정하면 화요일 새벽 영업도 없음
+	holiday, created, err := SetHoliday(db, restaurants[1].ID, models.RestaurantHoliday{Date: at(1, 15, 0), Closed: true, Note: "임시 휴업"})
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.True(t, at(1, 0, 0).Equal(holiday.Date), "날짜는 서울 자정으로 저장")
+	open, err = FilterOpen(db, restaurants, at(2, 1, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"국밥집"}, names(open))
+
+	// 같은 날짜를 다시 지정하면 바꿈
+	_, created, err = SetHoliday(db, restaurants[1].ID, models.RestaurantHoliday{Date: at(1, 0, 0), Opens: "18:00", Closes: "23:00"})
+	require.NoError(t, err)
+	assert.False(t, created)
+	open, err = FilterOpen(db, restaurants, at(1, 22, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"국밥집", "이자카야"}, names(open))
+	var count int64
+	require.NoError(t, db.Model(&models.RestaurantHoliday{}).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+
+	require.NoError(t, ReplaceWeekly(db, restaurants[0].ID, nil))
+	open, err = FilterOpen(db, restaurants, at(2, 12, 0))
+	require.NoError(t, err)
+	assert.Empty(t, open)
+}
@@ -0,0 +1,63 @@
+package i18n
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+)
+
+// Lang 지원하는 응답 언어
+type Lang string
+
+const (
+	KO Lang = "ko"
+	EN Lang = "en"
+)
+
+// DefaultLang Accept-Language가 없거나 지원하지 않는 언어일 때 사용
+const DefaultLang = KO
+
+// 지원 언어 순서는 Lang 상수와 일치해야 함 (첫 번째가 기본값)
+var (
+	supported = []Lang{KO, EN}
+	matcher   = language.NewMatcher([]language.Tag{language.Korean, language.English})
+)
+
+// FromRequest Accept-Language 헤더에서 가장 알맞은 지원 언어 선택
+func FromRequest(r *http.Request) Lang {
+	header := r.Header.Get("Accept-Language")
+	if header == "" {
+		return DefaultLang
+	}
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 {
+		return DefaultLang
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLang
+	}
+	return supported[index]
+}
+
+// T 언어별 메시지 조회, 번역이 없으면 기본 언어 → 키 순서로 대체
+func T(lang Lang, key Key, args ...any) string {
+	message, ok := catalog[lang][key]
+	if !ok {
+		message, ok = catalog[DefaultLang][key]
+	}
+	if !ok {
+		message = string(key)
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+	return message
+}
+
+// Message 요청의 Accept-Language에 맞는 메시지 반환
+func Message(c *gin.Context, key Key, args ...any) string {
+	return T(FromRequest(c.Request), key, args...)
+}
@@ -0,0 +1,47 @@
+package i18n
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       Lang
+	}{
+		{name: "헤더 없음", acceptLanguage: "", expected: KO},
+		{name: "영어", acceptLanguage: "en-US,en;q=0.9", expected: EN},
+		{name: "한국어 우선", acceptLanguage: "ko-KR,ko;q=0.9,en;q=0.8", expected: KO},
+		{name: "영어 우선", acceptLanguage: "en;q=0.9,ko;q=0.5", expected: EN},
+		{name: "지원하지 않는 언어", acceptLanguage: "fr-FR", expected: KO},
+		{name: "잘못된 형식", acceptLanguage: ";;;", expected: KO},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "/", nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			assert.Equal(t, tt.expected, FromRequest(req))
+		})
+	}
+}
+
+func TestCatalog_AllKeysTranslated(t *testing.T) {
+	for key := range catalog[DefaultLang] {
+		for _, lang := range supported {
+			_, ok := catalog[lang][key]
+			assert.True(t, ok, "missing %s translation for %s", lang, key)
+		}
+	}
+}
+
+func TestT_FormatsArguments(t *testing.T) {
+	assert.Equal(t, "VisitDate is required", T(EN, ValidationRequired, "VisitDate"))
+	assert.Equal(t, "VisitDate 값은 필수입니다", T(KO, ValidationRequired, "VisitDate"))
+}
@@ -0,0 +1,521 @@
+package i18n
+
+// Key 메시지 카탈로그 키
+type Key string
+
+const (
+	RequestInvalidBody    Key = "request.invalid_body"
+	ValidationRequired    Key = "validation.required"
+	ValidationInvalid     Key = "validation.invalid"
+	ValidationInvalidID   Key = "validation.invalid_id"
+	RouteNotFound         Key = "route.not_found"
+	RouteMethodNotAllowed Key = "route.method_not_allowed"
+	InternalError         Key = "internal.error"
+	AdminDisabled         Key = "admin.disabled"
+	AdminUnauthorized     Key = "admin.unauthorized"
+
+	RestaurantNameRequired          Key = "restaurant.name_required"
+	RestaurantAddressRequired       Key = "restaurant.address_required"
+	RestaurantLocationRequired      Key = "restaurant.location_required"
+	RestaurantDuplicate             Key = "restaurant.duplicate"
+	RestaurantNotFound              Key = "restaurant.not_found"
+	RestaurantLookupFailed          Key = "restaurant.lookup_failed"
+	RestaurantCreateFailed          Key = "restaurant.create_failed"
+	RestaurantDeleteFailed          Key = "restaurant.delete_failed"
+	RestaurantDeleted               Key = "restaurant.deleted"
+	RestaurantInvalidRadius         Key = "restaurant.invalid_radius"
+	RestaurantExternalSourceInvalid Key = "restaurant.external_source_invalid"
+	RestaurantExternalIDPair        Key = "restaurant.external_id_pair"
+	RestaurantInvalidExternalIDs    Key = "restaurant.invalid_external_ids"
+	RestaurantMergeSelf             Key = "restaurant.merge_self"
+	RestaurantMergeNotFound         Key = "restaurant.merge_not_found"
+	RestaurantMergeFailed           Key = "restaurant.merge_failed"
+
+	VisitNotFound              Key = "visit.not_found"
+	VisitLookupFailed          Key = "visit.lookup_failed"
+	VisitListFailed            Key = "visit.list_failed"
+	VisitCreateFailed          Key = "visit.create_failed"
+	VisitUpdateFailed          Key = "visit.update_failed"
+	VisitDeleteFailed          Key = "visit.delete_failed"
+	VisitUpdated               Key = "visit.updated"
+	VisitDeleted               Key = "visit.deleted"
+	VisitDeletedRestaurantName Key = "visit.deleted_restaurant_name"
+	VisitNoAddress             Key = "visit.no_address"
+
+	TrashListFailed       Key = "trash.list_failed"
+	TrashNotFound         Key = "trash.not_found"
+	TrashRestoreConflict  Key = "trash.restore_conflict"
+	TrashRestoreFailed    Key = "trash.restore_failed"
+	TrashPurgeFailed      Key = "trash.purge_failed"
+	TrashInvalidRetention Key = "trash.invalid_retention"
+
+	AuditListFailed Key = "audit.list_failed"
+
+	DirectionsInvalidMode      Key = "directions.invalid_mode"
+	DirectionsInvalidLatitude  Key = "directions.invalid_latitude"
+	DirectionsInvalidLongitude Key = "directions.invalid_longitude"
+	DirectionsFailed           Key = "directions.failed"
+
+	PollInvalidDeadline    Key = "poll.invalid_deadline"
+	PollTooManyCandidates  Key = "poll.too_many_candidates"
+	PollNoCandidates       Key = "poll.no_candidates"
+	PollRestaurantNotFound Key = "poll.restaurant_not_found"
+	PollNotFound           Key = "poll.not_found"
+	PollClosed             Key = "poll.closed"
+	PollCandidateNotFound  Key = "poll.candidate_not_found"
+	PollVoterRequired      Key = "poll.voter_required"
+	PollInvalidStatus      Key = "poll.invalid_status"
+	PollInvalidDate        Key = "poll.invalid_date"
+	PollCreateFailed       Key = "poll.create_failed"
+	PollLookupFailed       Key = "poll.lookup_failed"
+	PollVoteFailed         Key = "poll.vote_failed"
+	PollCloseFailed        Key = "poll.close_failed"
+
+	EventsInvalidTeam Key = "events.invalid_team"
+
+	RoomInvalidName        Key = "room.invalid_name"
+	RoomMemberRequired     Key = "room.member_required"
+	RoomInvalidMessage     Key = "room.invalid_message"
+	RoomRestaurantNotFound Key = "room.restaurant_not_found"
+	RoomVoteFailed         Key = "room.vote_failed"
+	RoomSpinInProgress     Key = "room.spin_in_progress"
+	RoomNoRestaurants      Key = "room.no_restaurants"
+	RoomSpinFailed         Key = "room.spin_failed"
+
+	DrawTooManyCandidates  Key = "draw.too_many_candidates"
+	DrawNoCandidates       Key = "draw.no_candidates"
+	DrawRestaurantNotFound Key = "draw.restaurant_not_found"
+	DrawLimitReached       Key = "draw.limit_reached"
+	DrawNotFound           Key = "draw.not_found"
+	DrawInvalidDate        Key = "draw.invalid_date"
+	DrawCreateFailed       Key = "draw.create_failed"
+	DrawLookupFailed       Key = "draw.lookup_failed"
+
+	SlackDisabled           Key = "slack.disabled"
+	SlackInvalidSignature   Key = "slack.invalid_signature"
+	SlackInvalidPayload     Key = "slack.invalid_payload"
+	SlackHelp               Key = "slack.help"
+	SlackRecommendTitle     Key = "slack.recommend_title"
+	SlackVisitedButton      Key = "slack.visited_button"
+	SlackStartPollButton    Key = "slack.start_poll_button"
+	SlackPollDefaultTitle   Key = "slack.poll_default_title"
+	SlackPollDeadline       Key = "slack.poll_deadline"
+	SlackVoteButton         Key = "slack.vote_button"
+	SlackClosePollButton    Key = "slack.close_poll_button"
+	SlackVotes              Key = "slack.votes"
+	SlackPollWinner         Key = "slack.poll_winner"
+	SlackPollNoVotes        Key = "slack.poll_no_votes"
+	SlackVisitLogged        Key = "slack.visit_logged"
+	SlackChooseRestaurant   Key = "slack.choose_restaurant"
+	SlackRestaurantNotFound Key = "slack.restaurant_not_found"
+	SlackNoRestaurants      Key = "slack.no_restaurants"
+	SlackLogNameRequired    Key = "slack.log_name_required"
+	SlackInvalidMinutes     Key = "slack.invalid_minutes"
+	SlackFailed             Key = "slack.failed"
+
+	KakaoDisabled           Key = "kakao.disabled"
+	KakaoUnauthorized       Key = "kakao.unauthorized"
+	KakaoInvalidPayload     Key = "kakao.invalid_payload"
+	KakaoHelp               Key = "kakao.help"
+	KakaoRecommendIntro     Key = "kakao.recommend_intro"
+	KakaoRecommendReply     Key = "kakao.recommend_reply"
+	KakaoVisitsReply        Key = "kakao.visits_reply"
+	KakaoMapButton          Key = "kakao.map_button"
+	KakaoVisitedButton      Key = "kakao.visited_button"
+	KakaoLogVisitMessage    Key = "kakao.log_visit_message"
+	KakaoNoRestaurants      Key = "kakao.no_restaurants"
+	KakaoLocationRequired   Key = "kakao.location_required"
+	KakaoNearbyUnavailable  Key = "kakao.nearby_unavailable"
+	KakaoLocationNotFound   Key = "kakao.location_not_found"
+	KakaoNearbyIntro        Key = "kakao.nearby_intro"
+	KakaoNearbyEmpty        Key = "kakao.nearby_empty"
+	KakaoRecentVisits       Key = "kakao.recent_visits"
+	KakaoNoVisits           Key = "kakao.no_visits"
+	KakaoVisitLogged        Key = "kakao.visit_logged"
+	KakaoChooseRestaurant   Key = "kakao.choose_restaurant"
+	KakaoRestaurantNotFound Key = "kakao.restaurant_not_found"
+	KakaoFailed             Key = "kakao.failed"
+
+	WebhookNotFound         Key = "webhook.not_found"
+	WebhookDeliveryNotFound Key = "webhook.delivery_not_found"
+	WebhookDeliveryPending  Key = "webhook.delivery_pending"
+	WebhookInvalidEvent     Key = "webhook.invalid_event"
+	WebhookInvalidURL       Key = "webhook.invalid_url"
+	WebhookInvalidStatus    Key = "webhook.invalid_status"
+	WebhookLookupFailed     Key = "webhook.lookup_failed"
+	WebhookSaveFailed       Key = "webhook.save_failed"
+	WebhookDeleteFailed     Key = "webhook.delete_failed"
+	WebhookRedeliverFailed  Key = "webhook.redeliver_failed"
+
+	DigestNotFound     Key = "digest.not_found"
+	DigestUnsubscribed Key = "digest.unsubscribed"
+	DigestLookupFailed Key = "digest.lookup_failed"
+	DigestSaveFailed   Key = "digest.save_failed"
+	DigestDeleteFailed Key = "digest.delete_failed"
+	DigestRenderFailed Key = "digest.render_failed"
+	MailDisabled       Key = "mail.disabled"
+	MailSendFailed     Key = "mail.send_failed"
+
+	JobNotFound      Key = "job.not_found"
+	JobRunning       Key = "job.running"
+	JobLookupFailed  Key = "job.lookup_failed"
+	JobTriggerFailed Key = "job.trigger_failed"
+
+	RestaurantInvalidOpenAt Key = "restaurant.invalid_open_at"
+	HoursInvalidWeekday     Key = "hours.invalid_weekday"
+	HoursDuplicateWeekday   Key = "hours.duplicate_weekday"
+	HoursInvalidClock       Key = "hours.invalid_clock"
+	HoursEmptyRange         Key = "hours.empty_range"
+	HoursBreakPair          Key = "hours.break_pair"
+	HoursBreakOutside       Key = "hours.break_outside"
+	HoursInvalidDate        Key = "hours.invalid_date"
+	HoursHolidayNotFound    Key = "hours.holiday_not_found"
+	HoursLookupFailed       Key = "hours.lookup_failed"
+	HoursSaveFailed         Key = "hours.save_failed"
+	HoursDeleteFailed       Key = "hours.delete_failed"
+)
+
+var catalog = map[Lang]map[Key]string{
+	KO: {
+		RequestInvalidBody:    "요청 본문 형식이 올바르지 않습니다",
+		ValidationRequired:    "%s 값은 필수입니다",
+		ValidationInvalid:     "%s 값이 올바르지 않습니다",
+		ValidationInvalidID:   "ID는 양의 정수여야 합니다",
+		RouteNotFound:         "요청한 경로를 찾을 수 없습니다",
+		RouteMethodNotAllowed: "허용되지 않은 메서드입니다",
+		InternalError:         "서버 내부 오류가 발생했습니다",
+		AdminDisabled:         "관리자 API가 설정되지 않았습니다",
+		AdminUnauthorized:     "관리자 인증이 필요합니다",
+
+		RestaurantNameRequired:          "맛집 이름은 필수입니다",
+		RestaurantAddressRequired:       "맛집 주소는 필수입니다",
+		RestaurantLocationRequired:      "맛집 위치 정보는 필수입니다",
+		RestaurantDuplicate:             "이미 등록된 맛집입니다",
+		RestaurantNotFound:              "맛집을 찾을 수 없습니다",
+		RestaurantLookupFailed:          "맛집 조회에 실패했습니다",
+		RestaurantCreateFailed:          "맛집 등록에 실패했습니다",
+		RestaurantDeleteFailed:          "맛집 삭제에 실패했습니다",
+		RestaurantDeleted:               "맛집이 삭제되었습니다",
+		RestaurantInvalidRadius:         "검색 반경은 0보다 크고 %gm 이하여야 합니다",
+		RestaurantExternalSourceInvalid: "외부 장소 출처는 kakao 또는 naver여야 합니다",
+		RestaurantExternalIDPair:        "외부 장소 출처와 ID는 함께 입력해야 합니다",
+		RestaurantInvalidExternalIDs:    "조회할 외부 장소 ID는 1개 이상 %d개 이하여야 합니다",
+		RestaurantMergeSelf:             "남길 맛집은 병합 대상에 포함될 수 없습니다",
+		RestaurantMergeNotFound:         "맛집(ID %d)을 찾을 수 없습니다",
+		RestaurantMergeFailed:           "맛집 병합에 실패했습니다",
+
+		VisitNotFound:              "방문 기록을 찾을 수 없습니다",
+		VisitLookupFailed:          "방문 기록 조회에 실패했습니다",
+		VisitListFailed:            "방문 기록 조회에 실패했습니다",
+		VisitCreateFailed:          "방문 기록 저장에 실패했습니다",
+		VisitUpdateFailed:          "방문 기록 수정에 실패했습니다",
+		VisitDeleteFailed:          "방문 기록 삭제에 실패했습니다",
+		VisitUpdated:               "방문 기록이 수정되었습니다",
+		VisitDeleted:               "방문 기록이 삭제되었습니다",
+		VisitDeletedRestaurantName: "삭제된 맛집",
+		VisitNoAddress:             "주소 정보 없음",
+
+		TrashListFailed:       "휴지통 조회에 실패했습니다",
+		TrashNotFound:         "휴지통에서 맛집을 찾을 수 없습니다",
+		TrashRestoreConflict:  "같은 이름과 주소의 맛집(ID %d)이 이미 등록되어 있습니다",
+		TrashRestoreFailed:    "맛집 복원에 실패했습니다",
+		TrashPurgeFailed:      "휴지통 비우기에 실패했습니다",
+		TrashInvalidRetention: "보관 기간은 0 이상의 일 수여야 합니다",
+
+		AuditListFailed: "감사 로그 조회에 실패했습니다",
+
+		DirectionsInvalidMode:      "이동 수단은 walking 또는 driving이어야 합니다",
+		DirectionsInvalidLatitude:  "%s 값은 -90~90 사이의 위도여야 합니다",
+		DirectionsInvalidLongitude: "%s 값은 -180~180 사이의 경도여야 합니다",
+		DirectionsFailed:           "경로 계산에 실패했습니다",
+
+		PollInvalidDeadline:    "마감 시각은 현재 이후 24시간 이내여야 합니다",
+		PollTooManyCandidates:  "후보는 최대 %d개까지 등록할 수 있습니다",
+		PollNoCandidates:       "후보로 등록할 맛집이 없습니다",
+		PollRestaurantNotFound: "후보 맛집(ID %d)을 찾을 수 없습니다",
+		PollNotFound:           "투표를 찾을 수 없습니다",
+		PollClosed:             "이미 마감된 투표입니다",
+		PollCandidateNotFound:  "투표에 없는 후보입니다",
+		PollVoterRequired:      "투표자 이름(voter 또는 X-Actor 헤더)은 필수입니다",
+		PollInvalidStatus:      "status는 open 또는 closed여야 합니다",
+		PollInvalidDate:        "날짜는 YYYY-MM-DD 형식이어야 합니다",
+		PollCreateFailed:       "투표 생성에 실패했습니다",
+		PollLookupFailed:       "투표 조회에 실패했습니다",
+		PollVoteFailed:         "투표에 실패했습니다",
+		PollCloseFailed:        "투표 마감에 실패했습니다",
+
+		EventsInvalidTeam: "팀 이름은 1~64자여야 합니다",
+
+		RoomInvalidName:        "방 이름은 1~64자여야 합니다",
+		RoomMemberRequired:     "참가자 이름(name)은 1~64자여야 합니다",
+		RoomInvalidMessage:     "알 수 없는 메시지입니다 (vote, unvote, spin 중 하나)",
+		RoomRestaurantNotFound: "맛집을 찾을 수 없습니다 (ID: %d)",
+		RoomVoteFailed:         "투표 처리에 실패했습니다",
+		RoomSpinInProgress:     "룰렛이 이미 돌아가고 있습니다",
+		RoomNoRestaurants:      "룰렛을 돌릴 맛집이 없습니다",
+		RoomSpinFailed:         "룰렛 추첨에 실패했습니다",
+
+		DrawTooManyCandidates:  "후보는 최대 %d개까지 고를 수 있습니다",
+		DrawNoCandidates:       "추첨할 맛집이 없습니다",
+		DrawRestaurantNotFound: "후보 맛집(ID %d)을 찾을 수 없습니다",
+		DrawLimitReached:       "오늘 추첨 횟수를 모두 썼습니다 (하루 %d번까지)",
+		DrawNotFound:           "추첨 기록을 찾을 수 없습니다",
+		DrawInvalidDate:        "날짜는 YYYY-MM-DD 형식이어야 합니다",
+		DrawCreateFailed:       "추첨에 실패했습니다",
+		DrawLookupFailed:       "추첨 기록 조회에 실패했습니다",
+
+		SlackDisabled:           "SLACK_SIGNING_SECRET이 설정되지 않아 Slack 연동이 비활성화되어 있습니다",
+		SlackInvalidSignature:   "Slack 요청 서명이 올바르지 않거나 만료되었습니다",
+		SlackInvalidPayload:     "Slack 요청 본문을 해석할 수 없습니다",
+		SlackHelp:               "*점심 명령어*\n• `/lunch` 또는 `/lunch recommend` — 맛집 3곳 추천\n• `/lunch poll [마감까지 분, 기본 30] [제목]` — 추천 맛집으로 채널 투표 시작\n• `/lunch log 맛집이름` — 방문 기록 남기기",
+		SlackRecommendTitle:     "*오늘의 추천 맛집*",
+		SlackVisitedButton:      "여기 갔어요",
+		SlackStartPollButton:    "이 맛집들로 투표 시작",
+		SlackPollDefaultTitle:   "점심 투표",
+		SlackPollDeadline:       "%s 마감 · %s님이 시작",
+		SlackVoteButton:         "투표",
+		SlackClosePollButton:    "투표 마감",
+		SlackVotes:              "%d표",
+		SlackPollWinner:         "투표 마감 · 오늘 점심은 *%s* (투표자 %d명 방문 기록)",
+		SlackPollNoVotes:        "투표 마감 · 표가 없어 방문 기록을 남기지 않았습니다",
+		SlackVisitLogged:        "*%s* 방문을 기록했습니다",
+		SlackChooseRestaurant:   "'%s'(으)로 찾은 맛집이 여러 곳입니다. 방문한 곳을 고르세요",
+		SlackRestaurantNotFound: "'%s' 맛집을 찾을 수 없습니다",
+		SlackNoRestaurants:      "등록된 맛집이 없습니다",
+		SlackLogNameRequired:    "맛집 이름을 입력하세요. 예: `/lunch log 국밥집`",
+		SlackInvalidMinutes:     "마감까지 시간은 1~1440분이어야 합니다",
+		SlackFailed:             "요청을 처리하지 못했습니다. 잠시 후 다시 시도하세요",
+
+		KakaoDisabled:           "카카오톡 챗봇 연동이 설정되지 않았습니다",
+		KakaoUnauthorized:       "카카오톡 스킬 토큰이 올바르지 않습니다",
+		KakaoInvalidPayload:     "카카오톡 스킬 요청을 해석할 수 없습니다",
+		KakaoHelp:               "이렇게 물어보세요\n• 오늘 뭐 먹지 — 맛집 추천\n• 강남역 근처 맛집 — 근처에 등록된 맛집\n• 방문 기록 — 최근 방문 기록\n• 방문 기록 맛집이름 — 방문 기록 남기기",
+		KakaoRecommendIntro:     "오늘은 이런 곳 어때요?",
+		KakaoRecommendReply:     "오늘 뭐 먹지",
+		KakaoVisitsReply:        "방문 기록",
+		KakaoMapButton:          "지도 보기",
+		KakaoVisitedButton:      "여기 갔어요",
+		KakaoLogVisitMessage:    "방문 기록 %s",
+		KakaoNoRestaurants:      "등록된 맛집이 없습니다",
+		KakaoLocationRequired:   "어디 근처인지 알려 주세요. 예: 강남역 근처 맛집",
+		KakaoNearbyUnavailable:  "위치 검색을 사용할 수 없습니다",
+		KakaoLocationNotFound:   "'%s' 위치를 찾을 수 없습니다",
+		KakaoNearbyIntro:        "%s 근처 %dm 안의 맛집이에요",
+		KakaoNearbyEmpty:        "%s 근처 %dm 안에 등록된 맛집이 없습니다",
+		KakaoRecentVisits:       "최근 방문 기록",
+		KakaoNoVisits:           "아직 방문 기록이 없습니다",
+		KakaoVisitLogged:        "%s 방문을 기록했습니다",
+		KakaoChooseRestaurant:   "'%s'(으)로 찾은 맛집이 여러 곳이에요. 방문한 곳을 골라 주세요",
+		KakaoRestaurantNotFound: "'%s' 맛집을 찾을 수 없습니다",
+		KakaoFailed:             "요청을 처리하지 못했습니다. 잠시 후 다시 시도해 주세요",
+
+		WebhookNotFound:         "웹훅을 찾을 수 없습니다",
+		WebhookDeliveryNotFound: "웹훅 배달 기록을 찾을 수 없습니다",
+		WebhookDeliveryPending:  "아직 보내는 중인 배달은 다시 보낼 수 없습니다",
+		WebhookInvalidEvent:     "구독할 수 없는 이벤트입니다: %s",
+		WebhookInvalidURL:       "웹훅 URL은 http 또는 https 주소여야 합니다",
+		WebhookInvalidStatus:    "배달 상태는 pending, succeeded, failed 중 하나여야 합니다",
+		WebhookLookupFailed:     "웹훅 조회에 실패했습니다",
+		WebhookSaveFailed:       "웹훅 저장에 실패했습니다",
+		WebhookDeleteFailed:     "웹훅 삭제에 실패했습니다",
+		WebhookRedeliverFailed:  "웹훅 재전송에 실패했습니다",
+
+		DigestNotFound:     "다이제스트 구독을 찾을 수 없습니다",
+		DigestUnsubscribed: "점심 다이제스트 메일 구독을 해지했습니다",
+		DigestLookupFailed: "다이제스트 구독 조회에 실패했습니다",
+		DigestSaveFailed:   "다이제스트 구독 저장에 실패했습니다",
+		DigestDeleteFailed: "다이제스트 구독 삭제에 실패했습니다",
+		DigestRenderFailed: "다이제스트 메일을 만들지 못했습니다",
+		MailDisabled:       "메일 발송이 설정되지 않았습니다",
+		MailSendFailed:     "메일 발송에 실패했습니다",
+
+		JobNotFound:      "예약 작업을 찾을 수 없습니다",
+		JobRunning:       "예약 작업이 이미 실행 중입니다",
+		JobLookupFailed:  "예약 작업 조회에 실패했습니다",
+		JobTriggerFailed: "예약 작업 실행에 실패했습니다",
+
+		RestaurantInvalidOpenAt: "open_at은 now, RFC 3339 또는 YYYY-MM-DDTHH:MM(Asia/Seoul) 형식이어야 합니다",
+		HoursInvalidWeekday:     "요일은 0(일요일)부터 6(토요일)까지입니다",
+		HoursDuplicateWeekday:   "요일 %d의 영업시간이 두 번 입력되었습니다",
+		HoursInvalidClock:       "%s 값은 HH:MM 형식이어야 합니다 (마감은 24:00까지)",
+		HoursEmptyRange:         "영업 시작과 마감 시각이 같습니다 (24시간 영업은 00:00~24:00)",
+		HoursBreakPair:          "브레이크타임은 시작과 끝을 함께 입력해야 합니다",
+		HoursBreakOutside:       "브레이크타임은 영업시간 안에 있어야 합니다",
+		HoursInvalidDate:        "날짜는 YYYY-MM-DD 형식이어야 합니다",
+		HoursHolidayNotFound:    "해당 날짜의 영업시간 예외가 없습니다",
+		HoursLookupFailed:       "영업시간 조회에 실패했습니다",
+		HoursSaveFailed:         "영업시간 저장에 실패했습니다",
+		HoursDeleteFailed:       "영업시간 예외 삭제에 실패했습니다",
+	},
+	EN: {
+		RequestInvalidBody:    "Request body is malformed",
+		ValidationRequired:    "%s is required",
+		ValidationInvalid:     "%s is invalid",
+		ValidationInvalidID:   "ID must be a positive integer",
+		RouteNotFound:         "The requested path was not found",
+		RouteMethodNotAllowed: "Method not allowed",
+		InternalError:         "Internal server error",
+		AdminDisabled:         "Admin API is not configured",
+		AdminUnauthorized:     "Admin authentication required",
+
+		RestaurantNameRequired:          "Restaurant name is required",
+		RestaurantAddressRequired:       "Restaurant address is required",
+		RestaurantLocationRequired:      "Restaurant location is required",
+		RestaurantDuplicate:             "Restaurant is already registered",
+		RestaurantNotFound:              "Restaurant not found",
+		RestaurantLookupFailed:          "Failed to fetch restaurant",
+		RestaurantCreateFailed:          "Failed to create restaurant",
+		RestaurantDeleteFailed:          "Failed to delete restaurant",
+		RestaurantDeleted:               "Restaurant deleted successfully",
+		RestaurantInvalidRadius:         "Radius must be greater than 0 and at most %gm",
+		RestaurantExternalSourceInvalid: "External source must be kakao or naver",
+		RestaurantExternalIDPair:        "External source and ID must be provided together",
+		RestaurantInvalidExternalIDs:    "Provide between 1 and %d external place IDs",
+		RestaurantMergeSelf:             "The restaurant to keep cannot be one of the duplicates",
+		RestaurantMergeNotFound:         "Restaurant %d not found",
+		RestaurantMergeFailed:           "Failed to merge restaurants",
+
+		VisitNotFound:              "Visit record not found",
+		VisitLookupFailed:          "Failed to fetch visit record",
+		VisitListFailed:            "Failed to fetch visits",
+		VisitCreateFailed:          "Failed to record visit",
+		VisitUpdateFailed:          "Failed to update visit record",
+		VisitDeleteFailed:          "Failed to delete visit record",
+		VisitUpdated:               "Visit record updated successfully",
+		VisitDeleted:               "Visit record deleted successfully",
+		VisitDeletedRestaurantName: "Deleted restaurant",
+		VisitNoAddress:             "No address",
+
+		TrashListFailed:       "Failed to fetch trash",
+		TrashNotFound:         "Restaurant not found in trash",
+		TrashRestoreConflict:  "A restaurant with the same name and address already exists (ID %d)",
+		TrashRestoreFailed:    "Failed to restore restaurant",
+		TrashPurgeFailed:      "Failed to purge trash",
+		TrashInvalidRetention: "Retention must be a non-negative number of days",
+
+		AuditListFailed: "Failed to fetch audit logs",
+
+		DirectionsInvalidMode:      "Mode must be walking or driving",
+		DirectionsInvalidLatitude:  "%s must be a latitude between -90 and 90",
+		DirectionsInvalidLongitude: "%s must be a longitude between -180 and 180",
+		DirectionsFailed:           "Failed to calculate the route",
+
+		PollInvalidDeadline:    "Deadline must be in the future and within 24 hours",
+		PollTooManyCandidates:  "A poll can have at most %d candidates",
+		PollNoCandidates:       "There are no restaurants to add as candidates",
+		PollRestaurantNotFound: "Candidate restaurant (ID %d) not found",
+		PollNotFound:           "Poll not found",
+		PollClosed:             "The poll is already closed",
+		PollCandidateNotFound:  "Candidate is not part of this poll",
+		PollVoterRequired:      "Voter name (voter or X-Actor header) is required",
+		PollInvalidStatus:      "Status must be open or closed",
+		PollInvalidDate:        "Date must be in YYYY-MM-DD format",
+		PollCreateFailed:       "Failed to create poll",
+		PollLookupFailed:       "Failed to fetch poll",
+		PollVoteFailed:         "Failed to record vote",
+		PollCloseFailed:        "Failed to close poll",
+
+		EventsInvalidTeam: "Team must be 1 to 64 characters",
+
+		RoomInvalidName:        "Room name must be 1 to 64 characters",
+		RoomMemberRequired:     "Member name (name) must be 1 to 64 characters",
+		RoomInvalidMessage:     "Unknown message (expected vote, unvote or spin)",
+		RoomRestaurantNotFound: "Restaurant not found (ID: %d)",
+		RoomVoteFailed:         "Failed to process the vote",
+		RoomSpinInProgress:     "The roulette is already spinning",
+		RoomNoRestaurants:      "There are no restaurants to spin",
+		RoomSpinFailed:         "Failed to spin the roulette",
+
+		DrawTooManyCandidates:  "You can choose at most %d candidates",
+		DrawNoCandidates:       "There are no restaurants to draw from",
+		DrawRestaurantNotFound: "Candidate restaurant (ID %d) not found",
+		DrawLimitReached:       "Daily draw limit reached (%d draws per day)",
+		DrawNotFound:           "Draw not found",
+		DrawInvalidDate:        "Date must be in YYYY-MM-DD format",
+		DrawCreateFailed:       "Failed to draw",
+		DrawLookupFailed:       "Failed to fetch draws",
+
+		SlackDisabled:           "Slack integration is disabled because SLACK_SIGNING_SECRET is not set",
+		SlackInvalidSignature:   "Slack request signature is invalid or expired",
+		SlackInvalidPayload:     "Cannot parse the Slack request body",
+		SlackHelp:               "*Lunch commands*\n• `/lunch` or `/lunch recommend` — recommend 3 places\n• `/lunch poll [minutes until close, default 30] [title]` — start a channel poll with recommended places\n• `/lunch log restaurant name` — log a visit",
+		SlackRecommendTitle:     "*Today's picks*",
+		SlackVisitedButton:      "I went here",
+		SlackStartPollButton:    "Start a poll with these",
+		SlackPollDefaultTitle:   "Lunch poll",
+		SlackPollDeadline:       "Closes at %s · started by %s",
+		SlackVoteButton:         "Vote",
+		SlackClosePollButton:    "Close poll",
+		SlackVotes:              "%d votes",
+		SlackPollWinner:         "Poll closed · Lunch today is *%s* (visits logged for %d voters)",
+		SlackPollNoVotes:        "Poll closed · No votes, so no visits were logged",
+		SlackVisitLogged:        "Logged a visit to *%s*",
+		SlackChooseRestaurant:   "Several restaurants match '%s'. Choose the one you visited",
+		SlackRestaurantNotFound: "No restaurant matches '%s'",
+		SlackNoRestaurants:      "There are no restaurants yet",
+		SlackLogNameRequired:    "Enter a restaurant name, e.g. `/lunch log Gukbap`",
+		SlackInvalidMinutes:     "Minutes until close must be between 1 and 1440",
+		SlackFailed:             "Could not handle the request. Please try again later",
+
+		KakaoDisabled:           "KakaoTalk chatbot integration is not configured",
+		KakaoUnauthorized:       "Invalid KakaoTalk skill token",
+		KakaoInvalidPayload:     "Could not parse the KakaoTalk skill request",
+		KakaoHelp:               "Try asking\n• What to eat — restaurant picks\n• Gangnam station nearby — saved restaurants nearby\n• Visits — recent visits\n• Visit restaurant name — log a visit",
+		KakaoRecommendIntro:     "How about one of these today?",
+		KakaoRecommendReply:     "What to eat",
+		KakaoVisitsReply:        "Visits",
+		KakaoMapButton:          "Map",
+		KakaoVisitedButton:      "I went here",
+		KakaoLogVisitMessage:    "Visit %s",
+		KakaoNoRestaurants:      "No restaurants saved yet",
+		KakaoLocationRequired:   "Tell me where. e.g. Gangnam station nearby",
+		KakaoNearbyUnavailable:  "Location search is not available",
+		KakaoLocationNotFound:   "Could not find the location '%s'",
+		KakaoNearbyIntro:        "Saved restaurants within %[2]dm of %[1]s",
+		KakaoNearbyEmpty:        "No saved restaurants within %[2]dm of %[1]s",
+		KakaoRecentVisits:       "Recent visits",
+		KakaoNoVisits:           "No visits yet",
+		KakaoVisitLogged:        "Logged a visit to %s",
+		KakaoChooseRestaurant:   "Several restaurants match '%s'. Which one did you visit?",
+		KakaoRestaurantNotFound: "Could not find a restaurant named '%s'",
+		KakaoFailed:             "Something went wrong. Please try again later",
+
+		WebhookNotFound:         "Webhook not found",
+		WebhookDeliveryNotFound: "Webhook delivery not found",
+		WebhookDeliveryPending:  "The delivery is still pending and cannot be redelivered yet",
+		WebhookInvalidEvent:     "Unknown event type: %s",
+		WebhookInvalidURL:       "Webhook URL must be an http or https URL",
+		WebhookInvalidStatus:    "Status must be one of pending, succeeded, failed",
+		WebhookLookupFailed:     "Failed to load webhooks",
+		WebhookSaveFailed:       "Failed to save the webhook",
+		WebhookDeleteFailed:     "Failed to delete the webhook",
+		WebhookRedeliverFailed:  "Failed to redeliver the webhook",
+
+		DigestNotFound:     "Digest subscription not found",
+		DigestUnsubscribed: "You have been unsubscribed from the lunch digest",
+		DigestLookupFailed: "Failed to load digest subscriptions",
+		DigestSaveFailed:   "Failed to save the digest subscription",
+		DigestDeleteFailed: "Failed to delete the digest subscription",
+		DigestRenderFailed: "Failed to build the digest",
+		MailDisabled:       "Mail sending is not configured",
+		MailSendFailed:     "Failed to send the mail",
+
+		JobNotFound:      "Job not found",
+		JobRunning:       "The job is already running",
+		JobLookupFailed:  "Failed to load jobs",
+		JobTriggerFailed: "Failed to start the job",
+
+		RestaurantInvalidOpenAt: "open_at must be now, RFC 3339 or YYYY-MM-DDTHH:MM (Asia/Seoul)",
+		HoursInvalidWeekday:     "Weekday must be between 0 (Sunday) and 6 (Saturday)",
+		HoursDuplicateWeekday:   "Opening hours for weekday %d are given twice",
+		HoursInvalidClock:       "%s must be HH:MM (closing up to 24:00)",
+		HoursEmptyRange:         "Opening and closing times are the same (use 00:00-24:00 for 24 hours)",
+		HoursBreakPair:          "Break start and end must be given together",
+		HoursBreakOutside:       "Break time must be within opening hours",
+		HoursInvalidDate:        "Date must be YYYY-MM-DD",
+		HoursHolidayNotFound:    "No opening hours override for that date",
+		HoursLookupFailed:       "Failed to look up opening hours",
+		HoursSaveFailed:         "Failed to save opening hours",
+		HoursDeleteFailed:       "Failed to delete the opening hours override",
+	},
+}
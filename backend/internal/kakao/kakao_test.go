@@ -0,0 +1,126 @@
+package kakao
+
+import (
+	"encoding/json"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/models"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommand(t *testing.T) {
+	data, err := os.ReadFile("testdata/skill_nearby.json")
+	require.NoError(t, err)
+	var recorded SkillRequest
+	require.NoError(t, json.Unmarshal(data, &recorded))
+	assert.Equal(t, "ko", recorded.UserRequest.Lang)
+	assert.Equal(t, Command{Type: CommandNearby, Argument: "강남역"}, ParseCommand(recorded), "블록 파라미터 우선")
+
+	utterance := func(block, text string) SkillRequest {
+		return SkillRequest{Intent: Intent{Name: block}, UserRequest: UserRequest{Utterance: text}}
+	}
+	tests := []struct {
+		name string
+		req  SkillRequest
+		want Command
+	}{
+		{"추천", utterance("", "오늘 뭐 먹지?"), Command{Type: CommandRecommend}},
+		{"붙여 쓴 추천", utterance("", "뭐먹지"), Command{Type: CommandRecommend}},
+		{"블록 이름 우선", utterance("오늘 뭐 먹지", "배고파"), Command{Type: CommandRecommend}},
+		{"발화의 위치", utterance("", "역삼역 근처 맛집"), Command{Type: CommandNearby, Argument: "역삼역"}},
+		{"위치 없음", utterance("근처 맛집", "근처 맛집"), Command{Type: CommandNearby}},
+		{"방문 기록 조회", utterance("", "방문 기록"), Command{Type: CommandVisits}},
+		{"방문 기록 남기기", utterance("", "방문 기록 국밥 집"), Command{Type: CommandVisits, Argument: "국밥 집"}},
+		{"붙여 쓴 방문 기록", utterance("", "방문기록 #12"), Command{Type: CommandVisits, Argument: "#12"}},
+		{"영어", utterance("", "Visit Burger House"), Command{Type: CommandVisits, Argument: "Burger House"}},
+		{"알 수 없음", utterance("폴백 블록", "안녕"), Command{Type: CommandHelp}},
+		{"빈 발화", utterance("", ""), Command{Type: CommandHelp}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseCommand(tt.req))
+		})
+	}
+
+	id, ok := RestaurantRef("#12")
+	assert.True(t, ok)
+	assert.Equal(t, uint(12), id)
+	for _, argument := range []string{"12", "#", "#0", "#abc"} {
+		_, ok := RestaurantRef(argument)
+		assert.False(t, ok, argument)
+	}
+}
+
+func TestRecommendResponse(t *testing.T) {
+	restaurants := []models.Restaurant{
+		{Name: "국밥집", Address: "서울시 1", Category: "한식", Latitude: 37.4979, Longitude: 127.0276},
+		{Name: "초밥, 스시", Address: "서울시 2"},
+	}
+	data, err := json.Marshal(RecommendResponse(i18n.KO, restaurants, "https://example.com/logo.png"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"version": "2.0",
+		"template": {
+			"outputs": [
+				{"simpleText": {"text": "오늘은 이런 곳 어때요?"}},
+				{"carousel": {"type": "basicCard", "items": [
+					{
+						"title": "국밥집",
+						"description": "한식\n서울시 1",
+						"thumbnail": {"imageUrl": "https://example.com/logo.png"},
+						"buttons": [
+							{"action": "webLink", "label": "지도 보기", "webLinkUrl": "https://map.kakao.com/link/map/%EA%B5%AD%EB%B0%A5%EC%A7%91,37.4979,127.0276"},
+							{"action": "message", "label": "여기 갔어요", "messageText": "방문 기록 국밥집"}
+						]
+					},
+					{
+						"title": "초밥, 스시",
+						"description": "서울시 2",
+						"thumbnail": {"imageUrl": "https://example.com/logo.png"},
+						"buttons": [
+							{"action": "webLink", "label": "지도 보기", "webLinkUrl": "https://map.kakao.com/link/search/%EC%B4%88%EB%B0%A5%2C%20%EC%8A%A4%EC%8B%9C"},
+							{"action": "message", "label": "여기 갔어요", "messageText": "방문 기록 초밥, 스시"}
+						]
+					}
+				]}}
+			],
+			"quickReplies": [
+				{"action": "message", "label": "오늘 뭐 먹지", "messageText": "오늘 뭐 먹지"},
+				{"action": "message", "label": "방문 기록", "messageText": "방문 기록"}
+			]
+		}
+	}`, string(data))
+}
+
+func TestResponseLimits(t *testing.T) {
+	single := CarouselOf(BasicCard{Title: "하나"})
+	assert.Nil(t, single.Carousel)
+	assert.Equal(t, "하나", single.BasicCard.Title, "카드가 한 장이면 basicCard")
+
+	cards := make([]BasicCard, 12)
+	for i := range cards {
+		cards[i] = BasicCard{Description: strings.Repeat("가", 300), Buttons: make([]Button, 4)}
+	}
+	carousel := CarouselOf(cards...).Carousel
+	require.Len(t, carousel.Items, MaxCarouselItems)
+	assert.Len(t, []rune(carousel.Items[0].Description), MaxDescription)
+	assert.Len(t, carousel.Items[0].Buttons, MaxButtons)
+
+	assert.Len(t, []rune(Text(strings.Repeat("a", 1200)).SimpleText.Text), MaxSimpleText)
+	assert.Len(t, NewResponse(Text("1"), Text("2"), Text("3"), Text("4")).Template.Outputs, MaxOutputs)
+}
+
+func TestVisitsResponse(t *testing.T) {
+	visits := []models.Visit{
+		{RestaurantSnapshot: models.RestaurantSnapshot{Name: "국밥집"}, VisitDate: time.Date(2024, 7, 4, 16, 0, 0, 0, time.UTC), Visitor: "민수"},
+		{RestaurantSnapshot: models.RestaurantSnapshot{Name: "초밥집"}, VisitDate: time.Date(2024, 7, 3, 3, 0, 0, 0, time.UTC)},
+	}
+	response := VisitsResponse(i18n.KO, visits)
+	assert.Equal(t, "최근 방문 기록\n• 2024-07-05 국밥집 (민수)\n• 2024-07-03 초밥집", response.Template.Outputs[0].SimpleText.Text)
+	assert.Equal(t, "No visits yet", VisitsResponse(i18n.EN, nil).Template.Outputs[0].SimpleText.Text)
+}
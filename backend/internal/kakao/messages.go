@@ -0,0 +1,117 @@
+package kakao
+
+import (
+	"fmt"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RecommendResponse 추천 맛집 캐러셀
+func RecommendResponse(lang i18n.Lang, restaurants []models.Restaurant, thumbnailURL string) Response {
+	cards := make([]BasicCard, 0, len(restaurants))
+	for _, r := range restaurants {
+		cards = append(cards, RestaurantCard(lang, r, thumbnailURL, ""))
+	}
+	return NewResponse(Text(i18n.T(lang, i18n.KakaoRecommendIntro)), CarouselOf(cards...)).
+		WithQuickReplies(quickReplies(lang)...)
+}
+
+// NearbyRestaurant 근처 맛집과 기준 위치에서의 거리
+type NearbyRestaurant struct {
+	Restaurant     models.Restaurant
+	DistanceMeters float64
+}
+
+// NearbyResponse 위치 근처 맛집 캐러셀 (가까운 순)
+func NearbyResponse(lang i18n.Lang, location string, radiusMeters int, restaurants []NearbyRestaurant, thumbnailURL string) Response {
+	if len(restaurants) == 0 {
+		return NewResponse(Text(i18n.T(lang, i18n.KakaoNearbyEmpty, location, radiusMeters))).
+			WithQuickReplies(quickReplies(lang)...)
+	}
+	cards := make([]BasicCard, 0, len(restaurants))
+	for _, nearby := range restaurants {
+		cards = append(cards, RestaurantCard(lang, nearby.Restaurant, thumbnailURL, fmt.Sprintf("%.0fm", nearby.DistanceMeters)))
+	}
+	return NewResponse(Text(i18n.T(lang, i18n.KakaoNearbyIntro, location, radiusMeters)), CarouselOf(cards...)).
+		WithQuickReplies(quickReplies(lang)...)
+}
+
+// VisitsResponse 최근 방문 기록 목록 (방문 일자는 Asia/Seoul)
+func VisitsResponse(lang i18n.Lang, visits []models.Visit) Response {
+	if len(visits) == 0 {
+		return NewResponse(Text(i18n.T(lang, i18n.KakaoNoVisits))).WithQuickReplies(quickReplies(lang)...)
+	}
+	lines := []string{i18n.T(lang, i18n.KakaoRecentVisits)}
+	for _, visit := range visits {
+		line := "• " + visit.VisitDate.In(kst.Location()).Format("2006-01-02") + " " + visit.RestaurantSnapshot.Name
+		if visit.Visitor != "" {
+			line += " (" + visit.Visitor + ")"
+		}
+		lines = append(lines, line)
+	}
+	return NewResponse(Text(strings.Join(lines, "\n"))).WithQuickReplies(quickReplies(lang)...)
+}
+
+// ChooseRestaurantResponse 이름으로 찾은 맛집이 여러 곳일 때 방문한 곳을 고르는 캐러셀
+// 버튼은 이름 대신 "#ID"를 보내서 이름이 같은 맛집도 구분
+func ChooseRestaurantResponse(lang i18n.Lang, query string, restaurants []models.Restaurant, thumbnailURL string) Response {
+	cards := make([]BasicCard, 0, len(restaurants))
+	for _, r := range restaurants {
+		card := RestaurantCard(lang, r, thumbnailURL, "")
+		card.Buttons[1].MessageText = i18n.T(lang, i18n.KakaoLogVisitMessage, "#"+strconv.FormatUint(uint64(r.ID), 10))
+		cards = append(cards, card)
+	}
+	return NewResponse(Text(i18n.T(lang, i18n.KakaoChooseRestaurant, query)), CarouselOf(cards...))
+}
+
+// HelpResponse 사용법 안내
+func HelpResponse(lang i18n.Lang) Response {
+	return TextResponse(lang, i18n.T(lang, i18n.KakaoHelp))
+}
+
+// TextResponse 바로가기 응답이 붙은 텍스트 응답
+func TextResponse(lang i18n.Lang, text string) Response {
+	return NewResponse(Text(text)).WithQuickReplies(quickReplies(lang)...)
+}
+
+// RestaurantCard 맛집 카드 (지도 보기, 여기 갔어요 버튼)
+// detail은 카테고리 옆에 붙일 정보 (예: 거리)
+func RestaurantCard(lang i18n.Lang, r models.Restaurant, thumbnailURL, detail string) BasicCard {
+	var summary []string
+	for _, value := range []string{r.Category, detail} {
+		if value != "" {
+			summary = append(summary, value)
+		}
+	}
+	description := r.Address
+	if len(summary) > 0 {
+		description = strings.Join(summary, " · ") + "\n" + description
+	}
+	return BasicCard{
+		Title:       r.Name,
+		Description: description,
+		Thumbnail:   Thumbnail{ImageURL: thumbnailURL},
+		Buttons: []Button{
+			LinkButton(i18n.T(lang, i18n.KakaoMapButton), MapURL(r)),
+			MessageButton(i18n.T(lang, i18n.KakaoVisitedButton), i18n.T(lang, i18n.KakaoLogVisitMessage, r.Name)),
+		},
+	}
+}
+
+// MapURL 카카오맵 링크 (좌표가 없으면 이름 검색)
+func MapURL(r models.Restaurant) string {
+	if r.Latitude == 0 && r.Longitude == 0 {
+		return "https://map.kakao.com/link/search/" + url.PathEscape(r.Name)
+	}
+	return fmt.Sprintf("https://map.kakao.com/link/map/%s,%s,%s", url.PathEscape(strings.ReplaceAll(r.Name, ",", " ")),
+		strconv.FormatFloat(r.Latitude, 'f', -1, 64), strconv.FormatFloat(r.Longitude, 'f', -1, 64))
+}
+
+// quickReplies 모든 응답 아래의 추천·방문 기록 바로가기
+func quickReplies(lang i18n.Lang) []QuickReply {
+	return []QuickReply{Reply(i18n.T(lang, i18n.KakaoRecommendReply)), Reply(i18n.T(lang, i18n.KakaoVisitsReply))}
+}
@@ -0,0 +1,162 @@
+// Package kakao 카카오 i 오픈빌더 스킬 서버 (카카오톡 챗봇)
+//
+// 오픈빌더가 보내는 스킬 요청 JSON을 해석해 의도를 고르고, 응답은 스킬 응답 2.0 형식
+// (simpleText, basicCard, carousel 템플릿)으로 만든다.
+// https://kakaobusiness.gitbook.io/main/tool/chatbot/skill_guide/answer_json_format
+package kakao
+
+import (
+	"strconv"
+	"strings"
+)
+
+// TokenHeader 오픈빌더 스킬 설정에 추가하는 인증 헤더 (KAKAO_SKILL_TOKEN 값)
+const TokenHeader = "X-Kakao-Skill-Token"
+
+// SkillRequest 오픈빌더 스킬 요청
+type SkillRequest struct {
+	Intent      Intent      `json:"intent"`
+	UserRequest UserRequest `json:"userRequest"`
+	Action      Action      `json:"action"`
+}
+
+// Intent 요청을 보낸 블록 (오픈빌더에서 정한 블록 이름)
+type Intent struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserRequest 사용자 발화와 사용자 정보
+type UserRequest struct {
+	Timezone  string `json:"timezone"`
+	Utterance string `json:"utterance"`
+	Lang      string `json:"lang"`
+	User      User   `json:"user"`
+}
+
+// User 사용자 (ID는 봇마다 다른 botUserKey)
+type User struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// Action 블록에서 추출한 파라미터 (엔티티 이름 → 값)
+type Action struct {
+	ID     string            `json:"id"`
+	Name   string            `json:"name"`
+	Params map[string]string `json:"params"`
+}
+
+// 블록 파라미터 이름
+const (
+	// ParamLocation 근처 맛집을 찾을 위치 (sys.location 엔티티 등)
+	ParamLocation = "location"
+	// ParamRestaurant 방문을 기록할 맛집 이름
+	ParamRestaurant = "restaurant"
+)
+
+// CommandType 스킬 요청의 의도
+type CommandType string
+
+const (
+	// CommandRecommend "오늘 뭐 먹지" - 맛집 추천
+	CommandRecommend CommandType = "recommend"
+	// CommandNearby "강남역 근처 맛집" - 위치 근처의 등록 맛집
+	CommandNearby CommandType = "nearby"
+	// CommandVisits "방문 기록" - 최근 방문 기록, "방문 기록 국밥집" - 방문 기록 남기기
+	CommandVisits CommandType = "visits"
+	// CommandHelp 알 수 없는 요청 - 사용법 안내
+	CommandHelp CommandType = "help"
+)
+
+// Command 스킬 요청에서 고른 의도와 인자 (위치 또는 맛집 이름)
+type Command struct {
+	Type     CommandType
+	Argument string
+}
+
+// intentKeywords 의도별 키워드 (공백을 뺀 소문자 발화에 포함되면 일치, 앞에 있는 의도가 우선)
+var intentKeywords = []struct {
+	command  CommandType
+	keywords []string
+}{
+	{CommandNearby, []string{"근처", "주변", "nearby", "near"}},
+	{CommandVisits, []string{"방문", "visit"}},
+	{CommandRecommend, []string{"뭐먹", "추천", "메뉴", "recommend", "whattoeat", "lunch"}},
+}
+
+// ParseCommand 블록 이름 또는 발화로 의도를 고르고 인자를 추출
+// 블록 이름이 키워드와 맞으면 블록 이름을 우선하고, 인자는 블록 파라미터 → 발화 순서로 찾음
+func ParseCommand(req SkillRequest) Command {
+	utterance := strings.TrimSpace(req.UserRequest.Utterance)
+	command := match(req.Intent.Name)
+	if command == CommandHelp {
+		command = match(utterance)
+	}
+
+	switch command {
+	case CommandNearby:
+		location := req.Action.Params[ParamLocation]
+		if location == "" {
+			location = textBefore(utterance, "근처", "주변", "nearby", "near")
+		}
+		return Command{Type: command, Argument: strings.TrimSpace(location)}
+	case CommandVisits:
+		restaurant := req.Action.Params[ParamRestaurant]
+		if restaurant == "" {
+			restaurant = textAfter(utterance, "방문 기록", "방문기록", "방문", "visits", "visit")
+		}
+		return Command{Type: command, Argument: strings.TrimSpace(restaurant)}
+	}
+	return Command{Type: command}
+}
+
+func match(text string) CommandType {
+	normalized := strings.ToLower(strings.ReplaceAll(text, " ", ""))
+	if normalized == "" {
+		return CommandHelp
+	}
+	for _, intent := range intentKeywords {
+		for _, keyword := range intent.keywords {
+			if strings.Contains(normalized, keyword) {
+				return intent.command
+			}
+		}
+	}
+	return CommandHelp
+}
+
+// textBefore 처음 나오는 표시어 앞의 텍스트 ("강남역 근처 맛집" → "강남역")
+func textBefore(text string, markers ...string) string {
+	lower := strings.ToLower(text)
+	for _, marker := range markers {
+		if i := strings.Index(lower, marker); i >= 0 {
+			return text[:i]
+		}
+	}
+	return ""
+}
+
+// textAfter 처음 나오는 표시어 뒤의 텍스트 ("방문 기록 국밥집" → "국밥집")
+func textAfter(text string, markers ...string) string {
+	lower := strings.ToLower(text)
+	for _, marker := range markers {
+		if i := strings.Index(lower, marker); i >= 0 {
+			return text[i+len(marker):]
+		}
+	}
+	return ""
+}
+
+// RestaurantRef "#12" 형태의 맛집 ID 인자 (여러 맛집 중 고르는 버튼이 보내는 값)
+func RestaurantRef(argument string) (uint, bool) {
+	digits, ok := strings.CutPrefix(argument, "#")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || id == 0 {
+		return 0, false
+	}
+	return uint(id), true
+}
@@ -0,0 +1,151 @@
+package kakao
+
+// 스킬 응답 제한 (오픈빌더가 넘으면 응답 전체를 거부)
+const (
+	// Version 스킬 응답 형식 버전
+	Version = "2.0"
+	// MaxOutputs 한 응답의 출력 수
+	MaxOutputs = 3
+	// MaxCarouselItems 캐러셀 카드 수
+	MaxCarouselItems = 10
+	// MaxButtons 카드 한 장의 버튼 수
+	MaxButtons = 3
+	// MaxQuickReplies 바로가기 응답 수
+	MaxQuickReplies = 10
+	// MaxSimpleText simpleText 글자 수
+	MaxSimpleText = 1000
+	// MaxDescription 캐러셀 안 basicCard 설명 글자 수
+	MaxDescription = 230
+)
+
+// 버튼 동작
+const (
+	ActionWebLink = "webLink"
+	ActionMessage = "message"
+)
+
+// Response 스킬 응답
+type Response struct {
+	Version  string   `json:"version"`
+	Template Template `json:"template"`
+}
+
+// Template 출력과 바로가기 응답
+type Template struct {
+	Outputs      []Output     `json:"outputs"`
+	QuickReplies []QuickReply `json:"quickReplies,omitempty"`
+}
+
+// Output 출력 하나 (셋 중 하나만 설정)
+type Output struct {
+	SimpleText *SimpleText `json:"simpleText,omitempty"`
+	BasicCard  *BasicCard  `json:"basicCard,omitempty"`
+	Carousel   *Carousel   `json:"carousel,omitempty"`
+}
+
+// SimpleText 텍스트 말풍선
+type SimpleText struct {
+	Text string `json:"text"`
+}
+
+// BasicCard 썸네일, 제목, 설명, 버튼이 있는 카드 (썸네일 필수)
+type BasicCard struct {
+	Title       string    `json:"title,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Thumbnail   Thumbnail `json:"thumbnail"`
+	Buttons     []Button  `json:"buttons,omitempty"`
+}
+
+// Thumbnail 카드 이미지
+type Thumbnail struct {
+	ImageURL string `json:"imageUrl"`
+}
+
+// Button 카드 버튼 (webLink는 링크 열기, message는 MessageText를 사용자 발화로 전송)
+type Button struct {
+	Action      string `json:"action"`
+	Label       string `json:"label"`
+	WebLinkURL  string `json:"webLinkUrl,omitempty"`
+	MessageText string `json:"messageText,omitempty"`
+}
+
+// Carousel 가로로 넘기는 basicCard 목록
+type Carousel struct {
+	Type  string      `json:"type"`
+	Items []BasicCard `json:"items"`
+}
+
+// QuickReply 말풍선 아래 바로가기 버튼 (MessageText를 사용자 발화로 전송)
+type QuickReply struct {
+	Action      string `json:"action"`
+	Label       string `json:"label"`
+	MessageText string `json:"messageText"`
+}
+
+// NewResponse 출력들로 스킬 응답 생성 (MaxOutputs를 넘는 출력은 버림)
+func NewResponse(outputs ...Output) Response {
+	if len(outputs) > MaxOutputs {
+		outputs = outputs[:MaxOutputs]
+	}
+	return Response{Version: Version, Template: Template{Outputs: outputs}}
+}
+
+// WithQuickReplies 바로가기 응답 추가 (MaxQuickReplies까지)
+func (r Response) WithQuickReplies(replies ...QuickReply) Response {
+	replies = append(r.Template.QuickReplies, replies...)
+	if len(replies) > MaxQuickReplies {
+		replies = replies[:MaxQuickReplies]
+	}
+	r.Template.QuickReplies = replies
+	return r
+}
+
+// Text simpleText 출력 (MaxSimpleText를 넘으면 자름)
+func Text(text string) Output {
+	return Output{SimpleText: &SimpleText{Text: truncate(text, MaxSimpleText)}}
+}
+
+// Card basicCard 출력
+func Card(card BasicCard) Output {
+	return Output{BasicCard: &card}
+}
+
+// CarouselOf basicCard 캐러셀 출력 (MaxCarouselItems까지, 카드가 한 장이면 basicCard)
+func CarouselOf(cards ...BasicCard) Output {
+	if len(cards) == 1 {
+		return Card(cards[0])
+	}
+	if len(cards) > MaxCarouselItems {
+		cards = cards[:MaxCarouselItems]
+	}
+	for i := range cards {
+		cards[i].Description = truncate(cards[i].Description, MaxDescription)
+		if len(cards[i].Buttons) > MaxButtons {
+			cards[i].Buttons = cards[i].Buttons[:MaxButtons]
+		}
+	}
+	return Output{Carousel: &Carousel{Type: "basicCard", Items: cards}}
+}
+
+// LinkButton 링크를 여는 버튼
+func LinkButton(label, url string) Button {
+	return Button{Action: ActionWebLink, Label: label, WebLinkURL: url}
+}
+
+// MessageButton 누르면 messageText를 보내는 버튼
+func MessageButton(label, messageText string) Button {
+	return Button{Action: ActionMessage, Label: label, MessageText: messageText}
+}
+
+// Reply 누르면 label을 그대로 보내는 바로가기 응답
+func Reply(label string) QuickReply {
+	return QuickReply{Action: ActionMessage, Label: label, MessageText: label}
+}
+
+func truncate(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit-1]) + "…"
+}
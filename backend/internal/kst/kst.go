@@ -0,0 +1,33 @@
+// Package kst 점심 날짜와 영업시간을 계산하는 기준 시간대 (Asia/Seoul)
+package kst
+
+import "time"
+
+// DateLayout 날짜 형식 (YYYY-MM-DD)
+const DateLayout = "2006-01-02"
+
+// location 시간대 데이터베이스가 없는 환경에서는 UTC+9 고정 시간대 사용
+var location = loadLocation()
+
+func loadLocation() *time.Location {
+	if loc, err := time.LoadLocation("Asia/Seoul"); err == nil {
+		return loc
+	}
+	return time.FixedZone("KST", 9*60*60)
+}
+
+// Location Asia/Seoul 시간대
+func Location() *time.Location {
+	return location
+}
+
+// Date t가 속한 Asia/Seoul 날짜의 자정
+func Date(t time.Time) time.Time {
+	local := t.In(location)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+}
+
+// ParseDate "YYYY-MM-DD"를 Asia/Seoul 자정으로 해석
+func ParseDate(value string) (time.Time, error) {
+	return time.ParseInLocation(DateLayout, value, location)
+}
@@ -0,0 +1,22 @@
+package kst
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDate(t *testing.T) {
+	// UTC 15:00은 Asia/Seoul 다음 날 자정
+	date := Date(time.Date(2024, 7, 1, 15, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2024-07-02T00:00:00+09:00", date.Format(time.RFC3339))
+	assert.Equal(t, "2024-07-01", Date(time.Date(2024, 7, 1, 14, 59, 0, 0, time.UTC)).Format(DateLayout))
+
+	parsed, err := ParseDate("2024-07-02")
+	require.NoError(t, err)
+	assert.True(t, parsed.Equal(date))
+	_, err = ParseDate("2024/07/02")
+	assert.Error(t, err)
+}
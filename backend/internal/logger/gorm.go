@@ -0,0 +1,96 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// slowQueryThreshold 이 시간을 넘는 쿼리는 warn 레벨로 기록
+const slowQueryThreshold = 200 * time.Millisecond
+
+// GormLogger GORM 쿼리 로그를 slog로 출력하는 로거
+type GormLogger struct {
+	level gormlogger.LogLevel
+}
+
+// NewGormLogger DB_LOG_LEVEL 환경변수(silent/error/warn/info)에 따른 GORM 로거 생성, 기본값 warn
+func NewGormLogger() *GormLogger {
+	return &GormLogger{level: parseGormLevel(os.Getenv("DB_LOG_LEVEL"))}
+}
+
+func parseGormLevel(value string) gormlogger.LogLevel {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "silent":
+		return gormlogger.Silent
+	case "error":
+		return gormlogger.Error
+	case "info":
+		return gormlogger.Info
+	default:
+		return gormlogger.Warn
+	}
+}
+
+// LogMode gormlogger.Interface 구현
+func (l *GormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	return &GormLogger{level: level}
+}
+
+// Info gormlogger.Interface 구현
+func (l *GormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Info {
+		FromContext(ctx).InfoContext(ctx, fmt.Sprintf(msg, data...), "component", "gorm")
+	}
+}
+
+// Warn gormlogger.Interface 구현
+func (l *GormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Warn {
+		FromContext(ctx).WarnContext(ctx, fmt.Sprintf(msg, data...), "component", "gorm")
+	}
+}
+
+// Error gormlogger.Interface 구현
+func (l *GormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Error {
+		FromContext(ctx).ErrorContext(ctx, fmt.Sprintf(msg, data...), "component", "gorm")
+	}
+}
+
+// Trace 쿼리 실행 결과를 레벨에 맞춰 기록
+// 에러는 error, 느린 쿼리는 warn, 나머지는 info 레벨일 때만 기록
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	attrs := func() []any {
+		sql, rows := fc()
+		return []any{
+			"component", "gorm",
+			"sql", sql,
+			"rows", rows,
+			"duration_ms", float64(elapsed.Microseconds()) / 1000,
+		}
+	}
+	log := FromContext(ctx)
+
+	switch {
+	case err != nil && l.level >= gormlogger.Error && !errors.Is(err, gorm.ErrRecordNotFound):
+		log.ErrorContext(ctx, "query failed", append(attrs(), "error", err)...)
+	case elapsed > slowQueryThreshold && l.level >= gormlogger.Warn:
+		log.WarnContext(ctx, "slow query", attrs()...)
+	case l.level >= gormlogger.Info:
+		log.InfoContext(ctx, "query", attrs()...)
+	}
+}
+
+var _ gormlogger.Interface = (*GormLogger)(nil)
@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+type contextKey struct{}
+
+var requestIDKey = contextKey{}
+
+// Init JSON 구조화 로거를 기본 로거로 설정
+// 로그 레벨은 LOG_LEVEL 환경변수(debug/info/warn/error)로 지정, 기본값 info
+func Init() {
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: ParseLevel(os.Getenv("LOG_LEVEL"), slog.LevelInfo),
+	})
+	slog.SetDefault(slog.New(handler))
+}
+
+// ParseLevel 문자열 로그 레벨을 slog.Level로 변환 (알 수 없는 값은 fallback)
+func ParseLevel(value string, fallback slog.Level) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "debug":
+		return slog.LevelDebug
+	case "info":
+		return slog.LevelInfo
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return fallback
+	}
+}
+
+// WithRequestID 컨텍스트에 요청 ID 저장
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestID 컨텍스트에 저장된 요청 ID 조회 (없으면 빈 문자열)
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+// FromContext 요청 ID가 포함된 로거 반환
+func FromContext(ctx context.Context) *slog.Logger {
+	if requestID := RequestID(ctx); requestID != "" {
+		return slog.Default().With("request_id", requestID)
+	}
+	return slog.Default()
+}
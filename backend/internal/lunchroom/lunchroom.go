@@ -0,0 +1,462 @@
+// Package lunchroom 여러 사람이 함께 접속해 실시간으로 투표하고 룰렛을 돌리는 점심 방
+//
+// 방마다 고루틴 하나가 접속자·투표 상태를 소유하고, 다른 고루틴은 채널로만 상태를 바꿉니다.
+// 접속(WebSocket) 처리는 handlers에서 하고 이 패키지는 전송 방식과 무관한 Client 채널만 다룹니다.
+package lunchroom
+
+import (
+	"context"
+	"errors"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/draw"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/models"
+	"sort"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 클라이언트 → 서버 메시지 종류
+const (
+	MessageVote   = "vote"
+	MessageUnvote = "unvote"
+	MessageSpin   = "spin"
+)
+
+// 서버 → 클라이언트 메시지 종류
+const (
+	MessageState = "state"
+	MessageError = "error"
+)
+
+const (
+	// DefaultSpinDuration 룰렛 애니메이션 시간 (결과 공개 시각 = 돌린 시각 + 이 시간)
+	DefaultSpinDuration = 3 * time.Second
+	// clientBuffer 클라이언트별 대기 메시지 수 (넘치면 연결을 끊음)
+	clientBuffer = 32
+	// pickTimeout 룰렛 후보 조회 제한 시간
+	pickTimeout = 5 * time.Second
+)
+
+// ErrNoRestaurants 룰렛을 돌릴 맛집이 없음
+var ErrNoRestaurants = errors.New("lunchroom: no restaurants to spin")
+
+// ClientMessage 클라이언트가 보내는 메시지
+type ClientMessage struct {
+	Type         string `json:"type"`
+	RestaurantID uint   `json:"restaurantId,omitempty"`
+}
+
+// ServerMessage 서버가 보내는 메시지 (type에 따라 state, spin, error 중 하나가 채워짐)
+type ServerMessage struct {
+	Type  string        `json:"type"`
+	State *State        `json:"state,omitempty"`
+	Spin  *Spin         `json:"spin,omitempty"`
+	Error *ErrorMessage `json:"error,omitempty"`
+}
+
+// State 방의 현재 접속자와 투표 현황
+type State struct {
+	Room string `json:"room"`
+	// Members 접속 중인 사람 (이름순, 여러 탭으로 접속해도 한 번)
+	Members []string `json:"members"`
+	// Votes 맛집별 득표 (많은 순, 같으면 맛집 ID순)
+	Votes []Tally `json:"votes"`
+}
+
+// Tally 맛집 한 곳의 득표
+type Tally struct {
+	RestaurantID uint     `json:"restaurantId"`
+	Name         string   `json:"name"`
+	Voters       []string `json:"voters"`
+}
+
+// Candidate 룰렛 후보 (가중치는 득표 수, 투표가 없으면 모든 맛집이 1)
+type Candidate struct {
+	RestaurantID uint   `json:"restaurantId"`
+	Name         string `json:"name"`
+	Weight       int    `json:"weight"`
+}
+
+// Spin 룰렛 결과 (모든 접속자에게 동시에 전달되며 revealAt에 함께 공개)
+type Spin struct {
+	SpunBy     string      `json:"spunBy"`
+	Result     Candidate   `json:"result"`
+	Candidates []Candidate `json:"candidates"`
+	RevealAt   time.Time   `json:"revealAt"`
+}
+
+// ErrorMessage 요청한 클라이언트에게만 보내는 에러
+type ErrorMessage struct {
+	Code    apierror.Code `json:"code"`
+	Message string        `json:"message"`
+}
+
+// Client 방에 접속한 한 연결
+type Client struct {
+	Name string
+	Lang i18n.Lang
+	send chan ServerMessage
+}
+
+// NewClient name으로 접속하는 클라이언트 생성 (에러 메시지는 lang으로 번역)
+func NewClient(name string, lang i18n.Lang) *Client {
+	return &Client{Name: name, Lang: lang, send: make(chan ServerMessage, clientBuffer)}
+}
+
+// Messages 클라이언트에게 보낼 메시지 채널 (방을 나가거나 너무 느려서 끊기면 닫힘)
+func (c *Client) Messages() <-chan ServerMessage {
+	return c.send
+}
+
+// Manager 방 이름별 Room 관리 (접속자가 없는 방은 고루틴을 멈추고 제거)
+type Manager struct {
+	db *gorm.DB
+	// SpinDuration 룰렛 결과 공개까지의 시간
+	SpinDuration time.Duration
+	// Pick 룰렛 추첨 함수 (기본은 DB 맛집 중 득표 가중 추첨)
+	Pick func(ctx context.Context, db *gorm.DB, votes map[uint]int) (Candidate, []Candidate, error)
+
+	mu    sync.Mutex
+	rooms map[string]*Room
+}
+
+// NewManager db의 맛집으로 투표·룰렛을 진행하는 방 관리자 생성
+func NewManager(db *gorm.DB) *Manager {
+	return &Manager{db: db, SpinDuration: DefaultSpinDuration, Pick: PickWeighted, rooms: make(map[string]*Room)}
+}
+
+// Join name 방에 client를 입장시키고 방 반환 (방이 없으면 생성)
+func (m *Manager) Join(name string, client *Client) *Room {
+	m.mu.Lock()
+	room, ok := m.rooms[name]
+	if !ok {
+		room = newRoom(name, m)
+		m.rooms[name] = room
+		go room.run()
+	}
+	room.connections++
+	m.mu.Unlock()
+
+	room.join <- client
+	return room
+}
+
+// Leave client를 방에서 내보내고 마지막 접속자였으면 방을 닫음
+func (m *Manager) Leave(room *Room, client *Client) {
+	room.leave <- client
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	room.connections--
+	if room.connections == 0 {
+		delete(m.rooms, room.name)
+		close(room.done)
+	}
+}
+
+// Snapshot name 방의 현재 상태 (열린 방이 없으면 빈 상태)
+func (m *Manager) Snapshot(name string) State {
+	m.mu.Lock()
+	room, ok := m.rooms[name]
+	m.mu.Unlock()
+
+	empty := State{Room: name, Members: []string{}, Votes: []Tally{}}
+	if !ok {
+		return empty
+	}
+	reply := make(chan State, 1)
+	select {
+	case room.snapshots <- reply:
+		return <-reply
+	case <-room.done:
+		return empty
+	}
+}
+
+// Room 방 하나의 상태를 소유하는 고루틴과 통신하는 채널 묶음
+type Room struct {
+	name    string
+	manager *Manager
+
+	join      chan *Client
+	leave     chan *Client
+	commands  chan command
+	snapshots chan chan State
+	spun      chan spinOutcome
+	done      chan struct{}
+
+	// connections Manager.mu로 보호되는 접속 수
+	connections int
+}
+
+// command 방 고루틴에 전달하는 요청
+type command struct {
+	client    *Client
+	kind      string
+	candidate Candidate
+	err       *ErrorMessage
+}
+
+// spinOutcome 방 고루틴 밖에서 추첨한 결과
+type spinOutcome struct {
+	client     *Client
+	result     Candidate
+	candidates []Candidate
+	err        error
+}
+
+func newRoom(name string, manager *Manager) *Room {
+	return &Room{
+		name:      name,
+		manager:   manager,
+		join:      make(chan *Client),
+		leave:     make(chan *Client),
+		commands:  make(chan command),
+		snapshots: make(chan chan State),
+		spun:      make(chan spinOutcome),
+		done:      make(chan struct{}),
+	}
+}
+
+// Handle 클라이언트 메시지 처리 (투표할 맛집 확인은 호출한 고루틴에서 DB로 조회)
+func (r *Room) Handle(ctx context.Context, client *Client, message ClientMessage) {
+	cmd := command{client: client, kind: message.Type}
+	switch message.Type {
+	case MessageVote:
+		var restaurant models.Restaurant
+		err := gorm.ErrRecordNotFound
+		if message.RestaurantID > 0 {
+			err = r.manager.db.WithContext(ctx).Select("id", "name").First(&restaurant, message.RestaurantID).Error
+		}
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			cmd.err = &ErrorMessage{Code: apierror.CodeRestaurantNotFound, Message: i18n.T(client.Lang, i18n.RoomRestaurantNotFound, message.RestaurantID)}
+		case err != nil:
+			cmd.err = &ErrorMessage{Code: apierror.CodeInternal, Message: i18n.T(client.Lang, i18n.RoomVoteFailed)}
+		default:
+			cmd.candidate = Candidate{RestaurantID: restaurant.ID, Name: restaurant.Name, Weight: 1}
+		}
+	case MessageUnvote, MessageSpin:
+	default:
+		cmd.err = &ErrorMessage{Code: apierror.CodeInvalidMessage, Message: i18n.T(client.Lang, i18n.RoomInvalidMessage)}
+	}
+	r.commands <- cmd
+}
+
+// run 방 상태를 소유하는 루프 (Manager가 done을 닫으면 종료)
+func (r *Room) run() {
+	clients := make(map[*Client]struct{})
+	votes := make(map[string]Candidate)
+	var spinning bool
+	var revealAt time.Time
+
+	members := func() map[string]int {
+		counts := make(map[string]int)
+		for client := range clients {
+			counts[client.Name]++
+		}
+		return counts
+	}
+	// remove 연결을 제거하고 그 사람의 마지막 연결이면 투표도 취소
+	remove := func(client *Client) {
+		if _, ok := clients[client]; !ok {
+			return
+		}
+		delete(clients, client)
+		close(client.send)
+		if members()[client.Name] == 0 {
+			delete(votes, client.Name)
+		}
+	}
+	// deliver 기다리지 않고 전달, 버퍼가 가득 찬 연결은 끊음 (클라이언트가 재접속하면 최신 상태를 받음)
+	deliver := func(client *Client, message ServerMessage) bool {
+		select {
+		case client.send <- message:
+			return true
+		default:
+			remove(client)
+			return false
+		}
+	}
+	state := func() State {
+		counts := members()
+		s := State{Room: r.name, Members: make([]string, 0, len(counts)), Votes: tallies(votes)}
+		for name := range counts {
+			s.Members = append(s.Members, name)
+		}
+		sort.Strings(s.Members)
+		return s
+	}
+	// broadcast 모든 연결에 전달하고, 끊긴 연결이 있으면 남은 사람에게 바뀐 접속자 목록을 다시 알림
+	var broadcast func(message ServerMessage)
+	broadcast = func(message ServerMessage) {
+		dropped := false
+		for client := range clients {
+			if !deliver(client, message) {
+				dropped = true
+			}
+		}
+		if dropped {
+			broadcast(ServerMessage{Type: MessageState, State: ptr(state())})
+		}
+	}
+	broadcastState := func() {
+		broadcast(ServerMessage{Type: MessageState, State: ptr(state())})
+	}
+	sendError := func(client *Client, code apierror.Code, key i18n.Key) {
+		deliver(client, ServerMessage{Type: MessageError, Error: &ErrorMessage{Code: code, Message: i18n.T(client.Lang, key)}})
+	}
+
+	for {
+		select {
+		case <-r.done:
+			return
+
+		case client := <-r.join:
+			clients[client] = struct{}{}
+			broadcastState()
+
+		case client := <-r.leave:
+			if _, ok := clients[client]; ok {
+				remove(client)
+				broadcastState()
+			}
+
+		case reply := <-r.snapshots:
+			reply <- state()
+
+		case cmd := <-r.commands:
+			if _, ok := clients[cmd.client]; !ok {
+				continue
+			}
+			if cmd.err != nil {
+				deliver(cmd.client, ServerMessage{Type: MessageError, Error: cmd.err})
+				continue
+			}
+			switch cmd.kind {
+			case MessageVote:
+				votes[cmd.client.Name] = cmd.candidate
+				broadcastState()
+			case MessageUnvote:
+				delete(votes, cmd.client.Name)
+				broadcastState()
+			case MessageSpin:
+				if spinning || time.Now().Before(revealAt) {
+					sendError(cmd.client, apierror.CodeSpinInProgress, i18n.RoomSpinInProgress)
+					continue
+				}
+				spinning = true
+				go r.pick(cmd.client, voteCounts(votes))
+			}
+
+		case outcome := <-r.spun:
+			spinning = false
+			if _, ok := clients[outcome.client]; ok && outcome.err != nil {
+				if errors.Is(outcome.err, ErrNoRestaurants) {
+					sendError(outcome.client, apierror.CodeNoRestaurants, i18n.RoomNoRestaurants)
+				} else {
+					sendError(outcome.client, apierror.CodeInternal, i18n.RoomSpinFailed)
+				}
+				continue
+			}
+			if outcome.err != nil {
+				continue
+			}
+			revealAt = time.Now().Add(r.manager.SpinDuration)
+			broadcast(ServerMessage{Type: MessageSpin, Spin: &Spin{
+				SpunBy:     outcome.client.Name,
+				Result:     outcome.result,
+				Candidates: outcome.candidates,
+				RevealAt:   revealAt,
+			}})
+		}
+	}
+}
+
+// pick 방 고루틴을 막지 않도록 별도 고루틴에서 추첨하고 결과를 방에 전달
+func (r *Room) pick(client *Client, votes map[uint]int) {
+	ctx, cancel := context.WithTimeout(context.Background(), pickTimeout)
+	defer cancel()
+	result, candidates, err := r.manager.Pick(ctx, r.manager.db.WithContext(ctx), votes)
+	select {
+	case r.spun <- spinOutcome{client: client, result: result, candidates: candidates, err: err}:
+	case <-r.done:
+	}
+}
+
+// PickWeighted 득표한 맛집 중 득표 수 가중치로 추첨 (투표가 없거나 득표 맛집이 모두 삭제됐으면 전체 맛집 중 균등 추첨)
+// 추첨 방식은 점심 룰렛(draw.Pick)과 같음
+func PickWeighted(ctx context.Context, db *gorm.DB, votes map[uint]int) (Candidate, []Candidate, error) {
+	var restaurants []models.Restaurant
+	if len(votes) > 0 {
+		ids := make([]uint, 0, len(votes))
+		for id := range votes {
+			ids = append(ids, id)
+		}
+		if err := db.Select("id", "name").Where("id IN ?", ids).Order("id").Find(&restaurants).Error; err != nil {
+			return Candidate{}, nil, err
+		}
+	}
+	weighted := len(restaurants) > 0
+	if !weighted {
+		if err := db.Select("id", "name").Order("id").Find(&restaurants).Error; err != nil {
+			return Candidate{}, nil, err
+		}
+	}
+	if len(restaurants) == 0 {
+		return Candidate{}, nil, ErrNoRestaurants
+	}
+
+	candidates := make([]Candidate, 0, len(restaurants))
+	weights := make([]int, 0, len(restaurants))
+	for _, restaurant := range restaurants {
+		weight := 1
+		if weighted {
+			weight = votes[restaurant.ID]
+		}
+		candidates = append(candidates, Candidate{RestaurantID: restaurant.ID, Name: restaurant.Name, Weight: weight})
+		weights = append(weights, weight)
+	}
+	return candidates[draw.Pick(draw.NewSeed(), weights)], candidates, nil
+}
+
+// voteCounts 맛집 ID별 득표 수
+func voteCounts(votes map[string]Candidate) map[uint]int {
+	counts := make(map[uint]int, len(votes))
+	for _, candidate := range votes {
+		counts[candidate.RestaurantID]++
+	}
+	return counts
+}
+
+// tallies 투표를 맛집별로 묶어 많은 순으로 정렬
+func tallies(votes map[string]Candidate) []Tally {
+	byRestaurant := make(map[uint]*Tally)
+	for voter, candidate := range votes {
+		tally, ok := byRestaurant[candidate.RestaurantID]
+		if !ok {
+			tally = &Tally{RestaurantID: candidate.RestaurantID, Name: candidate.Name}
+			byRestaurant[candidate.RestaurantID] = tally
+		}
+		tally.Voters = append(tally.Voters, voter)
+	}
+	result := make([]Tally, 0, len(byRestaurant))
+	for _, tally := range byRestaurant {
+		sort.Strings(tally.Voters)
+		result = append(result, *tally)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if len(result[i].Voters) != len(result[j].Voters) {
+			return len(result[i].Voters) > len(result[j].Voters)
+		}
+		return result[i].RestaurantID < result[j].RestaurantID
+	})
+	return result
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
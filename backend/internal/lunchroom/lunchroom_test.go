@@ -0,0 +1,189 @@
+package lunchroom
+
+import (
+	"context"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupManager(t *testing.T, names ...string) (*Manager, []models.Restaurant) {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Restaurant{}))
+	restaurants := make([]models.Restaurant, 0, len(names))
+	for _, name := range names {
+		restaurants = append(restaurants, models.Restaurant{Name: name, Address: "서울시 " + name})
+	}
+	if len(restaurants) > 0 {
+		require.NoError(t, db.Create(&restaurants).Error)
+	}
+	return NewManager(db), restaurants
+}
+
+func next(t *testing.T, client *Client) ServerMessage {
+	t.Helper()
+	select {
+	case message, ok := <-client.Messages():
+		require.True(t, ok, "연결이 끊김")
+		return message
+	case <-time.After(2 * time.Second):
+		t.Fatal("메시지 대기 시간 초과")
+		return ServerMessage{}
+	}
+}
+
+func TestRoom_PresenceAndVotes(t *testing.T) {
+	manager, restaurants := setupManager(t, "국밥집", "초밥집")
+	ctx := context.Background()
+
+	minsu := NewClient("민수", i18n.KO)
+	room := manager.Join("점심", minsu)
+	assert.Equal(t, []string{"민수"}, next(t, minsu).State.Members)
+
+	jiyoung := NewClient("지영", i18n.EN)
+	manager.Join("점심", jiyoung)
+	assert.Equal(t, []string{"민수", "지영"}, next(t, minsu).State.Members)
+	assert.Equal(t, []string{"민수", "지영"}, next(t, jiyoung).State.Members)
+
+	room.Handle(ctx, minsu, ClientMessage{Type: MessageVote, RestaurantID: restaurants[0].ID})
+	next(t, jiyoung)
+	room.Handle(ctx, jiyoung, ClientMessage{Type: MessageVote, RestaurantID: restaurants[0].ID})
+	next(t, jiyoung)
+	// 다시 투표하면 표를 옮김
+	room.Handle(ctx, minsu, ClientMessage{Type: MessageVote, RestaurantID: restaurants[1].ID})
+	next(t, minsu)
+	next(t, minsu)
+	state := next(t, minsu).State
+	require.Len(t, state.Votes, 2)
+	assert.Equal(t, Tally{RestaurantID: restaurants[0].ID, Name: "국밥집", Voters: []string{"지영"}}, state.Votes[0])
+	assert.Equal(t, []string{"민수"}, state.Votes[1].Voters)
+
+	// 에러는 보낸 사람에게만, 요청 언어로
+	room.Handle(ctx, jiyoung, ClientMessage{Type: MessageVote, RestaurantID: 999})
+	room.Handle(ctx, jiyoung, ClientMessage{Type: "dance"})
+	next(t, jiyoung)
+	message := next(t, jiyoung)
+	require.Equal(t, MessageError, message.Type)
+	assert.Equal(t, apierror.CodeRestaurantNotFound, message.Error.Code)
+	assert.Equal(t, "Restaurant not found (ID: 999)", message.Error.Message)
+	assert.Equal(t, apierror.CodeInvalidMessage, next(t, jiyoung).Error.Code)
+
+	// 나가면 그 사람의 표도 사라짐
+	manager.Leave(room, jiyoung)
+	_, open := <-jiyoung.Messages()
+	assert.False(t, open)
+	for len(minsu.Messages()) > 1 {
+		<-minsu.Messages()
+	}
+	state = next(t, minsu).State
+	assert.Equal(t, []string{"민수"}, state.Members)
+	require.Len(t, state.Votes, 1)
+	assert.Equal(t, *state, manager.Snapshot("점심"))
+
+	manager.Leave(room, minsu)
+	assert.Empty(t, manager.Snapshot("점심").Members, "마지막 사람이 나가면 방이 닫힘")
+	manager.mu.Lock()
+	assert.Empty(t, manager.rooms)
+	manager.mu.Unlock()
+}
+
+func TestRoom_SpinBroadcastsSameResult(t *testing.T) {
+	manager, restaurants := setupManager(t, "국밥집", "초밥집", "버거집")
+	ctx := context.Background()
+	manager.SpinDuration = 50 * time.Millisecond
+
+	clients := []*Client{NewClient("민수", i18n.KO), NewClient("지영", i18n.KO), NewClient("철수", i18n.KO)}
+	var room *Room
+	for _, client := range clients {
+		room = manager.Join("룰렛", client)
+	}
+	room.Handle(ctx, clients[0], ClientMessage{Type: MessageVote, RestaurantID: restaurants[2].ID})
+
+	room.Handle(ctx, clients[1], ClientMessage{Type: MessageSpin})
+	// 결과가 나오기 전 다시 돌리면 거부 (추첨 중 또는 공개 전)
+	room.Handle(ctx, clients[2], ClientMessage{Type: MessageSpin})
+
+	var spins []*Spin
+	for _, client := range clients {
+		for {
+			message := next(t, client)
+			if message.Type == MessageSpin {
+				spins = append(spins, message.Spin)
+				break
+			}
+			if message.Type == MessageError {
+				assert.Equal(t, apierror.CodeSpinInProgress, message.Error.Code)
+			}
+		}
+	}
+	for _, spin := range spins {
+		assert.Equal(t, spins[0], spin, "모두 같은 결과와 공개 시각")
+	}
+	assert.Equal(t, "지영", spins[0].SpunBy)
+	assert.Equal(t, restaurants[2].ID, spins[0].Result.RestaurantID, "득표한 맛집 중에서만 추첨")
+	assert.Equal(t, []Candidate{{RestaurantID: restaurants[2].ID, Name: "버거집", Weight: 1}}, spins[0].Candidates)
+
+	// 공개 시각이 지나면 다시 돌릴 수 있음
+	time.Sleep(time.Until(spins[0].RevealAt))
+	room.Handle(ctx, clients[2], ClientMessage{Type: MessageSpin})
+	assert.Equal(t, "철수", next(t, clients[0]).Spin.SpunBy)
+
+	for _, client := range clients {
+		manager.Leave(room, client)
+	}
+}
+
+func TestRoom_SlowClientIsDropped(t *testing.T) {
+	manager, _ := setupManager(t)
+	ctx := context.Background()
+
+	slow := NewClient("느림", i18n.KO)
+	room := manager.Join("방", slow)
+	fast := NewClient("빠름", i18n.KO)
+	manager.Join("방", fast)
+
+	// slow는 메시지를 읽지 않아 버퍼가 가득 참
+	next(t, fast)
+	for i := 0; i < clientBuffer; i++ {
+		room.Handle(ctx, fast, ClientMessage{Type: MessageUnvote})
+		next(t, fast)
+	}
+	assert.Equal(t, []string{"빠름"}, manager.Snapshot("방").Members)
+	assert.Len(t, slow.Messages(), clientBuffer, "버퍼까지 받은 뒤 채널이 닫힘")
+
+	manager.Leave(room, slow)
+	manager.Leave(room, fast)
+}
+
+func TestPickWeighted(t *testing.T) {
+	manager, restaurants := setupManager(t, "국밥집", "초밥집")
+	db := manager.db
+
+	counts := map[uint]int{}
+	for i := 0; i < 200; i++ {
+		result, candidates, err := PickWeighted(context.Background(), db, map[uint]int{restaurants[0].ID: 3, restaurants[1].ID: 1})
+		require.NoError(t, err)
+		require.Len(t, candidates, 2)
+		counts[result.RestaurantID]++
+	}
+	assert.Greater(t, counts[restaurants[0].ID], counts[restaurants[1].ID], "표가 많은 맛집이 더 자주 당첨")
+
+	// 득표 맛집이 모두 삭제됐으면 전체 맛집 중 균등 추첨
+	_, candidates, err := PickWeighted(context.Background(), db, map[uint]int{999: 2})
+	require.NoError(t, err)
+	assert.Len(t, candidates, 2)
+	assert.Equal(t, 1, candidates[0].Weight)
+
+	require.NoError(t, db.Where("1 = 1").Delete(&models.Restaurant{}).Error)
+	_, _, err = PickWeighted(context.Background(), db, nil)
+	assert.ErrorIs(t, err, ErrNoRestaurants)
+}
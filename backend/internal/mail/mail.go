@@ -0,0 +1,128 @@
+// Package mail 메일 메시지 작성과 SMTP 발송
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// ErrNotConfigured SMTP 서버가 설정되지 않음
+var ErrNotConfigured = errors.New("mail: smtp is not configured")
+
+// Sender 메일 발송기
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Message 텍스트와 HTML 본문을 함께 보내는 메일 (multipart/alternative)
+type Message struct {
+	// From 비어 있으면 발송기의 기본 발신자
+	From    string
+	To      []string
+	Subject string
+	Text    string
+	// HTML 비어 있으면 텍스트 본문만 보냄
+	HTML string
+	// Headers 추가 헤더 (List-Unsubscribe 등)
+	Headers map[string]string
+}
+
+// Recipients 봉투(RCPT TO)에 쓸 수신자 주소
+func (m Message) Recipients() ([]string, error) {
+	recipients := make([]string, 0, len(m.To))
+	for _, to := range m.To {
+		address, err := mail.ParseAddress(to)
+		if err != nil {
+			return nil, fmt.Errorf("mail: invalid recipient %q: %w", to, err)
+		}
+		recipients = append(recipients, address.Address)
+	}
+	if len(recipients) == 0 {
+		return nil, errors.New("mail: no recipients")
+	}
+	return recipients, nil
+}
+
+// Bytes RFC 5322 메시지로 변환 (한글 제목은 RFC 2047, 본문은 quoted-printable로 인코딩)
+func (m Message) Bytes(now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+	}
+	header("From", m.From)
+	header("To", strings.Join(m.To, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", messageID(m.From))
+	header("MIME-Version", "1.0")
+	for key, value := range m.Headers {
+		header(textproto.CanonicalMIMEHeaderKey(key), value)
+	}
+
+	if m.HTML == "" {
+		header("Content-Type", "text/plain; charset=utf-8")
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeQuotedPrintable(&buf, m.Text); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	writer := multipart.NewWriter(&buf)
+	header("Content-Type", "multipart/alternative; boundary="+writer.Boundary())
+	buf.WriteString("\r\n")
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", m.HTML},
+	} {
+		w, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQuotedPrintable(w, part.body); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeQuotedPrintable(w io.Writer, body string) error {
+	qp := quotedprintable.NewWriter(w)
+	// 텍스트 모드에서는 줄바꿈을 CRLF로 바꿔 씀
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// messageID 발신자 도메인으로 Message-ID 생성
+func messageID(from string) string {
+	domain := "localhost"
+	if address, err := mail.ParseAddress(from); err == nil {
+		if _, host, ok := strings.Cut(address.Address, "@"); ok {
+			domain = host
+		}
+	}
+	id := make([]byte, 16)
+	rand.Read(id)
+	return "<" + hex.EncodeToString(id) + "@" + domain + ">"
+}
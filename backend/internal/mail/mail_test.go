@@ -0,0 +1,131 @@
+package mail
+
+import (
+	"context"
+	"io"
+	"lunch_app/backend/internal/mail/mailtest"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedNow = time.Date(2024, 7, 5, 8, 30, 0, 0, time.FixedZone("KST", 9*60*60))
+
+func newTestSender(t *testing.T) (*SMTPSender, *mailtest.Server) {
+	server, err := mailtest.NewServer()
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+	sender := NewSMTPSender(SMTPConfig{
+		Host:     server.Host(),
+		Port:     server.Port(),
+		Username: "lunch",
+		Password: "secret",
+		From:     "점심 알리미 <lunch@example.com>",
+		TLS:      TLSNone,
+	})
+	sender.Now = func() time.Time { return fixedNow }
+	return sender, server
+}
+
+func TestSMTPSender_SendsMultipart(t *testing.T) {
+	sender, server := newTestSender(t)
+
+	err := sender.Send(context.Background(), Message{
+		To:      []string{"민수 <minsu@example.com>"},
+		Subject: "오늘의 점심",
+		Text:    "국밥집 어때요?\n= 등호도 그대로",
+		HTML:    "<p>국밥집 어때요?</p>",
+		Headers: map[string]string{"List-Unsubscribe": "<https://example.com/unsubscribe>"},
+	})
+	require.NoError(t, err)
+
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, "lunch@example.com", messages[0].From)
+	assert.Equal(t, []string{"minsu@example.com"}, messages[0].To)
+	assert.Equal(t, "lunch", messages[0].Username)
+
+	parsed, err := messages[0].Parse()
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "오늘의 점심", subject)
+	assert.Equal(t, "<https://example.com/unsubscribe>", parsed.Header.Get("List-Unsubscribe"))
+	assert.Equal(t, fixedNow.Format(time.RFC1123Z), parsed.Header.Get("Date"))
+	assert.True(t, strings.HasSuffix(parsed.Header.Get("Message-ID"), "@example.com>"))
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	var bodies []string
+	for {
+		part, err := reader.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(quotedprintable.NewReader(part))
+		require.NoError(t, err)
+		bodies = append(bodies, part.Header.Get("Content-Type")+"|"+string(body))
+	}
+	assert.Equal(t, []string{
+		"text/plain; charset=utf-8|국밥집 어때요?\n= 등호도 그대로",
+		"text/html; charset=utf-8|<p>국밥집 어때요?</p>",
+	}, bodies)
+}
+
+func TestSMTPSender_RejectedRecipient(t *testing.T) {
+	sender, server := newTestSender(t)
+	server.RejectRecipients["gone@example.com"] = true
+
+	err := sender.Send(context.Background(), Message{To: []string{"gone@example.com"}, Subject: "점심", Text: "본문"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "mailbox unavailable")
+	assert.Empty(t, server.Messages())
+
+	err = sender.Send(context.Background(), Message{To: []string{"not an address"}, Subject: "점심", Text: "본문"})
+	assert.ErrorContains(t, err, "invalid recipient")
+}
+
+func TestMessage_TextOnly(t *testing.T) {
+	data, err := Message{From: "lunch@example.com", To: []string{"a@example.com"}, Subject: "lunch", Text: "hello"}.Bytes(fixedNow)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "Content-Type: text/plain; charset=utf-8\r\n")
+	assert.True(t, strings.HasSuffix(string(data), "\r\n\r\nhello"))
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("SMTP_HOST", "")
+	_, err := ConfigFromEnv()
+	assert.ErrorIs(t, err, ErrNotConfigured)
+
+	t.Setenv("SMTP_HOST", "smtp.example.com")
+	t.Setenv("SMTP_USERNAME", "lunch@example.com")
+	cfg, err := ConfigFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, 587, cfg.Port)
+	assert.Equal(t, TLSStartTLS, cfg.TLS)
+	assert.Equal(t, "lunch@example.com", cfg.From, "발신자 기본값은 SMTP_USERNAME")
+
+	t.Setenv("SMTP_TLS", TLSImplicit)
+	cfg, err = ConfigFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, 465, cfg.Port)
+
+	t.Setenv("SMTP_PORT", "1025")
+	t.Setenv("SMTP_TLS", TLSNone)
+	cfg, err = ConfigFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, 1025, cfg.Port)
+
+	t.Setenv("SMTP_TLS", "ssl")
+	_, err = ConfigFromEnv()
+	assert.Error(t, err)
+}
@@ -0,0 +1,195 @@
+// Package mailtest 테스트용 가짜 SMTP 서버
+//
+// 실제로 메일을 보내지 않고 받은 메시지를 메모리에 보관함. STARTTLS를 광고하지 않으므로
+// 발송기는 SMTP_TLS가 none이든 starttls든 평문으로 보냄.
+package mailtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Message 가짜 서버가 받은 메시지
+type Message struct {
+	// From, To 봉투 주소 (MAIL FROM, RCPT TO)
+	From string
+	To   []string
+	// Username AUTH PLAIN으로 인증한 사용자 (인증하지 않았으면 빈 문자열)
+	Username string
+	// Data DATA로 받은 원본 메시지
+	Data []byte
+}
+
+// Parse 원본 메시지의 헤더와 본문 해석
+func (m Message) Parse() (*mail.Message, error) {
+	return mail.ReadMessage(bytes.NewReader(m.Data))
+}
+
+// Server 127.0.0.1의 임의 포트에서 받는 가짜 SMTP 서버
+type Server struct {
+	// RejectRecipients 이 주소로 보내면 RCPT TO를 550으로 거부
+	RejectRecipients map[string]bool
+
+	listener net.Listener
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewServer 서버를 시작 (Close로 종료)
+func NewServer() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{RejectRecipients: map[string]bool{}, listener: listener}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Host 접속 호스트 (127.0.0.1)
+func (s *Server) Host() string {
+	return s.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+// Port 접속 포트
+func (s *Server) Port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Messages 지금까지 받은 메시지
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// Close 새 접속을 받지 않고 진행 중인 세션이 끝날 때까지 기다림
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.session(conn)
+		}()
+	}
+}
+
+// session SMTP 명령 하나씩 처리 (EHLO, HELO, AUTH PLAIN, MAIL, RCPT, DATA, RSET, NOOP, QUIT)
+func (s *Server) session(conn net.Conn) {
+	text := textproto.NewConn(conn)
+	reply := func(code int, lines ...string) {
+		for i, line := range lines {
+			separator := " "
+			if i < len(lines)-1 {
+				separator = "-"
+			}
+			text.PrintfLine("%d%s%s", code, separator, line)
+		}
+	}
+
+	var current Message
+	var username string
+	reply(220, "mailtest ESMTP")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			reply(250, "mailtest", "8BITMIME", "AUTH PLAIN")
+		case "HELO":
+			reply(250, "mailtest")
+		case "AUTH":
+			mechanism, initial, _ := strings.Cut(arg, " ")
+			user, ok := decodePlain(initial)
+			if !strings.EqualFold(mechanism, "PLAIN") || !ok {
+				reply(535, "5.7.8 authentication failed")
+				continue
+			}
+			username = user
+			reply(235, "2.7.0 authenticated")
+		case "MAIL":
+			current = Message{From: angleAddress(arg), Username: username}
+			reply(250, "2.1.0 ok")
+		case "RCPT":
+			address := angleAddress(arg)
+			if s.RejectRecipients[address] {
+				reply(550, "5.1.1 mailbox unavailable")
+				continue
+			}
+			current.To = append(current.To, address)
+			reply(250, "2.1.5 ok")
+		case "DATA":
+			if current.From == "" || len(current.To) == 0 {
+				reply(503, "5.5.1 need MAIL and RCPT first")
+				continue
+			}
+			reply(354, "end data with <CR><LF>.<CR><LF>")
+			data, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			current.Data = data
+			s.mu.Lock()
+			s.messages = append(s.messages, current)
+			s.mu.Unlock()
+			current = Message{}
+			reply(250, "2.0.0 queued as "+strconv.Itoa(len(s.Messages())))
+		case "RSET":
+			current = Message{}
+			reply(250, "2.0.0 ok")
+		case "NOOP":
+			reply(250, "2.0.0 ok")
+		case "QUIT":
+			reply(221, "2.0.0 bye")
+			return
+		default:
+			reply(502, "5.5.2 command not implemented")
+		}
+	}
+}
+
+// angleAddress "FROM:<a@example.com> BODY=8BITMIME"에서 a@example.com
+func angleAddress(arg string) string {
+	_, rest, ok := strings.Cut(arg, "<")
+	if !ok {
+		return ""
+	}
+	address, _, _ := strings.Cut(rest, ">")
+	return address
+}
+
+// decodePlain AUTH PLAIN 초기 응답(base64 "authzid\x00user\x00password")에서 사용자
+func decodePlain(initial string) (string, bool) {
+	decoded, err := base64.StdEncoding.DecodeString(initial)
+	if err != nil {
+		return "", false
+	}
+	parts := bytes.Split(decoded, []byte{0})
+	if len(parts) != 3 || len(parts[1]) == 0 {
+		return "", false
+	}
+	return string(parts[1]), true
+}
@@ -0,0 +1,187 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"strconv"
+	"time"
+)
+
+// SMTP 연결 암호화 방식
+const (
+	// TLSStartTLS 평문으로 접속한 뒤 서버가 지원하면 STARTTLS (기본)
+	TLSStartTLS = "starttls"
+	// TLSImplicit 처음부터 TLS로 접속 (보통 465 포트)
+	TLSImplicit = "tls"
+	// TLSNone 암호화하지 않음 (로컬 테스트용 SMTP 서버)
+	TLSNone = "none"
+)
+
+// defaultSMTPTimeout 접속부터 발송 완료까지 기다리는 시간
+const defaultSMTPTimeout = 30 * time.Second
+
+// SMTPConfig SMTP 서버 설정
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username 비어 있으면 인증하지 않음 (PLAIN 인증은 TLS 연결이나 localhost에서만 보냄)
+	Username string
+	Password string
+	// From 기본 발신자 (예: "점심 알리미 <lunch@example.com>")
+	From string
+	// TLS starttls, tls, none
+	TLS     string
+	Timeout time.Duration
+}
+
+// ConfigFromEnv 환경변수에서 SMTP 설정을 읽음
+//
+//	SMTP_HOST      SMTP 서버 (없으면 ErrNotConfigured)
+//	SMTP_PORT      포트 (기본 587, SMTP_TLS=tls이면 465)
+//	SMTP_USERNAME  인증 사용자 (없으면 인증하지 않음)
+//	SMTP_PASSWORD  인증 비밀번호
+//	SMTP_FROM      발신자 (기본 SMTP_USERNAME)
+//	SMTP_TLS       starttls(기본), tls, none
+func ConfigFromEnv() (SMTPConfig, error) {
+	cfg := SMTPConfig{
+		Host:     os.Getenv("SMTP_HOST"),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+		TLS:      os.Getenv("SMTP_TLS"),
+		Timeout:  defaultSMTPTimeout,
+	}
+	if cfg.Host == "" {
+		return SMTPConfig{}, ErrNotConfigured
+	}
+	switch cfg.TLS {
+	case "":
+		cfg.TLS = TLSStartTLS
+	case TLSStartTLS, TLSImplicit, TLSNone:
+	default:
+		return SMTPConfig{}, fmt.Errorf("mail: invalid SMTP_TLS %q", cfg.TLS)
+	}
+	cfg.Port = 587
+	if cfg.TLS == TLSImplicit {
+		cfg.Port = 465
+	}
+	if value := os.Getenv("SMTP_PORT"); value != "" {
+		port, err := strconv.Atoi(value)
+		if err != nil || port <= 0 || port > 65535 {
+			return SMTPConfig{}, fmt.Errorf("mail: invalid SMTP_PORT %q", value)
+		}
+		cfg.Port = port
+	}
+	if cfg.From == "" {
+		cfg.From = cfg.Username
+	}
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		return SMTPConfig{}, fmt.Errorf("mail: invalid SMTP_FROM %q: %w", cfg.From, err)
+	}
+	return cfg, nil
+}
+
+// SMTPSender SMTP 서버로 메일을 보내는 Sender (발송마다 새로 접속)
+type SMTPSender struct {
+	Config SMTPConfig
+	// Now Date 헤더 시각 (테스트에서 고정 시각 주입용)
+	Now func() time.Time
+}
+
+// NewSMTPSender 설정으로 SMTPSender 생성
+func NewSMTPSender(cfg SMTPConfig) *SMTPSender {
+	return &SMTPSender{Config: cfg, Now: time.Now}
+}
+
+// Send 메시지 발송
+func (s *SMTPSender) Send(ctx context.Context, msg Message) error {
+	if msg.From == "" {
+		msg.From = s.Config.From
+	}
+	from, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return fmt.Errorf("mail: invalid sender %q: %w", msg.From, err)
+	}
+	recipients, err := msg.Recipients()
+	if err != nil {
+		return err
+	}
+	body, err := msg.Bytes(s.Now())
+	if err != nil {
+		return err
+	}
+
+	timeout := s.Config.Timeout
+	if timeout <= 0 {
+		timeout = defaultSMTPTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	client, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if s.Config.TLS == TLSStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: s.Config.Host}); err != nil {
+				return err
+			}
+		}
+	}
+	if s.Config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.Config.Username, s.Config.Password, s.Config.Host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	for _, recipient := range recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// dial SMTP 서버에 접속 (ctx의 마감 시각을 연결 전체의 deadline으로 사용)
+func (s *SMTPSender) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(s.Config.Host, strconv.Itoa(s.Config.Port))
+	dialer := &net.Dialer{}
+	var conn net.Conn
+	var err error
+	if s.Config.TLS == TLSImplicit {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: s.Config.Host}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	client, err := smtp.NewClient(conn, s.Config.Host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
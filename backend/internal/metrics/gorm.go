@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const queryStartKey = "metrics:query_start"
+
+// GormPlugin GORM 쿼리 실행 시간을 측정하는 플러그인
+// database.Connect에서 db.Use(metrics.GormPlugin{})로 등록
+type GormPlugin struct{}
+
+// Name gorm.Plugin 인터페이스 구현
+func (GormPlugin) Name() string {
+	return "metrics"
+}
+
+// Initialize 각 콜백 체인 앞뒤에 시간 측정 콜백을 등록
+func (GormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	registrations := []struct {
+		name string
+		fn   func(*gorm.DB)
+		reg  func(name string, fn func(*gorm.DB)) error
+	}{
+		{"metrics:before_create", startTimer, cb.Create().Before("gorm:create").Register},
+		{"metrics:after_create", observe("create"), cb.Create().After("gorm:create").Register},
+		{"metrics:before_query", startTimer, cb.Query().Before("gorm:query").Register},
+		{"metrics:after_query", observe("query"), cb.Query().After("gorm:query").Register},
+		{"metrics:before_update", startTimer, cb.Update().Before("gorm:update").Register},
+		{"metrics:after_update", observe("update"), cb.Update().After("gorm:update").Register},
+		{"metrics:before_delete", startTimer, cb.Delete().Before("gorm:delete").Register},
+		{"metrics:after_delete", observe("delete"), cb.Delete().After("gorm:delete").Register},
+		{"metrics:before_row", startTimer, cb.Row().Before("gorm:row").Register},
+		{"metrics:after_row", observe("row"), cb.Row().After("gorm:row").Register},
+		{"metrics:before_raw", startTimer, cb.Raw().Before("gorm:raw").Register},
+		{"metrics:after_raw", observe("raw"), cb.Raw().After("gorm:raw").Register},
+	}
+
+	for _, r := range registrations {
+		if err := r.reg(r.name, r.fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func startTimer(tx *gorm.DB) {
+	tx.InstanceSet(queryStartKey, time.Now())
+}
+
+func observe(operation string) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		value, ok := tx.InstanceGet(queryStartKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		table := tx.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+		dbQueryDuration.WithLabelValues(operation, table).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"gorm.io/gorm"
+)
+
+const namespace = "lunch_app"
+
+// Registry 애플리케이션 전용 Prometheus 레지스트리
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Total number of HTTP requests by method, route and status code.",
+		},
+		[]string{"method", "route", "status"},
+	)
+
+	httpRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method and route.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "route"},
+	)
+
+	dbQueryDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "GORM query latency by operation and table.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		},
+		[]string{"operation", "table"},
+	)
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestsTotal,
+		httpRequestDuration,
+		dbQueryDuration,
+	)
+}
+
+// Handler Prometheus 텍스트 포맷으로 메트릭을 노출하는 핸들러
+func Handler() gin.HandlerFunc {
+	return gin.WrapH(promhttp.HandlerFor(Registry, promhttp.HandlerOpts{}))
+}
+
+// Middleware 라우트별 요청 수, 지연 시간, 상태 코드를 기록하는 미들웨어
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		// 등록되지 않은 경로는 라벨 폭증을 막기 위해 하나로 묶음
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		method := c.Request.Method
+
+		httpRequestsTotal.WithLabelValues(method, route, strconv.Itoa(c.Writer.Status())).Inc()
+		httpRequestDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+	}
+}
+
+// RegisterBusinessGauges 맛집 수, 오늘 방문 수 등 비즈니스 지표를 등록
+// 스크레이프 시점에 DB를 조회하므로 별도의 갱신 작업이 필요 없음
+func RegisterBusinessGauges(db *gorm.DB) {
+	koreaLocation, _ := time.LoadLocation("Asia/Seoul")
+
+	Registry.MustRegister(
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "restaurants",
+				Help:      "Number of restaurants that are not soft-deleted.",
+			},
+			func() float64 {
+				var count int64
+				db.Table("restaurants").Where("deleted_at IS NULL").Count(&count)
+				return float64(count)
+			},
+		),
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "visits_today",
+				Help:      "Number of visits recorded for today in Asia/Seoul.",
+			},
+			func() float64 {
+				now := time.Now().In(koreaLocation)
+				startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, koreaLocation)
+				var count int64
+				db.Table("visits").
+					Where("deleted_at IS NULL").
+					Where("visit_date >= ? AND visit_date < ?", startOfDay, startOfDay.AddDate(0, 0, 1)).
+					Count(&count)
+				return float64(count)
+			},
+		),
+	)
+}
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware_RecordsRouteAndStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/metrics", Handler())
+	router.GET("/api/restaurants/:id", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Restaurant not found"})
+	})
+
+	req, _ := http.NewRequest("GET", "/api/restaurants/42", nil)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	req, _ = http.NewRequest("GET", "/metrics", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+	// 경로 파라미터가 아닌 라우트 패턴으로 집계되어야 함
+	assert.Contains(t, body, `lunch_app_http_requests_total{method="GET",route="/api/restaurants/:id",status="404"} 1`)
+	assert.Contains(t, body, `lunch_app_http_request_duration_seconds_bucket{method="GET",route="/api/restaurants/:id"`)
+	assert.NotContains(t, body, `route="/api/restaurants/42"`)
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"lunch_app/backend/internal/audit"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ActorHeader 클라이언트가 사용자 이름을 알려주는 헤더 (로그인 기능 도입 전까지 감사 로그 행위자로 사용)
+const ActorHeader = "X-Actor"
+
+// anonymousActor X-Actor 헤더가 없는 요청의 행위자
+const anonymousActor = "anonymous"
+
+// maxActorLength 행위자 이름 최대 길이 (audit_logs.actor 컬럼 크기)
+const maxActorLength = 64
+
+// Actor 감사 로그에 남길 행위자(X-Actor 헤더, 클라이언트 IP)를 요청 컨텍스트에 설정
+func Actor() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := strings.TrimSpace(c.GetHeader(ActorHeader))
+		if name == "" || len(name) > maxActorLength {
+			name = anonymousActor
+		}
+		setActor(c, name)
+		c.Next()
+	}
+}
+
+func setActor(c *gin.Context, name string) {
+	actor := audit.Actor{Name: name, IP: c.ClientIP()}
+	c.Request = c.Request.WithContext(audit.WithActor(c.Request.Context(), actor))
+}
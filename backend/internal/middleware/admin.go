@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/i18n"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// adminActor 관리자 토큰으로 인증된 요청의 행위자
+const adminActor = "admin"
+
+// AdminAuth 관리자 API 보호 미들웨어
+// ADMIN_TOKEN 환경변수와 같은 값을 Authorization: Bearer <token> 헤더로 보내야 함
+// ADMIN_TOKEN이 설정되지 않으면 관리자 API 전체가 비활성화됨
+// 인증된 요청의 감사 로그 행위자는 adminActor로 기록
+func AdminAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		expected := os.Getenv("ADMIN_TOKEN")
+		if expected == "" {
+			apierror.Abort(c, http.StatusForbidden, apierror.CodeAdminDisabled, i18n.Message(c, i18n.AdminDisabled))
+			return
+		}
+
+		token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+			apierror.Abort(c, http.StatusUnauthorized, apierror.CodeUnauthorized, i18n.Message(c, i18n.AdminUnauthorized))
+			return
+		}
+
+		setActor(c, adminActor)
+		c.Next()
+	}
+}
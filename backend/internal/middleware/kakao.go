@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/kakao"
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+)
+
+// KakaoSkill 카카오 i 오픈빌더 스킬 요청 인증 미들웨어
+// 오픈빌더는 요청에 서명하지 않으므로 스킬 설정의 헤더에 KAKAO_SKILL_TOKEN 값을 X-Kakao-Skill-Token으로 넣어 둠
+// KAKAO_SKILL_TOKEN이 설정되지 않으면 카카오톡 챗봇 연동 전체가 비활성화됨
+func KakaoSkill() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		expected := os.Getenv("KAKAO_SKILL_TOKEN")
+		if expected == "" {
+			apierror.Abort(c, http.StatusForbidden, apierror.CodeKakaoDisabled, i18n.Message(c, i18n.KakaoDisabled))
+			return
+		}
+
+		token := c.GetHeader(kakao.TokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+			apierror.Abort(c, http.StatusUnauthorized, apierror.CodeUnauthorized, i18n.Message(c, i18n.KakaoUnauthorized))
+			return
+		}
+		c.Next()
+	}
+}
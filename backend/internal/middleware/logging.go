@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"log/slog"
+	"lunch_app/backend/internal/logger"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Logger gin 기본 텍스트 로거 대신 요청 단위 구조화 로그를 남기는 미들웨어
+// RequestID 미들웨어 뒤에 등록해야 request_id가 함께 기록됨
+func Logger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		attrs := []any{
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"route", c.FullPath(),
+			"status", status,
+			"duration_ms", float64(time.Since(start).Microseconds()) / 1000,
+			"client_ip", c.ClientIP(),
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, "errors", c.Errors.String())
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= 500:
+			level = slog.LevelError
+		case status >= 400:
+			level = slog.LevelWarn
+		}
+
+		ctx := c.Request.Context()
+		logger.FromContext(ctx).Log(ctx, level, "request", attrs...)
+	}
+}
@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"io"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/logger"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Recovery 패닉을 구조화 로그로 남기고 요청 ID가 포함된 500 응답을 반환
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(c *gin.Context, recovered any) {
+		ctx := c.Request.Context()
+		logger.FromContext(ctx).ErrorContext(ctx, "panic recovered", "panic", recovered, "path", c.Request.URL.Path)
+		apierror.Abort(c, http.StatusInternalServerError, apierror.CodeInternal, i18n.Message(c, i18n.InternalError))
+	})
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"lunch_app/backend/internal/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader 요청 ID를 주고받는 헤더
+const RequestIDHeader = "X-Request-ID"
+
+// RequestIDKey gin.Context에 요청 ID를 저장하는 키
+const RequestIDKey = "request_id"
+
+// maxRequestIDLength 클라이언트가 보낸 요청 ID의 최대 길이
+const maxRequestIDLength = 128
+
+// RequestID X-Request-ID 헤더를 받거나 새로 생성해 컨텍스트와 응답 헤더에 설정
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = newRequestID()
+		}
+
+		c.Set(RequestIDKey, requestID)
+		c.Request = c.Request.WithContext(logger.WithRequestID(c.Request.Context(), requestID))
+		c.Header(RequestIDHeader, requestID)
+
+		c.Next()
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
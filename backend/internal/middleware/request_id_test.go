@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"lunch_app/backend/internal/logger"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/ping", func(c *gin.Context) {
+		c.String(http.StatusOK, logger.RequestID(c.Request.Context()))
+	})
+	return router
+}
+
+func TestRequestID_UsesIncomingHeader(t *testing.T) {
+	router := setupRouter()
+
+	req, _ := http.NewRequest("GET", "/ping", nil)
+	req.Header.Set(RequestIDHeader, "test-request-id")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, "test-request-id", w.Header().Get(RequestIDHeader))
+	assert.Equal(t, "test-request-id", w.Body.String())
+}
+
+func TestRequestID_GeneratesWhenMissing(t *testing.T) {
+	router := setupRouter()
+
+	req, _ := http.NewRequest("GET", "/ping", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	requestID := w.Header().Get(RequestIDHeader)
+	assert.Len(t, requestID, 32)
+	assert.Equal(t, requestID, w.Body.String())
+}
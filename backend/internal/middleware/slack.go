@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/slack"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxSlackBodyBytes Slack 요청 본문 최대 크기
+const maxSlackBodyBytes = 64 << 10
+
+// SlackSignature Slack 슬래시 커맨드·인터랙션 요청 서명 검증 미들웨어
+// SLACK_SIGNING_SECRET 환경변수로 X-Slack-Signature를 검증하고, 5분보다 오래된 요청은 거부
+// SLACK_SIGNING_SECRET이 설정되지 않으면 Slack 연동 전체가 비활성화됨
+// 검증한 본문은 다음 핸들러가 다시 읽을 수 있도록 되돌려 놓음
+func SlackSignature() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		secret := os.Getenv("SLACK_SIGNING_SECRET")
+		if secret == "" {
+			apierror.Abort(c, http.StatusForbidden, apierror.CodeSlackDisabled, i18n.Message(c, i18n.SlackDisabled))
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxSlackBodyBytes))
+		if err != nil {
+			apierror.Abort(c, http.StatusBadRequest, apierror.CodeInvalidRequestBody, i18n.Message(c, i18n.SlackInvalidPayload))
+			return
+		}
+		timestamp := c.GetHeader(slack.TimestampHeader)
+		signature := c.GetHeader(slack.SignatureHeader)
+		if err := slack.Verify(secret, timestamp, signature, body, time.Now()); err != nil {
+			apierror.Abort(c, http.StatusUnauthorized, apierror.CodeUnauthorized, i18n.Message(c, i18n.SlackInvalidSignature))
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Next()
+	}
+}
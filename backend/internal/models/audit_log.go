@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// AuditLog 맛집·방문 기록·리뷰·북마크의 생성/수정/삭제 이력
+// Before, After, Changes는 JSON 문자열 (없으면 빈 문자열)
+type AuditLog struct {
+	ID         uint      `gorm:"primarykey"`
+	CreatedAt  time.Time `gorm:"index"`
+	Action     string    `gorm:"size:16;index"`
+	EntityType string    `gorm:"size:32;index:idx_audit_logs_entity"`
+	EntityID   uint      `gorm:"index:idx_audit_logs_entity"`
+	Actor      string    `gorm:"size:64;index"`
+	RemoteIP   string    `gorm:"size:64"`
+	RequestID  string    `gorm:"size:128;index"`
+	Before     string    `gorm:"type:text"`
+	After      string    `gorm:"type:text"`
+	Changes    string    `gorm:"type:text"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// DigestSubscription 아침 점심 다이제스트 메일 구독
+// 같은 이메일로 다시 구독하면 이름·팀만 바뀌고 구독이 다시 켜짐
+type DigestSubscription struct {
+	ID    uint   `gorm:"primarykey"`
+	Email string `gorm:"size:254;uniqueIndex"`
+	// Name 메일 인사말과 "오래 안 간 곳" 계산에 쓰는 방문자 이름 (models.Visit.Visitor)
+	Name string `gorm:"size:64"`
+	// Team 진행 중인 점심 투표를 보여 줄 팀
+	Team string `gorm:"size:64"`
+	// Token 메일의 구독 해지 링크 토큰
+	Token  string `gorm:"size:64;uniqueIndex"`
+	Active bool   `gorm:"index"`
+	// LastSentOn 마지막으로 보낸 점심 날짜 (Asia/Seoul 자정, 하루에 한 번만 보냄)
+	LastSentOn *time.Time
+	// LastError 마지막 발송 실패 사유 (성공하면 비움)
+	LastError string `gorm:"size:512"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// Draw 서버에서 추첨한 점심 룰렛 결과
+// 시드·후보·가중치를 함께 저장하므로 누구나 같은 알고리즘으로 다시 계산해 결과를 검증할 수 있음
+type Draw struct {
+	ID   uint   `gorm:"primarykey"`
+	Team string `gorm:"size:64;uniqueIndex:idx_draws_team_date_sequence"`
+	// Date 점심 날짜 (Asia/Seoul 자정)
+	Date time.Time `gorm:"uniqueIndex:idx_draws_team_date_sequence"`
+	// Sequence 그 팀의 그날 몇 번째 추첨인지 (1이 첫 추첨, 2부터 다시 뽑기)
+	Sequence int `gorm:"uniqueIndex:idx_draws_team_date_sequence"`
+	// Seed 난수 시드 (32바이트 hex)
+	Seed string `gorm:"size:64"`
+	// Algorithm 추첨 알고리즘 버전 (검증 시 같은 알고리즘으로 재계산)
+	Algorithm string `gorm:"size:32"`
+	// DrawnBy 추첨한 사람 (X-Actor)
+	DrawnBy string `gorm:"size:64"`
+	// ResultPosition 당첨 후보의 Position
+	ResultPosition int
+	CreatedAt      time.Time
+	Candidates     []DrawCandidate
+}
+
+// DrawCandidate 추첨 후보와 가중치
+// 추첨 후 맛집이 삭제·수정되어도 기록이 바뀌지 않도록 맛집 정보를 복사해서 저장
+type DrawCandidate struct {
+	ID     uint `gorm:"primarykey"`
+	DrawID uint `gorm:"index"`
+	// Position 추첨 순서 (0부터, 가중치 구간을 이 순서로 나눔)
+	Position           int
+	RestaurantID       uint               `gorm:"index"`
+	RestaurantSnapshot RestaurantSnapshot `gorm:"embedded;embeddedPrefix:restaurant_"`
+	Weight             int
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// OpeningHours 맛집의 요일별 영업시간 (요일마다 한 줄, 줄이 없는 요일은 정기 휴무)
+// 시각은 Asia/Seoul 벽시계 "HH:MM"
+type OpeningHours struct {
+	ID           uint `gorm:"primarykey"`
+	RestaurantID uint `gorm:"uniqueIndex:idx_opening_hours_restaurant_weekday"`
+	// Weekday 요일 (0 일요일 ~ 6 토요일)
+	Weekday int `gorm:"uniqueIndex:idx_opening_hours_restaurant_weekday"`
+	// Opens, Closes 영업 시작·종료 (Closes가 Opens보다 이르면 다음 날 새벽까지 영업, 자정 마감은 24:00)
+	Opens  string `gorm:"size:5"`
+	Closes string `gorm:"size:5"`
+	// BreakStart, BreakEnd 브레이크타임 (없으면 빈 문자열)
+	BreakStart string `gorm:"size:5"`
+	BreakEnd   string `gorm:"size:5"`
+}
+
+// RestaurantHoliday 특정 날짜의 영업시간 예외 (명절 휴무, 임시 휴업, 단축 영업)
+// 그날은 요일별 영업시간 대신 이 설정을 씀
+type RestaurantHoliday struct {
+	ID           uint `gorm:"primarykey"`
+	RestaurantID uint `gorm:"uniqueIndex:idx_restaurant_holidays_restaurant_date"`
+	// Date 날짜 (Asia/Seoul 자정)
+	Date time.Time `gorm:"uniqueIndex:idx_restaurant_holidays_restaurant_date"`
+	// Closed 하루 종일 휴무 (false면 Opens~Closes만 영업)
+	Closed     bool
+	Opens      string `gorm:"size:5"`
+	Closes     string `gorm:"size:5"`
+	BreakStart string `gorm:"size:5"`
+	BreakEnd   string `gorm:"size:5"`
+	// Note 사유 (예: 추석 연휴)
+	Note      string `gorm:"size:128"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
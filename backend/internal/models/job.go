@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// 예약 작업 실행 상태
+const (
+	// JobRunRunning 실행 중
+	JobRunRunning = "running"
+	// JobRunSucceeded 에러 없이 끝남
+	JobRunSucceeded = "succeeded"
+	// JobRunFailed 에러를 반환했거나 실행 중 인스턴스가 멈춰 잠금이 만료됨
+	JobRunFailed = "failed"
+)
+
+// 예약 작업 실행 계기
+const (
+	// JobTriggerSchedule cron 일정에 따라 실행
+	JobTriggerSchedule = "schedule"
+	// JobTriggerManual 관리자 API로 실행
+	JobTriggerManual = "manual"
+)
+
+// JobLease 예약 작업의 다음 실행 시각과 인스턴스 간 실행 잠금 (작업마다 한 행)
+// 조건부 UPDATE로 잠금을 잡은 인스턴스만 작업을 실행하므로 여러 인스턴스에서도 한 번만 실행됨
+type JobLease struct {
+	Name string `gorm:"primaryKey;size:64"`
+	// Schedule NextRunAt을 계산한 cron 표현식 (바뀌면 다음 실행 시각을 다시 계산)
+	Schedule string `gorm:"size:128"`
+	// NextRunAt 다음 예약 실행 시각 (예약 실행하지 않는 작업이면 nil)
+	NextRunAt *time.Time
+	// Owner 마지막으로 잠금을 잡은 인스턴스
+	Owner string `gorm:"size:128"`
+	// LockedUntil 잠금 만료 시각 (실행 중이 아니면 nil)
+	LockedUntil *time.Time
+	UpdatedAt   time.Time
+}
+
+// JobRun 예약 작업 실행 기록
+type JobRun struct {
+	ID      uint   `gorm:"primarykey"`
+	Job     string `gorm:"size:64;index"`
+	Trigger string `gorm:"size:16"`
+	Status  string `gorm:"size:16"`
+	// Owner 작업을 실행한 인스턴스
+	Owner string `gorm:"size:128"`
+	// Summary 작업이 남긴 결과 요약 (예: "closed 2")
+	Summary    string `gorm:"size:512"`
+	Error      string `gorm:"size:1024"`
+	StartedAt  time.Time
+	FinishedAt *time.Time
+}
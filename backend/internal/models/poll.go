@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+// 투표 상태
+const (
+	PollStatusOpen   = "open"
+	PollStatusClosed = "closed"
+)
+
+// 투표 후보 출처
+const (
+	PollCandidateManual      = "manual"
+	PollCandidateRecommended = "recommended"
+)
+
+// DefaultTeam 팀을 지정하지 않은 요청의 팀
+const DefaultTeam = "default"
+
+// Poll 팀 점심 투표
+// 마감 시각이 지나면 닫히고, 가장 많이 득표한 맛집으로 투표자 전원의 방문 기록을 남김
+type Poll struct {
+	ID    uint   `gorm:"primarykey"`
+	Team  string `gorm:"size:64;index:idx_polls_team_date"`
+	Title string
+	// Date 점심 날짜 (Asia/Seoul 자정)
+	Date     time.Time `gorm:"index:idx_polls_team_date"`
+	Deadline time.Time `gorm:"index"`
+	Status   string    `gorm:"size:16;index"`
+	// CreatedBy 투표를 만든 사람 (X-Actor)
+	CreatedBy string `gorm:"size:64"`
+	// WinnerCandidateID 마감 후 선정된 후보 (표가 없으면 nil)
+	WinnerCandidateID *uint
+	ClosedAt          *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	Candidates        []PollCandidate
+	Votes             []PollVote
+}
+
+// PollCandidate 투표 후보 맛집
+// 투표 중에 맛집이 삭제·수정되어도 후보 목록이 바뀌지 않도록 맛집 정보를 복사해서 저장
+type PollCandidate struct {
+	ID                 uint               `gorm:"primarykey"`
+	PollID             uint               `gorm:"index"`
+	RestaurantID       uint               `gorm:"index"`
+	RestaurantSnapshot RestaurantSnapshot `gorm:"embedded;embeddedPrefix:restaurant_"`
+	// Source manual(직접 선택) 또는 recommended(추천으로 자동 추가)
+	Source    string `gorm:"size:16"`
+	CreatedAt time.Time
+}
+
+// PollVote 투표자 한 명의 표 (다시 투표하면 후보만 바뀜)
+type PollVote struct {
+	ID          uint   `gorm:"primarykey"`
+	PollID      uint   `gorm:"uniqueIndex:idx_poll_votes_voter"`
+	Voter       string `gorm:"size:64;uniqueIndex:idx_poll_votes_voter"`
+	CandidateID uint   `gorm:"index"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -11,6 +11,42 @@ type Restaurant struct {
 	Category  string
 	Latitude  float64
 	Longitude float64
-	Reviews   []Review
-	Bookmarks []Bookmark
+	// ExternalSource, ExternalID 지도 서비스의 장소 ID (예: kakao / 카카오 검색 결과의 id)
+	ExternalSource string `gorm:"size:16"`
+	ExternalID     string `gorm:"size:64"`
+	Reviews        []Review
+	Bookmarks      []Bookmark
+}
+
+// 외부 장소 출처
+const (
+	ExternalSourceKakao = "kakao"
+	ExternalSourceNaver = "naver"
+)
+
+// IsExternalSource 지원하는 외부 장소 출처인지 확인
+func IsExternalSource(source string) bool {
+	return source == ExternalSourceKakao || source == ExternalSourceNaver
+}
+
+// Snapshot 방문 기록에 저장할 현재 맛집 정보
+func (r Restaurant) Snapshot() RestaurantSnapshot {
+	return RestaurantSnapshot{
+		Name:      r.Name,
+		Address:   r.Address,
+		Category:  r.Category,
+		Latitude:  r.Latitude,
+		Longitude: r.Longitude,
+	}
+}
+
+// SameRestaurant 이름·주소 또는 외부 장소 ID가 같은 맛집을 찾는 스코프 (중복 등록·복원 충돌 검사용)
+func SameRestaurant(r Restaurant) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if r.ExternalID == "" {
+			return db.Where("name = ? AND address = ?", r.Name, r.Address)
+		}
+		return db.Where("(name = ? AND address = ?) OR (external_source = ? AND external_id = ?)",
+			r.Name, r.Address, r.ExternalSource, r.ExternalID)
+	}
 }
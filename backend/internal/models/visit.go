@@ -7,11 +7,26 @@ import (
 )
 
 type Visit struct {
-	ID           uint           `json:"ID" gorm:"primarykey"`
-	RestaurantID uint           `json:"RestaurantID"`
-	Restaurant   Restaurant     `json:"Restaurant" gorm:"foreignKey:RestaurantID;constraint:OnDelete:SET NULL"`
-	VisitDate    time.Time      `json:"VisitDate"`
-	CreatedAt    time.Time      `json:"CreatedAt"`
-	UpdatedAt    time.Time      `json:"UpdatedAt"`
-	DeletedAt    gorm.DeletedAt `json:"DeletedAt" gorm:"index"`
+	ID                 uint               `json:"ID" gorm:"primarykey"`
+	RestaurantID       uint               `json:"RestaurantID"`
+	Restaurant         Restaurant         `json:"Restaurant" gorm:"foreignKey:RestaurantID;constraint:OnDelete:SET NULL"`
+	RestaurantSnapshot RestaurantSnapshot `json:"RestaurantSnapshot" gorm:"embedded;embeddedPrefix:restaurant_"`
+	VisitDate          time.Time          `json:"VisitDate"`
+	CreatedAt          time.Time          `json:"CreatedAt"`
+	UpdatedAt          time.Time          `json:"UpdatedAt"`
+	DeletedAt          gorm.DeletedAt     `json:"DeletedAt" gorm:"index"`
+	// Visitor 방문한 사람 (점심 투표로 기록된 방문은 투표자, 직접 기록한 방문은 빈 문자열)
+	Visitor string `json:"Visitor,omitempty" gorm:"size:64"`
+	// PollID 점심 투표 결과로 기록된 방문이면 투표 ID
+	PollID *uint `json:"PollID,omitempty" gorm:"index"`
+}
+
+// RestaurantSnapshot 방문 시점의 맛집 정보
+// 맛집이 삭제되거나 이름이 바뀌어도 방문 기록에는 실제로 방문한 곳이 남도록 복사해서 저장
+type RestaurantSnapshot struct {
+	Name      string  `json:"Name"`
+	Address   string  `json:"Address"`
+	Category  string  `json:"Category"`
+	Latitude  float64 `json:"Latitude"`
+	Longitude float64 `json:"Longitude"`
 }
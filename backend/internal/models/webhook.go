@@ -0,0 +1,80 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// 웹훅 배달 상태
+const (
+	// WebhookDeliveryPending 보내기 전이거나 재시도 대기 중
+	WebhookDeliveryPending = "pending"
+	// WebhookDeliverySucceeded 2xx 응답을 받음
+	WebhookDeliverySucceeded = "succeeded"
+	// WebhookDeliveryFailed 재시도 횟수를 모두 썼거나 웹훅이 비활성화됨
+	WebhookDeliveryFailed = "failed"
+)
+
+// Webhook 팀의 외부 시스템 알림 구독
+// 팀 이벤트와 팀이 없는 이벤트(맛집·방문 기록) 중 EventTypes에 있는 이벤트를 URL로 보냄
+type Webhook struct {
+	ID   uint   `gorm:"primarykey"`
+	Team string `gorm:"size:64;index"`
+	URL  string `gorm:"size:2048"`
+	// Secret 요청 본문 HMAC-SHA256 서명 키 (생성 응답에서만 보여 줌)
+	Secret string `gorm:"size:128"`
+	// EventTypes 받을 이벤트 종류 (쉼표로 구분, 예: visit.created,poll.closed)
+	EventTypes string `gorm:"size:512"`
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Events 받을 이벤트 종류 목록
+func (w Webhook) Events() []string {
+	if w.EventTypes == "" {
+		return []string{}
+	}
+	return strings.Split(w.EventTypes, ",")
+}
+
+// SetEvents 받을 이벤트 종류 저장
+func (w *Webhook) SetEvents(eventTypes []string) {
+	w.EventTypes = strings.Join(eventTypes, ",")
+}
+
+// Subscribes team 이벤트(빈 문자열이면 모든 팀 이벤트) eventType을 받는지 여부
+func (w Webhook) Subscribes(team, eventType string) bool {
+	if !w.Active || (team != "" && team != w.Team) {
+		return false
+	}
+	for _, subscribed := range w.Events() {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery 웹훅 배달 기록 (재시도해도 같은 본문을 보냄)
+type WebhookDelivery struct {
+	ID        uint   `gorm:"primarykey"`
+	WebhookID uint   `gorm:"index"`
+	EventType string `gorm:"size:32"`
+	// Payload 보낸 JSON 본문
+	Payload string
+	Status  string `gorm:"size:16;index:idx_webhook_deliveries_status_next"`
+	// Attempts 보낸 횟수 (보내기 직전에 올려서 여러 워커가 같은 배달을 보내지 않게 함)
+	Attempts      int
+	NextAttemptAt time.Time `gorm:"index:idx_webhook_deliveries_status_next"`
+	LastAttemptAt *time.Time
+	// ResponseStatus 마지막 시도의 HTTP 상태 코드 (연결 실패 등으로 응답이 없으면 0)
+	ResponseStatus int
+	// LastError 마지막 실패 사유
+	LastError   string `gorm:"size:512"`
+	DeliveredAt *time.Time
+	// RedeliveryOf 수동 재전송이면 원래 배달 ID
+	RedeliveryOf *uint
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -0,0 +1,44 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	specOnce sync.Once
+	specJSON []byte
+)
+
+// Handler OpenAPI 문서를 JSON으로 반환 (최초 요청 시 한 번만 생성)
+func Handler(c *gin.Context) {
+	specOnce.Do(func() {
+		specJSON, _ = json.Marshal(Build())
+	})
+	c.Data(http.StatusOK, "application/json; charset=utf-8", specJSON)
+}
+
+// SwaggerUI /api/openapi.json을 보여주는 Swagger UI 페이지
+func SwaggerUI(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUIPage))
+}
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="ko">
+<head>
+  <meta charset="utf-8">
+  <title>Lunch App API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: "/api/openapi.json", dom_id: "#swagger-ui" });
+  </script>
+</body>
+</html>
+`
@@ -0,0 +1,141 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	deletedAtType = reflect.TypeOf(gorm.DeletedAt{})
+	rawJSONType   = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemaRegistry Go 타입을 리플렉션으로 읽어 components/schemas에 등록
+type schemaRegistry struct {
+	schemas map[string]*Schema
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{schemas: map[string]*Schema{}}
+}
+
+// ref 값의 타입 스키마를 등록하고 참조 스키마 반환
+func (r *schemaRegistry) ref(v any) *Schema {
+	return r.schemaFor(reflect.TypeOf(v))
+}
+
+// arrayOf 값 타입의 배열 스키마 반환
+func (r *schemaRegistry) arrayOf(v any) *Schema {
+	return &Schema{Type: "array", Items: r.ref(v)}
+}
+
+func (r *schemaRegistry) schemaFor(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case deletedAtType:
+		return &Schema{Type: "string", Format: "date-time", Nullable: true}
+	case rawJSONType:
+		// 임의의 JSON 값 (감사 로그의 before/after 등)
+		return &Schema{Nullable: true}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := r.schemaFor(t.Elem())
+		if schema.Ref != "" {
+			return schema
+		}
+		schema.Nullable = true
+		return schema
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: r.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.objectSchema(t)
+		}
+		name := schemaName(t)
+		if _, ok := r.schemas[name]; !ok {
+			// 재귀 참조를 위해 먼저 자리를 잡아둔 뒤 채움
+			r.schemas[name] = &Schema{}
+			*r.schemas[name] = *r.objectSchema(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + name}
+	default:
+		return &Schema{}
+	}
+}
+
+// objectSchema 구조체 필드를 JSON 태그 기준으로 properties에 매핑
+// 임베디드 구조체(gorm.Model 등)의 필드는 encoding/json과 동일하게 펼쳐서 포함
+func (r *schemaRegistry) objectSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	r.addFields(schema, t)
+	return schema
+}
+
+func (r *schemaRegistry) addFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			r.addFields(schema, field.Type)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema.Properties[name] = r.schemaFor(field.Type)
+		if strings.Contains(field.Tag.Get("binding"), "required") && !strings.Contains(options, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// schemaName 패키지명을 포함한 스키마 이름
+// 예: models.Restaurant, 제네릭 dto.ListResponse[dto.VisitResponse] → dto.ListResponse-dto.VisitResponse
+func schemaName(t reflect.Type) string {
+	name := t.Name()
+	if base, args, ok := strings.Cut(name, "["); ok {
+		parts := strings.Split(strings.TrimSuffix(args, "]"), ",")
+		for i, part := range parts {
+			parts[i] = shortTypeName(part)
+		}
+		name = base + "-" + strings.Join(parts, "-")
+	}
+	return shortTypeName(t.PkgPath()) + "." + name
+}
+
+// shortTypeName 패키지 경로를 마지막 요소만 남김 (lunch_app/backend/internal/dto.X → dto.X)
+func shortTypeName(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
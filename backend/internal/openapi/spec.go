@@ -0,0 +1,799 @@
+package openapi
+
+import (
+	"lunch_app/backend/internal/apierror"
+	"lunch_app/backend/internal/draw"
+	"lunch_app/backend/internal/dto"
+	"lunch_app/backend/internal/handlers"
+	"lunch_app/backend/internal/kakao"
+	"lunch_app/backend/internal/lunchroom"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/slack"
+	"lunch_app/backend/internal/webhook"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Build routes.Setup에 등록된 모든 경로의 OpenAPI 3 문서 생성
+// 라우트를 추가하면 여기에도 오퍼레이션을 추가해야 함 (spec_test.go에서 누락 검사)
+// 요약·파라미터·응답 코드는 핸들러 godoc과 같아야 함 (spec_test.go에서 교차 검사)
+func Build() *Document {
+	b := newBuilder()
+
+	// Health & 운영
+	b.add("GET", "/health", &Operation{
+		Tags:        []string{"health"},
+		Summary:     "Health check",
+		Description: "Report service status for uptime monitoring",
+		OperationID: "healthCheck",
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(handlers.HealthResponse{})),
+	})
+	b.add("GET", "/metrics", &Operation{
+		Tags:        []string{"operations"},
+		Summary:     "Prometheus metrics",
+		Description: "Request counts/latency, GORM query timing and business gauges in Prometheus text format",
+		OperationID: "metrics",
+		Responses: map[string]*Response{
+			"200": {Description: "OK", Content: map[string]MediaType{"text/plain": {Schema: &Schema{Type: "string"}}}},
+		},
+	})
+	b.add("GET", "/api/openapi.json", &Operation{
+		Tags:        []string{"operations"},
+		Summary:     "OpenAPI document",
+		OperationID: "openAPISpec",
+		Responses:   b.responses(http.StatusOK, &Schema{Type: "object"}),
+	})
+	b.add("GET", "/api/docs", &Operation{
+		Tags:        []string{"operations"},
+		Summary:     "Swagger UI",
+		OperationID: "swaggerUI",
+		Responses: map[string]*Response{
+			"200": {Description: "OK", Content: map[string]MediaType{"text/html": {Schema: &Schema{Type: "string"}}}},
+		},
+	})
+
+	// Restaurants
+	b.add("GET", "/api/restaurants/", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Get all restaurants",
+		Description: "Get a list of all restaurants",
+		OperationID: "listRestaurants",
+		Parameters:  []Parameter{openAtParam()},
+		Responses:   b.responses(http.StatusOK, b.schemas.arrayOf(models.Restaurant{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/restaurants/{id}", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Get a restaurant by ID",
+		Description: "Get details of a specific restaurant by its ID",
+		OperationID: "getRestaurant",
+		Parameters:  []Parameter{pathID("Restaurant ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(models.Restaurant{}), http.StatusBadRequest, http.StatusNotFound),
+	})
+	b.add("POST", "/api/restaurants/", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Create a new restaurant",
+		Description: "Add a new restaurant to the database",
+		OperationID: "createRestaurant",
+		RequestBody: jsonBody(b.schemas.ref(models.Restaurant{})),
+		Responses:   b.responses(http.StatusCreated, b.schemas.ref(models.Restaurant{}), http.StatusBadRequest, http.StatusConflict, http.StatusInternalServerError),
+	})
+	b.add("DELETE", "/api/restaurants/{id}", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Delete a restaurant",
+		Description: "Soft-delete a restaurant (visit history is kept)",
+		OperationID: "deleteRestaurant",
+		Parameters:  []Parameter{pathID("Restaurant ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(handlers.MessageResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/restaurants/duplicates", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Find duplicate restaurants",
+		Description: "Pairs of restaurants within the radius whose normalized names are similar (spacing, punctuation and branch suffixes such as 본점 are ignored), nearest first. restaurant is the older record and the suggested one to keep.",
+		OperationID: "findDuplicateRestaurants",
+		Parameters: []Parameter{
+			{Name: "radius", In: "query", Description: "Radius in meters (default 30, max 200)", Schema: &Schema{Type: "number"}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.DuplicateCandidateResponse]{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/restaurants/external/{source}", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Find saved restaurants for external place IDs",
+		Description: "Batch lookup for map search results. Only places that are already saved are returned, so the client can mark them as saved.",
+		OperationID: "listRestaurantsByExternalIDs",
+		Parameters: []Parameter{
+			externalSourceParam(),
+			{Name: "ids", In: "query", Description: "Comma-separated place IDs (max 50)", Required: true, Schema: &Schema{Type: "string"}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.RestaurantResponse]{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/restaurants/external/{source}/{externalId}", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Get a restaurant by external place ID",
+		Description: "Look up the saved restaurant linked to a Kakao/Naver place ID",
+		OperationID: "getRestaurantByExternalID",
+		Parameters: []Parameter{
+			externalSourceParam(),
+			{Name: "externalId", In: "path", Description: "Place ID of the source", Required: true, Schema: &Schema{Type: "string"}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.RestaurantResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("POST", "/api/restaurants/{id}/merge", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Merge duplicate restaurants",
+		Description: "Move visits, reviews and bookmarks of duplicateIds onto this restaurant and soft-delete the duplicates in one transaction. Visit snapshots are kept as recorded.",
+		OperationID: "mergeRestaurants",
+		Parameters:  []Parameter{pathID("Restaurant ID to keep")},
+		RequestBody: jsonBody(b.schemas.ref(dto.MergeRestaurantsRequest{})),
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.MergeRestaurantsResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/restaurants/{id}/hours", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Get opening hours of a restaurant",
+		Description: "Weekly opening hours with break times and date overrides (holidays, special hours). Times are HH:MM in Asia/Seoul; a missing weekday is a regular closing day.",
+		OperationID: "getOpeningHours",
+		Parameters:  []Parameter{pathID("Restaurant ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.OpeningHoursResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("PUT", "/api/restaurants/{id}/hours", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Replace weekly opening hours of a restaurant",
+		Description: "Replace all weekly opening hours. Weekdays left out are regular closing days and an empty list removes the hours. closes earlier than opens means open past midnight; 24:00 closes at midnight.",
+		OperationID: "updateOpeningHours",
+		Parameters:  []Parameter{pathID("Restaurant ID")},
+		RequestBody: jsonBody(b.schemas.ref(dto.UpdateOpeningHoursRequest{})),
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.OpeningHoursResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	holiday := b.responses(http.StatusOK, b.schemas.ref(dto.HolidayResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError)
+	holiday["201"] = &Response{
+		Description: http.StatusText(http.StatusCreated),
+		Content:     map[string]MediaType{"application/json": {Schema: b.schemas.ref(dto.HolidayResponse{})}},
+	}
+	b.add("PUT", "/api/restaurants/{id}/holidays/{date}", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Set an opening hours override for a date",
+		Description: "Close the restaurant for the whole day (closed) or open only opens-closes on that date, instead of its weekly hours. Returns 201 when the override is new.",
+		OperationID: "setHoliday",
+		Parameters:  []Parameter{pathID("Restaurant ID"), holidayDateParam()},
+		RequestBody: jsonBody(b.schemas.ref(dto.HolidayRequest{})),
+		Responses:   holiday,
+	})
+	b.add("DELETE", "/api/restaurants/{id}/holidays/{date}", &Operation{
+		Tags:        []string{"restaurants"},
+		Summary:     "Remove an opening hours override",
+		Description: "The restaurant follows its weekly hours again on that date",
+		OperationID: "deleteHoliday",
+		Parameters:  []Parameter{pathID("Restaurant ID"), holidayDateParam()},
+		Responses:   b.noContent(http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+
+	// Visits
+	b.add("GET", "/api/visits/", &Operation{
+		Tags:        []string{"visits"},
+		Summary:     "Get all visits",
+		Description: "Get visit history, newest first, including visits to deleted restaurants",
+		OperationID: "listVisits",
+		Responses:   b.responses(http.StatusOK, b.schemas.arrayOf(handlers.VisitResponse{}), http.StatusInternalServerError),
+	})
+	b.add("POST", "/api/visits/", &Operation{
+		Tags:        []string{"visits"},
+		Summary:     "Record a visit",
+		Description: "Record a visit to a restaurant (stored in Asia/Seoul time)",
+		OperationID: "createVisit",
+		RequestBody: jsonBody(b.schemas.ref(handlers.CreateVisitRequest{})),
+		Responses:   b.responses(http.StatusCreated, b.schemas.ref(models.Visit{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("PUT", "/api/visits/{id}", &Operation{
+		Tags:        []string{"visits"},
+		Summary:     "Update a visit",
+		Description: "Change the date of a visit record",
+		OperationID: "updateVisit",
+		Parameters:  []Parameter{pathID("Visit ID")},
+		RequestBody: jsonBody(b.schemas.ref(handlers.UpdateVisitRequest{})),
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(handlers.UpdateVisitResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("DELETE", "/api/visits/{id}", &Operation{
+		Tags:        []string{"visits"},
+		Summary:     "Delete a visit",
+		Description: "Soft-delete a visit record",
+		OperationID: "deleteVisit",
+		Parameters:  []Parameter{pathID("Visit ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(handlers.MessageResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+
+	// Trash
+	b.add("GET", "/api/trash/restaurants", &Operation{
+		Tags:        []string{"trash"},
+		Summary:     "List deleted restaurants",
+		Description: "List soft-deleted restaurants, most recently deleted first",
+		OperationID: "listTrashedRestaurants",
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.TrashedRestaurantResponse]{}), http.StatusInternalServerError),
+	})
+	b.add("POST", "/api/trash/restaurants/{id}/restore", &Operation{
+		Tags:        []string{"trash"},
+		Summary:     "Restore a deleted restaurant",
+		Description: "Undo a soft delete and re-link visits whose restaurant reference was cleared. Fails with RESTORE_CONFLICT when an active restaurant has the same name and address.",
+		OperationID: "restoreRestaurant",
+		Parameters:  []Parameter{pathID("Restaurant ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.RestoreRestaurantResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError),
+	})
+	b.admin("DELETE", "/api/admin/trash/restaurants", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "Purge deleted restaurants (admin)",
+		Description: "Hard-delete restaurants that have been in the trash longer than the retention period. Visits keep their snapshot; bookmarks, reviews and opening hours are removed.",
+		OperationID: "purgeTrashedRestaurants",
+		Parameters: []Parameter{
+			{Name: "olderThanDays", In: "query", Description: "Retention in days (default TRASH_RETENTION_DAYS or 30)", Schema: &Schema{Type: "integer"}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.PurgeResponse{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+	b.admin("GET", "/api/audit", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "List audit logs (admin)",
+		Description: "Create/update/delete history of restaurants, visits, reviews and bookmarks, newest first. before/after hold the full row and changes only the columns whose value changed.",
+		OperationID: "listAuditLogs",
+		Parameters: []Parameter{
+			{Name: "entityType", In: "query", Description: "restaurants, visits, reviews or bookmarks", Schema: &Schema{Type: "string"}},
+			{Name: "entityId", In: "query", Description: "Entity ID", Schema: &Schema{Type: "integer"}},
+			{Name: "action", In: "query", Description: "create, update or delete", Schema: &Schema{Type: "string", Enum: []string{"create", "update", "delete"}}},
+			{Name: "actor", In: "query", Description: "Actor name (X-Actor header, admin or system:*)", Schema: &Schema{Type: "string"}},
+			{Name: "requestId", In: "query", Description: "Request ID", Schema: &Schema{Type: "string"}},
+			{Name: "from", In: "query", Description: "Start time, inclusive (RFC 3339)", Schema: &Schema{Type: "string", Format: "date-time"}},
+			{Name: "to", In: "query", Description: "End time, exclusive (RFC 3339)", Schema: &Schema{Type: "string", Format: "date-time"}},
+			{Name: "limit", In: "query", Description: "Page size (default 50, max 200)", Schema: &Schema{Type: "integer"}},
+			{Name: "offset", In: "query", Description: "Page offset", Schema: &Schema{Type: "integer"}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.AuditLogResponse]{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+
+	// 웹훅 (관리자 전용)
+	b.admin("GET", "/api/admin/webhooks", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "List webhooks (admin)",
+		Description: "Outgoing webhooks, oldest first. Secrets are not included.",
+		OperationID: "listWebhooks",
+		Parameters: []Parameter{
+			{Name: "team", In: "query", Description: "Team", Schema: &Schema{Type: "string"}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.WebhookResponse]{}), http.StatusInternalServerError),
+	})
+	b.admin("POST", "/api/admin/webhooks", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "Register a webhook (admin)",
+		Description: "Subscribe a team to change events (" + strings.Join(webhook.EventTypes, ", ") + "). Team events go only to the team's webhooks; restaurant and visit events go to every team. Each delivery is a JSON POST signed with " + webhook.SignatureHeader + "; failures are retried with exponential backoff. The secret is generated when omitted and returned only in this response.",
+		OperationID: "createWebhook",
+		RequestBody: jsonBody(b.schemas.ref(dto.CreateWebhookRequest{})),
+		Responses:   b.responses(http.StatusCreated, b.schemas.ref(dto.CreateWebhookResponse{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+	b.admin("GET", "/api/admin/webhooks/{id}", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "Get a webhook (admin)",
+		OperationID: "getWebhook",
+		Parameters:  []Parameter{pathID("Webhook ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.WebhookResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.admin("PUT", "/api/admin/webhooks/{id}", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "Update a webhook (admin)",
+		Description: "Change the URL, subscribed events or active flag. Omitted fields are kept; the secret cannot be changed.",
+		OperationID: "updateWebhook",
+		Parameters:  []Parameter{pathID("Webhook ID")},
+		RequestBody: jsonBody(b.schemas.ref(dto.UpdateWebhookRequest{})),
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.WebhookResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.admin("DELETE", "/api/admin/webhooks/{id}", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "Delete a webhook (admin)",
+		Description: "Delete the webhook and its delivery log. Pending deliveries are not sent.",
+		OperationID: "deleteWebhook",
+		Parameters:  []Parameter{pathID("Webhook ID")},
+		Responses:   b.noContent(http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.admin("GET", "/api/admin/webhooks/{id}/deliveries", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "List webhook deliveries (admin)",
+		Description: "Delivery log of a webhook, newest first, at most 50.",
+		OperationID: "listWebhookDeliveries",
+		Parameters: []Parameter{
+			pathID("Webhook ID"),
+			{Name: "status", In: "query", Description: "Delivery status", Schema: &Schema{Type: "string", Enum: []string{models.WebhookDeliveryPending, models.WebhookDeliverySucceeded, models.WebhookDeliveryFailed}}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.WebhookDeliveryResponse]{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.admin("POST", "/api/admin/webhooks/{id}/deliveries/{deliveryId}/redeliver", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "Redeliver a webhook delivery (admin)",
+		Description: "Queue a new delivery with the same payload as a finished one; the original log entry is kept. Fails with WEBHOOK_DELIVERY_PENDING while the delivery is still being retried.",
+		OperationID: "redeliverWebhook",
+		Parameters: []Parameter{
+			pathID("Webhook ID"),
+			{Name: "deliveryId", In: "path", Description: "Delivery ID", Required: true, Schema: &Schema{Type: "integer"}},
+		},
+		Responses: b.responses(http.StatusAccepted, b.schemas.ref(dto.WebhookDeliveryResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError),
+	})
+
+	b.admin("GET", "/api/admin/digest/subscriptions", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "List digest subscriptions (admin)",
+		Description: "Morning lunch digest subscribers, oldest first. Unsubscribe tokens are not included.",
+		OperationID: "listDigestSubscriptions",
+		Parameters: []Parameter{
+			{Name: "active", In: "query", Description: "Only active (true) or unsubscribed (false) subscriptions", Schema: &Schema{Type: "boolean"}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.DigestSubscriptionResponse]{}), http.StatusInternalServerError),
+	})
+	subscribe := b.responses(http.StatusCreated, b.schemas.ref(dto.DigestSubscriptionResponse{}), http.StatusBadRequest, http.StatusInternalServerError)
+	subscribe["200"] = &Response{Description: "Already subscribed; name and team updated and the subscription reactivated", Content: map[string]MediaType{"application/json": {Schema: b.schemas.ref(dto.DigestSubscriptionResponse{})}}}
+	b.admin("POST", "/api/admin/digest/subscriptions", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "Subscribe to the lunch digest (admin)",
+		Description: "Subscribe an email to the weekday morning digest (today's recommendations, the team's open poll and places the subscriber has not visited for 30 days). Sent at DIGEST_SEND_AT (Asia/Seoul) over SMTP.",
+		OperationID: "subscribeDigest",
+		RequestBody: jsonBody(b.schemas.ref(dto.SubscribeDigestRequest{})),
+		Responses:   subscribe,
+	})
+	b.admin("DELETE", "/api/admin/digest/subscriptions/{id}", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "Delete a digest subscription (admin)",
+		OperationID: "deleteDigestSubscription",
+		Parameters:  []Parameter{pathID("Subscription ID")},
+		Responses:   b.noContent(http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	preview := b.responses(http.StatusOK, nil, http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError)
+	preview["200"] = &Response{Description: "HTML digest", Content: map[string]MediaType{"text/html": {Schema: &Schema{Type: "string"}}}}
+	b.admin("GET", "/api/admin/digest/subscriptions/{id}/preview", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "Preview a digest (admin)",
+		Description: "Render the HTML digest the subscriber would get right now, without sending it.",
+		OperationID: "previewDigest",
+		Parameters:  []Parameter{pathID("Subscription ID")},
+		Responses:   preview,
+	})
+	b.admin("POST", "/api/admin/digest/subscriptions/{id}/send", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "Send a digest now (admin)",
+		Description: "Send today's digest to the subscriber immediately, even if it was already sent or the subscription is inactive; the daily schedule is not changed. 503 MAIL_DISABLED when SMTP_HOST is not set, 502 MAIL_SEND_FAILED when the SMTP server rejects the mail.",
+		OperationID: "sendDigest",
+		Parameters:  []Parameter{pathID("Subscription ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.SendDigestResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable),
+	})
+	jobName := Parameter{Name: "name", In: "path", Description: "Job name (retention, poll-close, digest)", Required: true, Schema: &Schema{Type: "string"}}
+	b.admin("GET", "/api/admin/jobs", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "List scheduled jobs (admin)",
+		Description: "Jobs run inside the API server with their cron schedule (JOB_<NAME>_SCHEDULE, evaluated in JOB_TIMEZONE, default Asia/Seoul), next run, the instance running them and the last run. A lease row per job makes each scheduled run happen on one instance only.",
+		OperationID: "listJobs",
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.JobResponse]{}), http.StatusInternalServerError),
+	})
+	b.admin("GET", "/api/admin/jobs/{name}/runs", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "List job runs (admin)",
+		Description: "Run history of a job, newest first. The latest JOB_HISTORY_LIMIT runs (default 100) are kept per job.",
+		OperationID: "listJobRuns",
+		Parameters: []Parameter{
+			jobName,
+			{Name: "limit", In: "query", Description: "Number of runs (default 20, max 100)", Schema: &Schema{Type: "integer"}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.JobRunResponse]{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.admin("POST", "/api/admin/jobs/{name}/run", &Operation{
+		Tags:        []string{"admin"},
+		Summary:     "Run a job now (admin)",
+		Description: "Start the job in the background without changing its schedule; also works for jobs whose schedule is off. 409 JOB_RUNNING while any instance holds the job's lease. Follow the result in the run history.",
+		OperationID: "runJob",
+		Parameters:  []Parameter{jobName},
+		Responses:   b.responses(http.StatusAccepted, b.schemas.ref(dto.JobRunResponse{}), http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError),
+	})
+	for _, method := range []string{"GET", "POST"} {
+		b.add(method, "/api/digest/unsubscribe", &Operation{
+			Tags:        []string{"digest"},
+			Summary:     "Unsubscribe from the lunch digest",
+			Description: "Unsubscribe link in the digest mail; POST is the one-click unsubscribe of mail apps (RFC 8058). Unsubscribing twice succeeds.",
+			OperationID: strings.ToLower(method) + "UnsubscribeDigest",
+			Parameters: []Parameter{
+				{Name: "token", In: "query", Description: "Unsubscribe token from the mail", Required: true, Schema: &Schema{Type: "string"}},
+			},
+			Responses: b.responses(http.StatusOK, b.schemas.ref(handlers.MessageResponse{}), http.StatusNotFound, http.StatusInternalServerError),
+		})
+	}
+
+	b.add("GET", "/api/polls", &Operation{
+		Tags:        []string{"polls"},
+		Summary:     "List lunch polls",
+		Description: "Newest first, at most 50.",
+		OperationID: "listPolls",
+		Parameters: []Parameter{
+			{Name: "team", In: "query", Description: "Team", Schema: &Schema{Type: "string"}},
+			{Name: "status", In: "query", Description: "Poll status", Schema: &Schema{Type: "string", Enum: []string{"open", "closed"}}},
+			{Name: "date", In: "query", Description: "Lunch date (YYYY-MM-DD, Asia/Seoul)", Schema: &Schema{Type: "string", Format: "date"}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.PollResponse]{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+	b.add("POST", "/api/polls", &Operation{
+		Tags:        []string{"polls"},
+		Summary:     "Create a lunch poll",
+		Description: "Candidates are restaurantIds in order, followed by autoSeed recommended restaurants (not visited in the last 7 days first). The deadline must be within 24 hours; X-Actor is recorded as the creator.",
+		OperationID: "createPoll",
+		Parameters:  []Parameter{actorParam("Poll creator")},
+		RequestBody: jsonBody(b.schemas.ref(dto.CreatePollRequest{})),
+		Responses:   b.responses(http.StatusCreated, b.schemas.ref(dto.PollResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/polls/{id}", &Operation{
+		Tags:        []string{"polls"},
+		Summary:     "Get a lunch poll",
+		Description: "Candidates with vote counts and voters.",
+		OperationID: "getPoll",
+		Parameters:  []Parameter{pathID("Poll ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.PollResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("POST", "/api/polls/{id}/votes", &Operation{
+		Tags:        []string{"polls"},
+		Summary:     "Vote in a lunch poll",
+		Description: "One vote per voter; voting again moves the vote. voter defaults to the X-Actor header. Fails with POLL_CLOSED after the deadline.",
+		OperationID: "votePoll",
+		Parameters:  []Parameter{pathID("Poll ID"), actorParam("Voter name when voter is omitted")},
+		RequestBody: jsonBody(b.schemas.ref(dto.VoteRequest{})),
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.PollResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError),
+	})
+	b.add("POST", "/api/polls/{id}/close", &Operation{
+		Tags:        []string{"polls"},
+		Summary:     "Close a lunch poll",
+		Description: "Close the poll now and record a visit at the winning restaurant for every voter. Ties go to the earlier candidate. Open polls are also closed automatically at their deadline.",
+		OperationID: "closePoll",
+		Parameters:  []Parameter{pathID("Poll ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.ClosePollResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError),
+	})
+
+	b.add("GET", "/api/draws", &Operation{
+		Tags:        []string{"draws"},
+		Summary:     "List lunch roulette draws",
+		Description: "Newest first, at most 50.",
+		OperationID: "listDraws",
+		Parameters: []Parameter{
+			{Name: "team", In: "query", Description: "Team", Schema: &Schema{Type: "string"}},
+			{Name: "date", In: "query", Description: "Lunch date (YYYY-MM-DD, Asia/Seoul)", Schema: &Schema{Type: "string", Format: "date"}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.DrawResponse]{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+	b.add("POST", "/api/draws", &Operation{
+		Tags:        []string{"draws"},
+		Summary:     "Draw a restaurant (lunch roulette)",
+		Description: "Weighted draw on the server (" + draw.Algorithm + "). Empty candidates draw from every restaurant with weight 1. The seed, candidates, weights and result are recorded; X-Actor is recorded as drawnBy. Each team gets one draw plus DRAW_REROLLS_PER_DAY re-rolls per day (Asia/Seoul), then DRAW_LIMIT_REACHED.",
+		OperationID: "createDraw",
+		Parameters:  []Parameter{actorParam("Who drew")},
+		RequestBody: jsonBody(b.schemas.ref(dto.CreateDrawRequest{})),
+		Responses:   b.responses(http.StatusCreated, b.schemas.ref(dto.CreateDrawResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusTooManyRequests, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/draws/{id}", &Operation{
+		Tags:        []string{"draws"},
+		Summary:     "Get a lunch roulette draw",
+		Description: "Draw with its seed, candidates, weights and result.",
+		OperationID: "getDraw",
+		Parameters:  []Parameter{pathID("Draw ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.DrawResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/draws/{id}/verify", &Operation{
+		Tags:        []string{"draws"},
+		Summary:     "Verify a lunch roulette draw",
+		Description: "Recompute the draw from its recorded seed, candidates and weights and compare with the recorded result.",
+		OperationID: "verifyDraw",
+		Parameters:  []Parameter{pathID("Draw ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.DrawVerificationResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+
+	events := b.responses(http.StatusOK, nil, http.StatusBadRequest)
+	events["200"].Content = map[string]MediaType{"text/event-stream": {Schema: &Schema{Type: "string"}}}
+	b.add("GET", "/api/events", &Operation{
+		Tags:        []string{"events"},
+		Summary:     "Stream change events (SSE)",
+		Description: "Server-Sent Events stream. Each message has id, event (restaurant.created|deleted|restored|merged, visit.created|updated|deleted, poll.created|voted|closed, draw.created) and the changed resource as JSON data. Restaurant and visit events go to every team, poll and draw events only to their team. Reconnect with Last-Event-ID to receive missed events; a reset event means they are no longer available and the client should reload. A ': ping' comment is sent every 25 seconds.",
+		OperationID: "streamEvents",
+		Parameters: []Parameter{
+			{Name: "team", In: "query", Description: "Team (default: default)", Schema: &Schema{Type: "string"}},
+			{Name: "Last-Event-ID", In: "header", Description: "Last received event ID (sent by EventSource on reconnect)", Schema: &Schema{Type: "string"}},
+			{Name: "lastEventId", In: "query", Description: "Last received event ID when the header cannot be set", Schema: &Schema{Type: "string"}},
+		},
+		Responses: events,
+	})
+
+	b.add("GET", "/api/rooms/{room}", &Operation{
+		Tags:        []string{"rooms"},
+		Summary:     "Get lunch room state",
+		Description: "Members currently connected and their votes. Empty when nobody is in the room.",
+		OperationID: "getRoom",
+		Parameters:  []Parameter{roomParam()},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(lunchroom.State{}), http.StatusBadRequest),
+	})
+	join := b.responses(http.StatusSwitchingProtocols, nil, http.StatusBadRequest)
+	join["101"] = &Response{Description: "WebSocket upgrade. Client messages: {\"type\":\"vote\",\"restaurantId\":1}, {\"type\":\"unvote\"}, {\"type\":\"spin\"}. Server messages: state (members and votes, sent on every change), spin (the same result for everyone, to be revealed at revealAt) and error (only to the sender)."}
+	b.add("GET", "/api/rooms/{room}/ws", &Operation{
+		Tags:        []string{"rooms"},
+		Summary:     "Join a lunch room (WebSocket)",
+		Description: "Join the room as name. Votes are weights for the server-side roulette; with no votes every restaurant is equally likely. A member's vote is removed when their last connection leaves.",
+		OperationID: "joinRoom",
+		Parameters: []Parameter{
+			roomParam(),
+			{Name: "name", In: "query", Description: "Member name (up to 64 characters)", Required: true, Schema: &Schema{Type: "string"}},
+		},
+		Responses: join,
+	})
+
+	b.add("GET", "/api/directions", &Operation{
+		Tags:        []string{"directions"},
+		Summary:     "Get walking or driving directions",
+		Description: "Road route from OSRM, cached by coordinates rounded to 4 decimal places. Falls back to a straight-line estimate (walking 67 m/min, driving 417 m/min) when OSRM is unavailable; source tells which one was used.",
+		OperationID: "getDirections",
+		Parameters: []Parameter{
+			{Name: "mode", In: "query", Description: "Travel mode (default walking)", Schema: &Schema{Type: "string", Enum: []string{"walking", "driving"}}},
+			{Name: "fromLat", In: "query", Description: "Origin latitude", Required: true, Schema: &Schema{Type: "number"}},
+			{Name: "fromLng", In: "query", Description: "Origin longitude", Required: true, Schema: &Schema{Type: "number"}},
+			{Name: "toLat", In: "query", Description: "Destination latitude", Required: true, Schema: &Schema{Type: "number"}},
+			{Name: "toLng", In: "query", Description: "Destination longitude", Required: true, Schema: &Schema{Type: "number"}},
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.DirectionsResponse{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+
+	slackResponses := b.responses(http.StatusOK, b.schemas.ref(slack.Message{}), http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden)
+	b.add("POST", "/api/slack/commands", &Operation{
+		Tags:        []string{"slack"},
+		Summary:     "Slack /lunch slash command",
+		Description: "Request URL of the /lunch slash command. Subcommands: (none) or recommend for three random restaurants, poll [minutes] [title] for a channel poll (default 30 minutes, team slack-<team_id>), log <name> to record a visit, anything else for help. Signed with SLACK_SIGNING_SECRET; 403 SLACK_DISABLED when it is not set. Command errors are returned as ephemeral messages with 200.",
+		OperationID: "slackCommand",
+		Parameters:  slackSignatureParams(),
+		RequestBody: formBody(&Schema{Type: "object", Properties: map[string]*Schema{
+			"command":   {Type: "string"},
+			"text":      {Type: "string"},
+			"team_id":   {Type: "string"},
+			"user_name": {Type: "string"},
+		}}),
+		Responses: slackResponses,
+	})
+	interactionResponses := b.responses(http.StatusOK, nil, http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden)
+	interactionResponses["200"] = &Response{Description: "Acknowledged (empty body)"}
+	b.add("POST", "/api/slack/interactions", &Operation{
+		Tags:        []string{"slack"},
+		Summary:     "Slack interactive message actions",
+		Description: "Interactivity Request URL. Handles block_actions for " + slack.ActionLogVisit + ", " + slack.ActionStartPoll + ", " + slack.ActionVote + " and " + slack.ActionClosePoll + ". Replies with 200 at once and posts the result to the payload's response_url (only https://hooks.slack.com/); poll messages are replaced in place.",
+		OperationID: "slackInteraction",
+		Parameters:  slackSignatureParams(),
+		RequestBody: formBody(&Schema{Type: "object", Properties: map[string]*Schema{
+			"payload": {Type: "string", Description: "block_actions payload JSON"},
+		}}),
+		Responses: interactionResponses,
+	})
+
+	b.add("POST", "/api/kakao/skill", &Operation{
+		Tags:        []string{"kakao"},
+		Summary:     "KakaoTalk chatbot skill",
+		Description: "Kakao i Open Builder skill endpoint. The intent is chosen by block name, then utterance: \"오늘 뭐 먹지\" recommends 5 restaurants, \"<location> 근처 맛집\" lists saved restaurants within 1 km of the location (action param location, found with the place searcher), \"방문 기록\" lists the 10 latest visits and \"방문 기록 <name>\" logs a visit (action param restaurant). Anything else gets help. Responds with skill response 2.0 (simpleText, basicCard, carousel); command errors are returned as simpleText with 200. Requires the X-Kakao-Skill-Token header set in the skill settings; 403 KAKAO_DISABLED when KAKAO_SKILL_TOKEN is not set.",
+		OperationID: "kakaoSkill",
+		Parameters: []Parameter{
+			{Name: kakao.TokenHeader, In: "header", Description: "KAKAO_SKILL_TOKEN", Required: true, Schema: &Schema{Type: "string"}},
+		},
+		RequestBody: jsonBody(b.schemas.ref(kakao.SkillRequest{})),
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(kakao.Response{}), http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden),
+	})
+
+	// v2 - camelCase DTO
+	b.add("GET", "/api/v2/restaurants", &Operation{
+		Tags:        []string{"restaurants-v2"},
+		Summary:     "List restaurants (v2)",
+		OperationID: "listRestaurantsV2",
+		Parameters:  []Parameter{openAtParam()},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.RestaurantResponse]{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/v2/restaurants/nearby", &Operation{
+		Tags:        []string{"restaurants-v2"},
+		Summary:     "List restaurants near a location (v2)",
+		Description: "Saved restaurants within the radius of lat/lng, nearest first",
+		OperationID: "nearbyRestaurantsV2",
+		Parameters: []Parameter{
+			{Name: "lat", In: "query", Description: "Latitude", Required: true, Schema: &Schema{Type: "number"}},
+			{Name: "lng", In: "query", Description: "Longitude", Required: true, Schema: &Schema{Type: "number"}},
+			{Name: "radius", In: "query", Description: "Radius in meters (default 1000, max 5000)", Schema: &Schema{Type: "number"}},
+			openAtParam(),
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.NearbyRestaurantResponse]{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/v2/restaurants/recommendations", &Operation{
+		Tags:        []string{"restaurants-v2"},
+		Summary:     "Recommend restaurants (v2)",
+		Description: "Random picks, preferring restaurants not visited in the last 7 days",
+		OperationID: "recommendRestaurantsV2",
+		Parameters: []Parameter{
+			{Name: "limit", In: "query", Description: "Number of restaurants (default 3, max 10)", Schema: &Schema{Type: "integer"}},
+			openAtParam(),
+		},
+		Responses: b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.RestaurantResponse]{}), http.StatusBadRequest, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/v2/restaurants/{id}", &Operation{
+		Tags:        []string{"restaurants-v2"},
+		Summary:     "Get a restaurant by ID (v2)",
+		OperationID: "getRestaurantV2",
+		Parameters:  []Parameter{pathID("Restaurant ID")},
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.RestaurantResponse{}), http.StatusBadRequest, http.StatusNotFound),
+	})
+	b.add("POST", "/api/v2/restaurants", &Operation{
+		Tags:        []string{"restaurants-v2"},
+		Summary:     "Create a restaurant (v2)",
+		OperationID: "createRestaurantV2",
+		RequestBody: jsonBody(b.schemas.ref(dto.CreateRestaurantRequest{})),
+		Responses:   b.responses(http.StatusCreated, b.schemas.ref(dto.RestaurantResponse{}), http.StatusBadRequest, http.StatusConflict, http.StatusInternalServerError),
+	})
+	b.add("DELETE", "/api/v2/restaurants/{id}", &Operation{
+		Tags:        []string{"restaurants-v2"},
+		Summary:     "Delete a restaurant (v2)",
+		OperationID: "deleteRestaurantV2",
+		Parameters:  []Parameter{pathID("Restaurant ID")},
+		Responses:   b.noContent(http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("GET", "/api/v2/visits", &Operation{
+		Tags:        []string{"visits-v2"},
+		Summary:     "List visits (v2)",
+		OperationID: "listVisitsV2",
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.ListResponse[dto.VisitResponse]{}), http.StatusInternalServerError),
+	})
+	b.add("POST", "/api/v2/visits", &Operation{
+		Tags:        []string{"visits-v2"},
+		Summary:     "Record a visit (v2)",
+		OperationID: "createVisitV2",
+		RequestBody: jsonBody(b.schemas.ref(dto.CreateVisitRequest{})),
+		Responses:   b.responses(http.StatusCreated, b.schemas.ref(dto.VisitResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("PUT", "/api/v2/visits/{id}", &Operation{
+		Tags:        []string{"visits-v2"},
+		Summary:     "Update a visit (v2)",
+		OperationID: "updateVisitV2",
+		Parameters:  []Parameter{pathID("Visit ID")},
+		RequestBody: jsonBody(b.schemas.ref(dto.UpdateVisitRequest{})),
+		Responses:   b.responses(http.StatusOK, b.schemas.ref(dto.VisitResponse{}), http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+	b.add("DELETE", "/api/v2/visits/{id}", &Operation{
+		Tags:        []string{"visits-v2"},
+		Summary:     "Delete a visit (v2)",
+		OperationID: "deleteVisitV2",
+		Parameters:  []Parameter{pathID("Visit ID")},
+		Responses:   b.noContent(http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError),
+	})
+
+	return b.document()
+}
+
+type builder struct {
+	paths   map[string]*PathItem
+	schemas *schemaRegistry
+}
+
+func newBuilder() *builder {
+	return &builder{paths: map[string]*PathItem{}, schemas: newSchemaRegistry()}
+}
+
+func (b *builder) add(method, path string, op *Operation) {
+	item, ok := b.paths[path]
+	if !ok {
+		item = &PathItem{}
+		b.paths[path] = item
+	}
+	slot := item.operation(method)
+	if slot == nil {
+		panic("openapi: unsupported method " + method)
+	}
+	if *slot != nil {
+		panic("openapi: duplicate operation " + method + " " + path)
+	}
+	*slot = op
+}
+
+// admin 관리자 인증이 필요한 오퍼레이션 등록 (401/403 응답 포함)
+func (b *builder) admin(method, path string, op *Operation) {
+	op.Security = []map[string][]string{{"adminToken": {}}}
+	for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden} {
+		op.Responses[strconv.Itoa(status)] = &Response{
+			Description: http.StatusText(status),
+			Content:     map[string]MediaType{"application/json": {Schema: b.schemas.ref(apierror.Response{})}},
+		}
+	}
+	b.add(method, path, op)
+}
+
+// responses 성공 응답과 공통 에러 응답(apierror.Response) 맵 생성
+func (b *builder) responses(status int, schema *Schema, errorStatuses ...int) map[string]*Response {
+	responses := map[string]*Response{
+		strconv.Itoa(status): {
+			Description: http.StatusText(status),
+			Content:     map[string]MediaType{"application/json": {Schema: schema}},
+		},
+	}
+	for _, errorStatus := range errorStatuses {
+		responses[strconv.Itoa(errorStatus)] = &Response{
+			Description: http.StatusText(errorStatus),
+			Content:     map[string]MediaType{"application/json": {Schema: b.schemas.ref(apierror.Response{})}},
+		}
+	}
+	return responses
+}
+
+// noContent 본문 없는 204 응답과 공통 에러 응답 맵 생성
+func (b *builder) noContent(errorStatuses ...int) map[string]*Response {
+	responses := b.responses(http.StatusNoContent, nil, errorStatuses...)
+	responses[strconv.Itoa(http.StatusNoContent)] = &Response{Description: http.StatusText(http.StatusNoContent)}
+	return responses
+}
+
+func (b *builder) document() *Document {
+	return &Document{
+		OpenAPI: "3.0.3",
+		Info: Info{
+			Title:       "Lunch App API",
+			Description: "점심 맛집 추천 및 방문 기록 API. 에러 응답은 모두 apierror.Response 형식을 따르며 메시지는 Accept-Language(ko/en)에 따라 반환됩니다.",
+			Version:     "1.0.0",
+		},
+		Tags: []Tag{
+			{Name: "restaurants", Description: "맛집 관리"},
+			{Name: "visits", Description: "방문 기록"},
+			{Name: "restaurants-v2", Description: "맛집 관리 (v2, camelCase DTO)"},
+			{Name: "visits-v2", Description: "방문 기록 (v2, camelCase DTO)"},
+			{Name: "polls", Description: "팀 점심 투표"},
+			{Name: "draws", Description: "점심 룰렛 (서버 추첨 기록과 검증)"},
+			{Name: "events", Description: "실시간 변경 이벤트 (SSE)"},
+			{Name: "rooms", Description: "점심 방 (WebSocket 실시간 투표·룰렛)"},
+			{Name: "directions", Description: "길찾기 (도보/차량 경로)"},
+			{Name: "slack", Description: "Slack /lunch 슬래시 커맨드 (SLACK_SIGNING_SECRET)"},
+			{Name: "kakao", Description: "카카오톡 챗봇 스킬 (KAKAO_SKILL_TOKEN)"},
+			{Name: "digest", Description: "아침 점심 다이제스트 메일 구독 해지"},
+			{Name: "health", Description: "헬스체크"},
+			{Name: "trash", Description: "휴지통 (삭제된 맛집 조회/복원)"},
+			{Name: "admin", Description: "관리자 전용 (ADMIN_TOKEN)"},
+			{Name: "operations", Description: "운영용 엔드포인트"},
+		},
+		Paths: b.paths,
+		Components: Components{
+			Schemas: b.schemas.schemas,
+			SecuritySchemes: map[string]*SecurityScheme{
+				"adminToken": {Type: "http", Scheme: "bearer", Description: "ADMIN_TOKEN 환경변수 값"},
+			},
+		},
+	}
+}
+
+func pathID(description string) Parameter {
+	return Parameter{Name: "id", In: "path", Description: description, Required: true, Schema: &Schema{Type: "integer"}}
+}
+
+func roomParam() Parameter {
+	return Parameter{Name: "room", In: "path", Description: "Room name (up to 64 characters)", Required: true, Schema: &Schema{Type: "string"}}
+}
+
+// openAtParam 영업 중인 맛집만 고르는 open_at 쿼리
+func openAtParam() Parameter {
+	return Parameter{Name: "open_at", In: "query", Description: "Only restaurants open at this time, judged by their opening hours in Asia/Seoul: now, RFC 3339 or YYYY-MM-DDTHH:MM (Asia/Seoul). Restaurants without opening hours are left out", Schema: &Schema{Type: "string"}}
+}
+
+// actorParam 요청한 사용자 이름을 담는 X-Actor 헤더
+func actorParam(description string) Parameter {
+	return Parameter{Name: "X-Actor", In: "header", Description: description, Schema: &Schema{Type: "string"}}
+}
+
+func holidayDateParam() Parameter {
+	return Parameter{Name: "date", In: "path", Description: "Date (YYYY-MM-DD, Asia/Seoul)", Required: true, Schema: &Schema{Type: "string", Format: "date"}}
+}
+
+func externalSourceParam() Parameter {
+	return Parameter{Name: "source", In: "path", Description: "External place source", Required: true, Schema: &Schema{Type: "string", Enum: []string{"kakao", "naver"}}}
+}
+
+// slackSignatureParams Slack 요청 서명 헤더
+func slackSignatureParams() []Parameter {
+	return []Parameter{
+		{Name: slack.TimestampHeader, In: "header", Description: "Request timestamp (rejected when older than 5 minutes)", Required: true, Schema: &Schema{Type: "string"}},
+		{Name: slack.SignatureHeader, In: "header", Description: "v0=HMAC-SHA256(SLACK_SIGNING_SECRET, v0:timestamp:body)", Required: true, Schema: &Schema{Type: "string"}},
+	}
+}
+
+func formBody(schema *Schema) *RequestBody {
+	return &RequestBody{Required: true, Content: map[string]MediaType{"application/x-www-form-urlencoded": {Schema: schema}}}
+}
+
+func jsonBody(schema *Schema) *RequestBody {
+	return &RequestBody{Required: true, Content: map[string]MediaType{"application/json": {Schema: schema}}}
+}
@@ -0,0 +1,199 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"lunch_app/backend/internal/openapi"
+	"lunch_app/backend/internal/routes"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ginParam = regexp.MustCompile(`[:*](\w+)`)
+
+// specPath gin 경로 표기(:id)를 OpenAPI 표기({id})로 변환
+func specPath(path string) string {
+	return ginParam.ReplaceAllString(path, "{$1}")
+}
+
+func setupRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes.Setup(router)
+	return router
+}
+
+func TestSpec_CoversEveryRoute(t *testing.T) {
+	doc := openapi.Build()
+
+	for _, route := range setupRouter().Routes() {
+		assert.True(t, doc.HasOperation(route.Method, specPath(route.Path)),
+			"route %s %s is missing from the OpenAPI spec", route.Method, route.Path)
+	}
+}
+
+func TestSpec_HasNoStaleOperations(t *testing.T) {
+	registered := map[string]bool{}
+	for _, route := range setupRouter().Routes() {
+		registered[route.Method+" "+specPath(route.Path)] = true
+	}
+
+	doc := openapi.Build()
+	for path, item := range doc.Paths {
+		for method, op := range map[string]*openapi.Operation{
+			"GET": item.Get, "POST": item.Post, "PUT": item.Put, "PATCH": item.Patch, "DELETE": item.Delete,
+		} {
+			if op != nil {
+				assert.True(t, registered[method+" "+path], "spec operation %s %s has no matching route", method, path)
+			}
+		}
+	}
+}
+
+// annotation 핸들러 godoc(swag 주석)에 선언된 오퍼레이션 하나
+type annotation struct {
+	handler  string
+	method   string
+	path     string
+	summary  string
+	params   []string
+	statuses []string
+}
+
+var annotationLine = regexp.MustCompile(`^@(\w+)\s+(.*)$`)
+
+// handlerAnnotations handlers 패키지 godoc의 @Router마다 파라미터와 응답 코드를 모음
+func handlerAnnotations(t *testing.T) []annotation {
+	t.Helper()
+	files, err := filepath.Glob("../handlers/*.go")
+	require.NoError(t, err)
+
+	var annotations []annotation
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		parsed, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		require.NoError(t, err)
+		for _, decl := range parsed.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Doc == nil {
+				continue
+			}
+			var op annotation
+			var routers [][2]string
+			for _, line := range strings.Split(fn.Doc.Text(), "\n") {
+				match := annotationLine.FindStringSubmatch(strings.TrimSpace(line))
+				if match == nil {
+					continue
+				}
+				fields := strings.Fields(match[2])
+				switch match[1] {
+				case "Summary":
+					op.summary = match[2]
+				case "Param":
+					// body/formData는 requestBody로 표현되므로 제외
+					if fields[1] != "body" && fields[1] != "formData" {
+						op.params = append(op.params, fields[1]+":"+fields[0]+":"+fields[3])
+					}
+				case "Success", "Failure":
+					op.statuses = append(op.statuses, fields[0])
+				case "Router":
+					routers = append(routers, [2]string{strings.ToUpper(strings.Trim(fields[1], "[]")), fields[0]})
+				}
+			}
+			for _, router := range routers {
+				op.handler = fn.Name.Name
+				op.method, op.path = router[0], router[1]
+				annotations = append(annotations, op)
+			}
+		}
+	}
+	return annotations
+}
+
+// operationFor godoc @Router 경로(/api 접두사 없음)에 해당하는 스펙 오퍼레이션 조회
+func operationFor(doc *openapi.Document, method, path string) (*openapi.Operation, bool) {
+	for _, candidate := range []string{"/api" + path, "/api" + path + "/", path} {
+		item, ok := doc.Paths[candidate]
+		if !ok {
+			continue
+		}
+		op := map[string]*openapi.Operation{
+			"GET": item.Get, "POST": item.Post, "PUT": item.Put, "PATCH": item.Patch, "DELETE": item.Delete,
+		}[method]
+		if op != nil {
+			return op, true
+		}
+	}
+	return nil, false
+}
+
+func TestSpec_MatchesHandlerAnnotations(t *testing.T) {
+	doc := openapi.Build()
+	annotations := handlerAnnotations(t)
+	require.NotEmpty(t, annotations)
+
+	for _, annotated := range annotations {
+		name := annotated.handler + " " + annotated.method + " " + annotated.path
+		op, ok := operationFor(doc, annotated.method, annotated.path)
+		if !assert.True(t, ok, "%s: godoc @Router has no spec operation", name) {
+			continue
+		}
+		assert.Equal(t, annotated.summary, op.Summary, "%s: @Summary", name)
+
+		var params []string
+		for _, param := range op.Parameters {
+			params = append(params, param.In+":"+param.Name+":"+map[bool]string{true: "true", false: "false"}[param.Required])
+		}
+		sort.Strings(params)
+		sort.Strings(annotated.params)
+		assert.Equal(t, annotated.params, params, "%s: @Param", name)
+
+		var statuses []string
+		for status := range op.Responses {
+			statuses = append(statuses, status)
+		}
+		sort.Strings(statuses)
+		sort.Strings(annotated.statuses)
+		assert.Equal(t, annotated.statuses, statuses, "%s: @Success/@Failure", name)
+	}
+}
+
+func TestSpec_ReferencesResolve(t *testing.T) {
+	body, err := json.Marshal(openapi.Build())
+	assert.NoError(t, err)
+
+	doc := openapi.Build()
+	for _, match := range regexp.MustCompile(`"\$ref":"#/components/schemas/([^"]+)"`).FindAllStringSubmatch(string(body), -1) {
+		_, ok := doc.Components.Schemas[match[1]]
+		assert.True(t, ok, "unresolved schema reference %s", match[1])
+	}
+}
+
+func TestHandler_ServesSpec(t *testing.T) {
+	router := setupRouter()
+
+	req, _ := http.NewRequest("GET", "/api/openapi.json", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, strings.HasPrefix(w.Header().Get("Content-Type"), "application/json"))
+
+	var doc map[string]any
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.Equal(t, "3.0.3", doc["openapi"])
+}
@@ -0,0 +1,142 @@
+package places
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"lunch_app/backend/internal/geo"
+	"os"
+	"sort"
+	"strings"
+)
+
+// fakeAddressRadiusMeters Fake.CoordToAddress가 가장 가까운 장소의 주소를 돌려주는 최대 거리
+const fakeAddressRadiusMeters = 100
+
+// Fake 고정된 장소 목록(픽스처)에서 검색하는 PlaceSearcher 구현
+// 네트워크 없이 테스트하거나 API 키 없이 로컬에서 실행할 때 사용
+type Fake struct {
+	Places []Place
+	// Err 설정하면 모든 호출이 이 에러를 반환 (장애 상황 테스트용)
+	Err error
+}
+
+// NewFake 장소 목록으로 Fake 생성
+func NewFake(places ...Place) *Fake {
+	return &Fake{Places: places}
+}
+
+// LoadFake 카카오 키워드·카테고리 검색 응답과 같은 형식의 JSON 파일로 Fake 생성
+// 실제 API 응답을 저장해 그대로 픽스처로 쓸 수 있음
+func LoadFake(path string) (*Fake, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("places: read fixture: %w", err)
+	}
+	var response kakaoSearchResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("places: parse fixture %s: %w", path, err)
+	}
+	return NewFake(response.toResult().Places...), nil
+}
+
+// SearchKeyword 공백으로 나눈 단어 중 하나라도 이름이나 카테고리에 포함된 장소를 검색
+// 정확도순은 일치한 단어가 많은 순
+func (f *Fake) SearchKeyword(ctx context.Context, query string, opts SearchOptions) (SearchResult, error) {
+	if f.Err != nil {
+		return SearchResult{}, f.Err
+	}
+	terms := strings.Fields(strings.ToLower(query))
+	scores := map[string]int{}
+	matched := f.filter(opts, func(p Place) bool {
+		text := strings.ToLower(p.Name + " " + p.Category)
+		for _, term := range terms {
+			if strings.Contains(text, term) {
+				scores[p.ID]++
+			}
+		}
+		return scores[p.ID] > 0
+	})
+	if opts.Sort != SortDistance {
+		sort.SliceStable(matched, func(i, j int) bool {
+			return scores[matched[i].ID] > scores[matched[j].ID]
+		})
+	}
+	return paginate(matched, opts), nil
+}
+
+// SearchCategory 카테고리 그룹 코드가 같은 장소를 검색
+func (f *Fake) SearchCategory(ctx context.Context, categoryGroupCode string, opts SearchOptions) (SearchResult, error) {
+	if f.Err != nil {
+		return SearchResult{}, f.Err
+	}
+	matched := f.filter(opts, func(p Place) bool {
+		return p.CategoryGroupCode == categoryGroupCode
+	})
+	return paginate(matched, opts), nil
+}
+
+// CoordToAddress 100m 안에서 가장 가까운 장소의 주소를 반환 (없으면 ErrAddressNotFound)
+func (f *Fake) CoordToAddress(ctx context.Context, latitude, longitude float64) (Address, error) {
+	if f.Err != nil {
+		return Address{}, f.Err
+	}
+	var nearest *Place
+	nearestDistance := float64(fakeAddressRadiusMeters)
+	for i, p := range f.Places {
+		if distance := geo.DistanceMeters(latitude, longitude, p.Latitude, p.Longitude); distance <= nearestDistance {
+			nearest, nearestDistance = &f.Places[i], distance
+		}
+	}
+	if nearest == nil {
+		return Address{}, ErrAddressNotFound
+	}
+
+	address := Address{Address: nearest.Address, RoadAddress: nearest.RoadAddress}
+	regions := strings.Fields(nearest.Address)
+	for i, region := range []*string{&address.Region1, &address.Region2, &address.Region3} {
+		if i < len(regions) {
+			*region = regions[i]
+		}
+	}
+	return address, nil
+}
+
+// filter 조건에 맞고 검색 반경 안에 있는 장소를 거리와 함께 반환 (거리순 정렬 요청이면 정렬)
+func (f *Fake) filter(opts SearchOptions, match func(Place) bool) []Place {
+	matched := []Place{}
+	for _, p := range f.Places {
+		if !match(p) {
+			continue
+		}
+		p.DistanceMeters = 0
+		if opts.HasCenter() {
+			p.DistanceMeters = geo.DistanceMeters(opts.Latitude, opts.Longitude, p.Latitude, p.Longitude)
+			if opts.RadiusMeters > 0 && p.DistanceMeters > float64(opts.RadiusMeters) {
+				continue
+			}
+		}
+		matched = append(matched, p)
+	}
+	if opts.Sort == SortDistance {
+		sort.SliceStable(matched, func(i, j int) bool {
+			return matched[i].DistanceMeters < matched[j].DistanceMeters
+		})
+	}
+	return matched
+}
+
+// paginate 카카오 API와 같은 기본값(1페이지, 15개)으로 한 페이지를 잘라냄
+func paginate(places []Place, opts SearchOptions) SearchResult {
+	page, size := max(opts.Page, 1), opts.Size
+	if size <= 0 || size > MaxSize {
+		size = MaxSize
+	}
+	start := min((page-1)*size, len(places))
+	end := min(start+size, len(places))
+	return SearchResult{
+		Places:     places[start:end],
+		TotalCount: len(places),
+		IsEnd:      end == len(places),
+	}
+}
@@ -0,0 +1,98 @@
+package places
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 강남역 근처 좌표
+const (
+	testLatitude  = 37.4979
+	testLongitude = 127.0276
+)
+
+func loadTestFake(t *testing.T) *Fake {
+	t.Helper()
+	fake, err := LoadFake("testdata/kakao_keyword.json")
+	require.NoError(t, err)
+	return fake
+}
+
+func placeIDs(places []Place) []string {
+	ids := make([]string, 0, len(places))
+	for _, p := range places {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func TestFake_SearchKeyword(t *testing.T) {
+	fake := loadTestFake(t)
+	ctx := context.Background()
+
+	result, err := fake.SearchKeyword(ctx, "국밥", SearchOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10001001", "10001003"}, placeIDs(result.Places))
+	assert.Zero(t, result.Places[0].DistanceMeters, "중심 좌표가 없으면 거리를 계산하지 않음")
+
+	// 반경 밖의 서초 순대국밥은 제외
+	result, err = fake.SearchKeyword(ctx, "국밥", SearchOptions{Latitude: testLatitude, Longitude: testLongitude, RadiusMeters: 300})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10001001"}, placeIDs(result.Places))
+	assert.Greater(t, result.Places[0].DistanceMeters, 0.0)
+
+	// 정확도순은 일치한 단어가 많은 장소가 먼저
+	result, err = fake.SearchKeyword(ctx, "순대 국밥", SearchOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10001003", "10001001"}, placeIDs(result.Places))
+}
+
+func TestFake_SearchCategoryPaging(t *testing.T) {
+	fake := loadTestFake(t)
+	opts := SearchOptions{Latitude: testLatitude, Longitude: testLongitude, Sort: SortDistance, Size: 2}
+
+	first, err := fake.SearchCategory(context.Background(), CategoryRestaurant, opts)
+	require.NoError(t, err)
+	assert.Equal(t, 3, first.TotalCount)
+	assert.False(t, first.IsEnd)
+	require.Len(t, first.Places, 2)
+	assert.LessOrEqual(t, first.Places[0].DistanceMeters, first.Places[1].DistanceMeters)
+
+	opts.Page = 2
+	second, err := fake.SearchCategory(context.Background(), CategoryRestaurant, opts)
+	require.NoError(t, err)
+	assert.True(t, second.IsEnd)
+	assert.Len(t, second.Places, 1)
+
+	opts.Page = 10
+	empty, err := fake.SearchCategory(context.Background(), CategoryRestaurant, opts)
+	require.NoError(t, err)
+	assert.Empty(t, empty.Places)
+}
+
+func TestFake_CoordToAddress(t *testing.T) {
+	fake := loadTestFake(t)
+
+	address, err := fake.CoordToAddress(context.Background(), 37.49811, 127.02791)
+	require.NoError(t, err)
+	assert.Equal(t, "서울 강남구 역삼동 825-20", address.Address)
+	assert.Equal(t, "서울 강남구 강남대로 396", address.RoadAddress)
+	assert.Equal(t, "강남구", address.Region2)
+
+	_, err = fake.CoordToAddress(context.Background(), 35.1796, 129.0756)
+	assert.ErrorIs(t, err, ErrAddressNotFound)
+}
+
+func TestFake_Err(t *testing.T) {
+	failure := errors.New("boom")
+	fake := NewFake(Place{ID: "1", Name: "국밥"})
+	fake.Err = failure
+
+	var searcher PlaceSearcher = fake
+	_, err := searcher.SearchKeyword(context.Background(), "국밥", SearchOptions{})
+	assert.ErrorIs(t, err, failure)
+}
@@ -0,0 +1,271 @@
+package places
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultKakaoBaseURL 카카오 로컬 API 기본 주소
+const DefaultKakaoBaseURL = "https://dapi.kakao.com"
+
+const (
+	kakaoKeywordPath        = "/v2/local/search/keyword.json"
+	kakaoCategoryPath       = "/v2/local/search/category.json"
+	kakaoCoordToAddressPath = "/v2/local/geo/coord2address.json"
+
+	defaultKakaoTimeout = 5 * time.Second
+	// maxErrorBodyBytes 에러 응답에서 읽을 최대 크기
+	maxErrorBodyBytes = 4 << 10
+)
+
+// KakaoClient 카카오 로컬 REST API로 장소를 검색하는 PlaceSearcher 구현
+type KakaoClient struct {
+	// BaseURL API 주소 (테스트에서 httptest 서버 주소로 교체)
+	BaseURL string
+	// APIKey REST API 키 (Authorization: KakaoAK {APIKey})
+	APIKey     string
+	HTTPClient *http.Client
+}
+
+// NewKakaoClient 기본 주소와 5초 타임아웃을 쓰는 클라이언트 생성
+func NewKakaoClient(apiKey string) *KakaoClient {
+	return &KakaoClient{
+		BaseURL:    DefaultKakaoBaseURL,
+		APIKey:     apiKey,
+		HTTPClient: &http.Client{Timeout: defaultKakaoTimeout},
+	}
+}
+
+// APIError 카카오 API가 2xx가 아닌 상태 코드로 응답
+type APIError struct {
+	StatusCode int
+	// Type 카카오 에러 타입 (예: AccessDeniedError, 없으면 빈 문자열)
+	Type    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	if e.Type != "" {
+		return fmt.Sprintf("places: kakao api %d %s: %s", e.StatusCode, e.Type, e.Message)
+	}
+	return fmt.Sprintf("places: kakao api %d: %s", e.StatusCode, e.Message)
+}
+
+// SearchKeyword PlaceSearcher 구현 (/v2/local/search/keyword.json)
+func (k *KakaoClient) SearchKeyword(ctx context.Context, query string, opts SearchOptions) (SearchResult, error) {
+	params := searchParams(opts)
+	params.Set("query", query)
+	return k.search(ctx, kakaoKeywordPath, params)
+}
+
+// SearchCategory PlaceSearcher 구현 (/v2/local/search/category.json)
+// 카카오 API는 카테고리 검색에 중심 좌표와 반경을 요구함
+func (k *KakaoClient) SearchCategory(ctx context.Context, categoryGroupCode string, opts SearchOptions) (SearchResult, error) {
+	params := searchParams(opts)
+	params.Set("category_group_code", categoryGroupCode)
+	return k.search(ctx, kakaoCategoryPath, params)
+}
+
+// CoordToAddress PlaceSearcher 구현 (/v2/local/geo/coord2address.json)
+func (k *KakaoClient) CoordToAddress(ctx context.Context, latitude, longitude float64) (Address, error) {
+	params := url.Values{}
+	params.Set("x", formatCoord(longitude))
+	params.Set("y", formatCoord(latitude))
+
+	var response kakaoAddressResponse
+	if err := k.get(ctx, kakaoCoordToAddressPath, params, &response); err != nil {
+		return Address{}, err
+	}
+	if len(response.Documents) == 0 {
+		return Address{}, ErrAddressNotFound
+	}
+	return response.Documents[0].toAddress(), nil
+}
+
+func (k *KakaoClient) search(ctx context.Context, path string, params url.Values) (SearchResult, error) {
+	var response kakaoSearchResponse
+	if err := k.get(ctx, path, params, &response); err != nil {
+		return SearchResult{}, err
+	}
+	return response.toResult(), nil
+}
+
+// get GET 요청을 보내고 JSON 응답을 out으로 디코딩
+func (k *KakaoClient) get(ctx context.Context, path string, params url.Values, out any) error {
+	if k.APIKey == "" {
+		return ErrNotConfigured
+	}
+	baseURL := k.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultKakaoBaseURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(baseURL, "/")+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "KakaoAK "+k.APIKey)
+
+	client := k.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("places: kakao request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newAPIError(resp)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("places: decode kakao response: %w", err)
+	}
+	return nil
+}
+
+// newAPIError 카카오 에러 응답 파싱
+// 로컬 API는 {"errorType","message"}, 인증 에러는 {"code","msg"} 형식으로 응답함
+func newAPIError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+
+	var payload struct {
+		ErrorType string `json:"errorType"`
+		Message   string `json:"message"`
+		Msg       string `json:"msg"`
+	}
+	if json.Unmarshal(body, &payload) == nil {
+		apiErr.Type = payload.ErrorType
+		apiErr.Message = payload.Message
+		if apiErr.Message == "" {
+			apiErr.Message = payload.Msg
+		}
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+	return apiErr
+}
+
+// searchParams 검색 조건을 카카오 쿼리 파라미터로 변환 (범위를 벗어난 값은 상한으로 맞춤)
+func searchParams(opts SearchOptions) url.Values {
+	params := url.Values{}
+	if opts.HasCenter() {
+		params.Set("x", formatCoord(opts.Longitude))
+		params.Set("y", formatCoord(opts.Latitude))
+		if opts.RadiusMeters > 0 {
+			params.Set("radius", strconv.Itoa(min(opts.RadiusMeters, MaxRadiusMeters)))
+		}
+	}
+	if opts.Page > 0 {
+		params.Set("page", strconv.Itoa(min(opts.Page, MaxPage)))
+	}
+	if opts.Size > 0 {
+		params.Set("size", strconv.Itoa(min(opts.Size, MaxSize)))
+	}
+	if opts.Sort != "" {
+		params.Set("sort", opts.Sort)
+	}
+	return params
+}
+
+func formatCoord(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// kakaoSearchResponse 키워드·카테고리 검색 응답
+type kakaoSearchResponse struct {
+	Meta struct {
+		TotalCount    int  `json:"total_count"`
+		PageableCount int  `json:"pageable_count"`
+		IsEnd         bool `json:"is_end"`
+	} `json:"meta"`
+	Documents []kakaoPlace `json:"documents"`
+}
+
+func (r kakaoSearchResponse) toResult() SearchResult {
+	result := SearchResult{
+		Places:     make([]Place, 0, len(r.Documents)),
+		TotalCount: r.Meta.TotalCount,
+		IsEnd:      r.Meta.IsEnd,
+	}
+	for _, document := range r.Documents {
+		result.Places = append(result.Places, document.toPlace())
+	}
+	return result
+}
+
+// kakaoPlace 검색 응답의 장소 (좌표와 거리는 문자열로 내려옴)
+type kakaoPlace struct {
+	ID                string `json:"id"`
+	PlaceName         string `json:"place_name"`
+	CategoryName      string `json:"category_name"`
+	CategoryGroupCode string `json:"category_group_code"`
+	CategoryGroupName string `json:"category_group_name"`
+	Phone             string `json:"phone"`
+	AddressName       string `json:"address_name"`
+	RoadAddressName   string `json:"road_address_name"`
+	X                 string `json:"x"`
+	Y                 string `json:"y"`
+	PlaceURL          string `json:"place_url"`
+	Distance          string `json:"distance"`
+}
+
+func (p kakaoPlace) toPlace() Place {
+	latitude, _ := strconv.ParseFloat(p.Y, 64)
+	longitude, _ := strconv.ParseFloat(p.X, 64)
+	distance, _ := strconv.ParseFloat(p.Distance, 64)
+	return Place{
+		ID:                p.ID,
+		Name:              p.PlaceName,
+		Category:          p.CategoryName,
+		CategoryGroupCode: p.CategoryGroupCode,
+		Phone:             p.Phone,
+		Address:           p.AddressName,
+		RoadAddress:       p.RoadAddressName,
+		Latitude:          latitude,
+		Longitude:         longitude,
+		URL:               p.PlaceURL,
+		DistanceMeters:    distance,
+	}
+}
+
+// kakaoAddressResponse 좌표→주소 변환 응답
+type kakaoAddressResponse struct {
+	Documents []kakaoAddressDocument `json:"documents"`
+}
+
+// kakaoAddressDocument 지번 주소와 도로명 주소 (도로명 주소가 없는 좌표는 road_address가 null)
+type kakaoAddressDocument struct {
+	Address *struct {
+		AddressName string `json:"address_name"`
+		Region1     string `json:"region_1depth_name"`
+		Region2     string `json:"region_2depth_name"`
+		Region3     string `json:"region_3depth_name"`
+	} `json:"address"`
+	RoadAddress *struct {
+		AddressName string `json:"address_name"`
+	} `json:"road_address"`
+}
+
+func (d kakaoAddressDocument) toAddress() Address {
+	var address Address
+	if d.Address != nil {
+		address.Address = d.Address.AddressName
+		address.Region1 = d.Address.Region1
+		address.Region2 = d.Address.Region2
+		address.Region3 = d.Address.Region3
+	}
+	if d.RoadAddress != nil {
+		address.RoadAddress = d.RoadAddress.AddressName
+	}
+	return address
+}
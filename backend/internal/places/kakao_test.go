@@ -0,0 +1,113 @@
+package places
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFixtureServer 경로별로 testdata 픽스처를 응답하는 카카오 API 대역 서버
+func newFixtureServer(t *testing.T, requests *[]*http.Request) *KakaoClient {
+	t.Helper()
+	fixtures := map[string]string{
+		kakaoKeywordPath:        "testdata/kakao_keyword.json",
+		kakaoCategoryPath:       "testdata/kakao_keyword.json",
+		kakaoCoordToAddressPath: "testdata/kakao_coord2address.json",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r)
+		if r.Header.Get("Authorization") != "KakaoAK test-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"errorType":"AccessDeniedError","message":"cannot find appKey"}`))
+			return
+		}
+		data, err := os.ReadFile(fixtures[r.URL.Path])
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewKakaoClient("test-key")
+	client.BaseURL = server.URL
+	return client
+}
+
+func TestKakaoClient_SearchKeyword(t *testing.T) {
+	var requests []*http.Request
+	client := newFixtureServer(t, &requests)
+
+	result, err := client.SearchKeyword(context.Background(), "국밥", SearchOptions{
+		Latitude: 37.4979, Longitude: 127.0276, RadiusMeters: 50000, Size: 20, Sort: SortDistance,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Places, 4)
+	assert.Equal(t, 4, result.TotalCount)
+	assert.True(t, result.IsEnd)
+
+	first := result.Places[0]
+	assert.Equal(t, "10001001", first.ID)
+	assert.Equal(t, "강남 돼지국밥", first.Name)
+	assert.Equal(t, CategoryRestaurant, first.CategoryGroupCode)
+	assert.Equal(t, "서울 강남구 강남대로 396", first.RoadAddress)
+	assert.InDelta(t, 37.4981, first.Latitude, 1e-9)
+	assert.InDelta(t, 127.0279, first.Longitude, 1e-9)
+	assert.Equal(t, 120.0, first.DistanceMeters)
+
+	require.Len(t, requests, 1)
+	query := requests[0].URL.Query()
+	assert.Equal(t, "국밥", query.Get("query"))
+	assert.Equal(t, "127.0276", query.Get("x"))
+	assert.Equal(t, "37.4979", query.Get("y"))
+	assert.Equal(t, "20000", query.Get("radius"), "반경은 API 상한으로 맞춤")
+	assert.Equal(t, "15", query.Get("size"), "페이지 크기는 API 상한으로 맞춤")
+	assert.Equal(t, SortDistance, query.Get("sort"))
+}
+
+func TestKakaoClient_SearchCategoryAndCoordToAddress(t *testing.T) {
+	var requests []*http.Request
+	client := newFixtureServer(t, &requests)
+
+	_, err := client.SearchCategory(context.Background(), CategoryRestaurant, SearchOptions{Latitude: 37.4979, Longitude: 127.0276, RadiusMeters: 500})
+	require.NoError(t, err)
+	assert.Equal(t, CategoryRestaurant, requests[0].URL.Query().Get("category_group_code"))
+	assert.Equal(t, "500", requests[0].URL.Query().Get("radius"))
+
+	address, err := client.CoordToAddress(context.Background(), 37.4981, 127.0279)
+	require.NoError(t, err)
+	assert.Equal(t, Address{
+		Address:     "서울 강남구 역삼동 825-20",
+		RoadAddress: "서울 강남구 강남대로 396",
+		Region1:     "서울",
+		Region2:     "강남구",
+		Region3:     "역삼동",
+	}, address)
+}
+
+func TestKakaoClient_Errors(t *testing.T) {
+	var requests []*http.Request
+	client := newFixtureServer(t, &requests)
+	client.APIKey = "wrong-key"
+
+	_, err := client.SearchKeyword(context.Background(), "국밥", SearchOptions{})
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	assert.Equal(t, "AccessDeniedError", apiErr.Type)
+	assert.Equal(t, "cannot find appKey", apiErr.Message)
+
+	// API 키가 없으면 요청하지 않음
+	client.APIKey = ""
+	_, err = client.CoordToAddress(context.Background(), 37.5, 127)
+	assert.ErrorIs(t, err, ErrNotConfigured)
+	assert.Len(t, requests, 1)
+}
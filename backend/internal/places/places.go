@@ -0,0 +1,120 @@
+package places
+
+import (
+	"context"
+	"errors"
+	"os"
+)
+
+// 카카오 로컬 API 카테고리 그룹 코드 중 맛집 관련 코드
+const (
+	CategoryRestaurant = "FD6" // 음식점
+	CategoryCafe       = "CE7" // 카페
+)
+
+// 검색 결과 정렬 방식
+const (
+	SortAccuracy = "accuracy"
+	SortDistance = "distance"
+)
+
+const (
+	// MaxRadiusMeters 카카오 로컬 API가 허용하는 최대 검색 반경
+	MaxRadiusMeters = 20000
+	// MaxPage, MaxSize 카카오 로컬 API의 페이지 번호·페이지 크기 상한
+	MaxPage = 45
+	MaxSize = 15
+)
+
+// ErrNotConfigured 장소 검색에 필요한 API 키나 픽스처가 설정되지 않음
+var ErrNotConfigured = errors.New("places: place searcher is not configured")
+
+// ErrAddressNotFound 좌표에 해당하는 주소가 없음
+var ErrAddressNotFound = errors.New("places: address not found")
+
+// Place 검색된 장소 (카카오 로컬 API의 document를 정리한 값)
+type Place struct {
+	// ID 출처의 장소 ID (models.Restaurant.ExternalID로 저장)
+	ID                string
+	Name              string
+	Category          string
+	CategoryGroupCode string
+	Phone             string
+	Address           string
+	RoadAddress       string
+	Latitude          float64
+	Longitude         float64
+	URL               string
+	// DistanceMeters 검색 중심 좌표에서의 거리 (중심 좌표 없이 검색하면 0)
+	DistanceMeters float64
+}
+
+// SearchOptions 키워드·카테고리 검색 조건 (0이면 API 기본값)
+type SearchOptions struct {
+	// Latitude, Longitude 검색 중심 좌표 (둘 다 0이면 중심 없이 검색)
+	Latitude  float64
+	Longitude float64
+	// RadiusMeters 중심 좌표 기준 반경 (최대 20000)
+	RadiusMeters int
+	Page         int
+	Size         int
+	// Sort accuracy(기본) 또는 distance
+	Sort string
+}
+
+// HasCenter 중심 좌표가 지정되었는지 확인
+func (o SearchOptions) HasCenter() bool {
+	return o.Latitude != 0 || o.Longitude != 0
+}
+
+// SearchResult 한 페이지의 검색 결과
+type SearchResult struct {
+	Places []Place
+	// TotalCount 검색된 전체 장소 수
+	TotalCount int
+	// IsEnd 마지막 페이지 여부
+	IsEnd bool
+}
+
+// Address 좌표를 변환한 주소
+type Address struct {
+	// Address 지번 주소
+	Address string
+	// RoadAddress 도로명 주소 (없으면 빈 문자열)
+	RoadAddress string
+	Region1     string // 시도
+	Region2     string // 구
+	Region3     string // 동
+}
+
+// PlaceSearcher 서버에서 장소를 검색하는 인터페이스
+// 운영에서는 KakaoClient, 테스트·오프라인 개발에서는 Fake를 사용
+type PlaceSearcher interface {
+	// SearchKeyword 키워드로 장소 검색 (예: "강남역 국밥")
+	SearchKeyword(ctx context.Context, query string, opts SearchOptions) (SearchResult, error)
+	// SearchCategory 카테고리 그룹 코드로 중심 좌표 주변 장소 검색 (예: CategoryRestaurant)
+	SearchCategory(ctx context.Context, categoryGroupCode string, opts SearchOptions) (SearchResult, error)
+	// CoordToAddress 좌표를 주소로 변환
+	CoordToAddress(ctx context.Context, latitude, longitude float64) (Address, error)
+}
+
+// NewFromEnv 환경변수에 따라 장소 검색기 생성
+//
+//	KAKAO_REST_API_KEY  카카오 REST API 키 (설정되면 KakaoClient 사용)
+//	KAKAO_LOCAL_BASE_URL 카카오 로컬 API 주소 (기본 https://dapi.kakao.com)
+//	PLACES_FIXTURE      API 키가 없을 때 Fake가 읽을 카카오 검색 응답 JSON 파일
+//
+// 둘 다 없으면 ErrNotConfigured
+func NewFromEnv() (PlaceSearcher, error) {
+	if key := os.Getenv("KAKAO_REST_API_KEY"); key != "" {
+		client := NewKakaoClient(key)
+		if baseURL := os.Getenv("KAKAO_LOCAL_BASE_URL"); baseURL != "" {
+			client.BaseURL = baseURL
+		}
+		return client, nil
+	}
+	if fixture := os.Getenv("PLACES_FIXTURE"); fixture != "" {
+		return LoadFake(fixture)
+	}
+	return nil, ErrNotConfigured
+}
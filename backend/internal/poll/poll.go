@@ -0,0 +1,308 @@
+package poll
+
+import (
+	"errors"
+	"fmt"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/recommend"
+	"math/rand/v2"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// MaxCandidates 투표 하나의 최대 후보 수
+	MaxCandidates = 10
+	// MaxDuration 생성 시각부터 마감까지 최대 시간
+	MaxDuration = 24 * time.Hour
+)
+
+// Actor 마감 시각이 지나 자동으로 닫힌 투표의 감사 로그 행위자
+const Actor = "system:poll"
+
+var (
+	// ErrNoCandidates 후보가 하나도 없음 (직접 고른 맛집도, 추천할 맛집도 없음)
+	ErrNoCandidates = errors.New("poll: no candidates")
+	// ErrTooManyCandidates 후보가 MaxCandidates를 넘음
+	ErrTooManyCandidates = errors.New("poll: too many candidates")
+	// ErrInvalidDeadline 마감 시각이 지났거나 MaxDuration보다 멂
+	ErrInvalidDeadline = errors.New("poll: deadline must be in the future and within 24 hours")
+	// ErrNotFound 투표가 없음
+	ErrNotFound = errors.New("poll: not found")
+	// ErrClosed 이미 마감된 투표
+	ErrClosed = errors.New("poll: closed")
+	// ErrCandidateNotFound 투표에 없는 후보
+	ErrCandidateNotFound = errors.New("poll: candidate not found")
+)
+
+// RestaurantNotFoundError 후보로 고른 맛집이 없거나 삭제됨
+type RestaurantNotFoundError struct {
+	ID uint
+}
+
+func (e *RestaurantNotFoundError) Error() string {
+	return fmt.Sprintf("poll: restaurant %d not found", e.ID)
+}
+
+// CreateInput 투표 생성 조건
+type CreateInput struct {
+	Team      string
+	Title     string
+	CreatedBy string
+	Deadline  time.Time
+	// RestaurantIDs 직접 고른 후보 맛집 (순서 유지)
+	RestaurantIDs []uint
+	// AutoSeed 추천으로 추가할 후보 수
+	AutoSeed int
+	// Rand 추천 순서 난수 생성기 (nil이면 전역 난수, 테스트에서 고정)
+	Rand *rand.Rand
+}
+
+// Create 오늘(Asia/Seoul) 점심 투표 생성
+// 직접 고른 맛집 뒤에 최근 방문하지 않은 맛집을 우선으로 AutoSeed개 추천 후보를 추가
+func Create(db *gorm.DB, input CreateInput, now time.Time) (models.Poll, error) {
+	if !input.Deadline.After(now) || input.Deadline.Sub(now) > MaxDuration {
+		return models.Poll{}, ErrInvalidDeadline
+	}
+	restaurantIDs := uniqueIDs(input.RestaurantIDs)
+	if len(restaurantIDs)+max(input.AutoSeed, 0) > MaxCandidates {
+		return models.Poll{}, ErrTooManyCandidates
+	}
+	team := input.Team
+	if team == "" {
+		team = models.DefaultTeam
+	}
+
+	poll := models.Poll{
+		Team:      team,
+		Title:     input.Title,
+		Date:      kst.Date(now),
+		Deadline:  input.Deadline,
+		Status:    models.PollStatusOpen,
+		CreatedBy: input.CreatedBy,
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var manual []models.Restaurant
+		if err := tx.Where("id IN ?", restaurantIDs).Find(&manual).Error; err != nil {
+			return err
+		}
+		byID := make(map[uint]models.Restaurant, len(manual))
+		for _, r := range manual {
+			byID[r.ID] = r
+		}
+		for _, id := range restaurantIDs {
+			r, ok := byID[id]
+			if !ok {
+				return &RestaurantNotFoundError{ID: id}
+			}
+			poll.Candidates = append(poll.Candidates, newCandidate(r, models.PollCandidateManual))
+		}
+
+		recommended, err := recommend.Restaurants(tx, recommend.Options{
+			Limit:      input.AutoSeed,
+			ExcludeIDs: restaurantIDs,
+			Now:        now,
+			Rand:       input.Rand,
+		})
+		if err != nil {
+			return err
+		}
+		for _, r := range recommended {
+			poll.Candidates = append(poll.Candidates, newCandidate(r, models.PollCandidateRecommended))
+		}
+		if len(poll.Candidates) == 0 {
+			return ErrNoCandidates
+		}
+		return tx.Create(&poll).Error
+	})
+	return poll, err
+}
+
+func newCandidate(r models.Restaurant, source string) models.PollCandidate {
+	return models.PollCandidate{RestaurantID: r.ID, RestaurantSnapshot: r.Snapshot(), Source: source}
+}
+
+// WithDetails 후보와 표를 등록 순서대로 함께 불러오는 scope
+func WithDetails(tx *gorm.DB) *gorm.DB {
+	return tx.Preload("Candidates", func(tx *gorm.DB) *gorm.DB { return tx.Order("id") }).
+		Preload("Votes", func(tx *gorm.DB) *gorm.DB { return tx.Order("id") })
+}
+
+// Get 후보와 표를 포함해 투표 조회
+func Get(db *gorm.DB, id uint) (models.Poll, error) {
+	var poll models.Poll
+	err := db.Scopes(WithDetails).First(&poll, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return poll, ErrNotFound
+	}
+	return poll, err
+}
+
+// Vote voter의 표를 candidateID에 기록 (이미 투표했으면 후보만 변경)
+// 마감 시각이 지났거나 닫힌 투표면 ErrClosed
+func Vote(db *gorm.DB, pollID, candidateID uint, voter string, now time.Time) (models.PollVote, error) {
+	vote := models.PollVote{PollID: pollID, Voter: voter, CandidateID: candidateID}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var poll models.Poll
+		if err := tx.First(&poll, pollID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+		if poll.Status != models.PollStatusOpen || !now.Before(poll.Deadline) {
+			return ErrClosed
+		}
+
+		var count int64
+		if err := tx.Model(&models.PollCandidate{}).Where("id = ? AND poll_id = ?", candidateID, pollID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrCandidateNotFound
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "poll_id"}, {Name: "voter"}},
+			DoUpdates: clause.AssignmentColumns([]string{"candidate_id", "updated_at"}),
+		}).Create(&vote).Error
+	})
+	return vote, err
+}
+
+// Tally 후보별 득표 (후보 순서 유지)
+type Tally struct {
+	Candidate models.PollCandidate
+	Voters    []string
+}
+
+// Count 득표 수
+func (t Tally) Count() int {
+	return len(t.Voters)
+}
+
+// Results 후보별 득표 집계
+func Results(poll models.Poll) []Tally {
+	tallies := make([]Tally, 0, len(poll.Candidates))
+	index := make(map[uint]int, len(poll.Candidates))
+	for i, candidate := range poll.Candidates {
+		index[candidate.ID] = i
+		tallies = append(tallies, Tally{Candidate: candidate, Voters: []string{}})
+	}
+	for _, vote := range poll.Votes {
+		if i, ok := index[vote.CandidateID]; ok {
+			tallies[i].Voters = append(tallies[i].Voters, vote.Voter)
+		}
+	}
+	return tallies
+}
+
+// winner 가장 많이 득표한 후보 (동점이면 먼저 등록된 후보, 표가 없으면 nil)
+func winner(tallies []Tally) *Tally {
+	var best *Tally
+	for i := range tallies {
+		if tallies[i].Count() > 0 && (best == nil || tallies[i].Count() > best.Count()) {
+			best = &tallies[i]
+		}
+	}
+	return best
+}
+
+// CloseResult 투표 마감 결과
+type CloseResult struct {
+	Poll models.Poll
+	// Visits 당선 맛집으로 기록된 투표자별 방문 기록 (표가 없으면 비어 있음)
+	Visits []models.Visit
+}
+
+// Close 투표를 마감하고 당선 맛집으로 모든 투표자의 방문 기록을 남김 (한 트랜잭션)
+// 방문 일시는 마감 시각과 마감 처리 시각 중 이른 쪽
+// 이미 닫힌 투표면 ErrClosed (동시에 마감해도 한 번만 처리됨)
+func Close(db *gorm.DB, pollID uint, now time.Time) (CloseResult, error) {
+	var result CloseResult
+	err := db.Transaction(func(tx *gorm.DB) error {
+		poll, err := Get(tx, pollID)
+		if err != nil {
+			return err
+		}
+		if poll.Status != models.PollStatusOpen {
+			return ErrClosed
+		}
+
+		closedAt := now
+		if poll.Deadline.Before(closedAt) {
+			closedAt = poll.Deadline
+		}
+		updates := map[string]any{"status": models.PollStatusClosed, "closed_at": closedAt}
+		best := winner(Results(poll))
+		if best != nil {
+			updates["winner_candidate_id"] = best.Candidate.ID
+		}
+		closed := tx.Model(&models.Poll{}).Where("id = ? AND status = ?", poll.ID, models.PollStatusOpen).Updates(updates)
+		if closed.Error != nil {
+			return closed.Error
+		}
+		if closed.RowsAffected == 0 {
+			return ErrClosed
+		}
+
+		poll.Status = models.PollStatusClosed
+		poll.ClosedAt = &closedAt
+		if best != nil {
+			poll.WinnerCandidateID = &best.Candidate.ID
+			for _, vote := range poll.Votes {
+				result.Visits = append(result.Visits, models.Visit{
+					RestaurantID:       best.Candidate.RestaurantID,
+					RestaurantSnapshot: best.Candidate.RestaurantSnapshot,
+					VisitDate:          closedAt.In(kst.Location()),
+					Visitor:            vote.Voter,
+					PollID:             &poll.ID,
+				})
+			}
+			if len(result.Visits) > 0 {
+				if err := tx.Create(&result.Visits).Error; err != nil {
+					return err
+				}
+			}
+		}
+		result.Poll = poll
+		return nil
+	})
+	return result, err
+}
+
+// CloseExpired 마감 시각이 지난 열린 투표를 모두 마감하고 마감 결과 반환
+// 다른 요청이 먼저 마감한 투표는 건너뜀
+func CloseExpired(db *gorm.DB, now time.Time) ([]CloseResult, error) {
+	var ids []uint
+	if err := db.Model(&models.Poll{}).Where("status = ? AND deadline <= ?", models.PollStatusOpen, now).Order("id").Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	var closed []CloseResult
+	for _, id := range ids {
+		result, err := Close(db, id, now)
+		if errors.Is(err, ErrClosed) {
+			continue
+		}
+		if err != nil {
+			return closed, err
+		}
+		closed = append(closed, result)
+	}
+	return closed, nil
+}
+
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,225 @@
+package poll
+
+import (
+	"lunch_app/backend/internal/models"
+	"math/rand/v2"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// 2024-06-30 11:00 KST
+var fixedNow = time.Date(2024, 6, 30, 2, 0, 0, 0, time.UTC)
+
+func setupDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{TranslateError: true})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Restaurant{}, &models.Visit{}, &models.Poll{}, &models.PollCandidate{}, &models.PollVote{}))
+	return db
+}
+
+func createRestaurants(t *testing.T, db *gorm.DB, names ...string) []models.Restaurant {
+	restaurants := make([]models.Restaurant, 0, len(names))
+	for i, name := range names {
+		restaurants = append(restaurants, models.Restaurant{Name: name, Address: "서울시 " + name, Latitude: 37.5 + float64(i)/1000, Longitude: 127})
+	}
+	require.NoError(t, db.Create(&restaurants).Error)
+	return restaurants
+}
+
+func TestCreate_ManualAndAutoSeed(t *testing.T) {
+	db := setupDB(t)
+	restaurants := createRestaurants(t, db, "국밥집", "초밥집", "버거집", "분식집")
+	// 분식집은 어제 방문했으므로 다른 맛집이 있으면 추천하지 않음
+	require.NoError(t, db.Create(&models.Visit{RestaurantID: restaurants[3].ID, VisitDate: fixedNow.AddDate(0, 0, -1)}).Error)
+
+	poll, err := Create(db, CreateInput{
+		Title:         "오늘 점심",
+		CreatedBy:     "민수",
+		Deadline:      fixedNow.Add(time.Hour),
+		RestaurantIDs: []uint{restaurants[0].ID, restaurants[0].ID},
+		AutoSeed:      2,
+		Rand:          rand.New(rand.NewPCG(1, 2)),
+	}, fixedNow)
+	require.NoError(t, err)
+
+	assert.Equal(t, models.DefaultTeam, poll.Team)
+	assert.Equal(t, models.PollStatusOpen, poll.Status)
+	assert.Equal(t, "2024-06-30", poll.Date.Format("2006-01-02"))
+	require.Len(t, poll.Candidates, 3)
+	assert.Equal(t, "국밥집", poll.Candidates[0].RestaurantSnapshot.Name)
+	assert.Equal(t, models.PollCandidateManual, poll.Candidates[0].Source)
+	seeded := []string{poll.Candidates[1].RestaurantSnapshot.Name, poll.Candidates[2].RestaurantSnapshot.Name}
+	assert.ElementsMatch(t, []string{"초밥집", "버거집"}, seeded)
+	assert.Equal(t, models.PollCandidateRecommended, poll.Candidates[1].Source)
+}
+
+func TestCreate_Errors(t *testing.T) {
+	db := setupDB(t)
+	restaurants := createRestaurants(t, db, "국밥집")
+	deadline := fixedNow.Add(time.Hour)
+
+	_, err := Create(db, CreateInput{Deadline: fixedNow.Add(-time.Minute), AutoSeed: 1}, fixedNow)
+	assert.ErrorIs(t, err, ErrInvalidDeadline)
+	_, err = Create(db, CreateInput{Deadline: fixedNow.Add(25 * time.Hour), AutoSeed: 1}, fixedNow)
+	assert.ErrorIs(t, err, ErrInvalidDeadline)
+	_, err = Create(db, CreateInput{Deadline: deadline, AutoSeed: MaxCandidates + 1}, fixedNow)
+	assert.ErrorIs(t, err, ErrTooManyCandidates)
+	_, err = Create(db, CreateInput{Deadline: deadline}, fixedNow)
+	assert.ErrorIs(t, err, ErrNoCandidates)
+
+	_, err = Create(db, CreateInput{Deadline: deadline, RestaurantIDs: []uint{restaurants[0].ID, 999}}, fixedNow)
+	var notFound *RestaurantNotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, uint(999), notFound.ID)
+
+	var count int64
+	db.Model(&models.Poll{}).Count(&count)
+	assert.Zero(t, count)
+}
+
+func TestVoteAndClose(t *testing.T) {
+	db := setupDB(t)
+	restaurants := createRestaurants(t, db, "국밥집", "초밥집")
+	poll, err := Create(db, CreateInput{
+		Deadline:      fixedNow.Add(time.Hour),
+		RestaurantIDs: []uint{restaurants[0].ID, restaurants[1].ID},
+	}, fixedNow)
+	require.NoError(t, err)
+	gukbap, sushi := poll.Candidates[0].ID, poll.Candidates[1].ID
+
+	for voter, candidate := range map[string]uint{"민수": gukbap, "지영": sushi, "철수": sushi} {
+		_, err := Vote(db, poll.ID, candidate, voter, fixedNow)
+		require.NoError(t, err)
+	}
+	// 다시 투표하면 표를 옮김 → 국밥 2표, 초밥 1표
+	_, err = Vote(db, poll.ID, gukbap, "철수", fixedNow.Add(time.Minute))
+	require.NoError(t, err)
+
+	_, err = Vote(db, poll.ID, 999, "영희", fixedNow)
+	assert.ErrorIs(t, err, ErrCandidateNotFound)
+	_, err = Vote(db, 999, gukbap, "영희", fixedNow)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = Vote(db, poll.ID, gukbap, "영희", fixedNow.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrClosed, "마감 시각이 지나면 투표 불가")
+
+	loaded, err := Get(db, poll.ID)
+	require.NoError(t, err)
+	tallies := Results(loaded)
+	assert.ElementsMatch(t, []string{"민수", "철수"}, tallies[0].Voters)
+	assert.Equal(t, []string{"지영"}, tallies[1].Voters)
+
+	// 마감 처리가 늦어도 방문 일시는 마감 시각
+	result, err := Close(db, poll.ID, fixedNow.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, models.PollStatusClosed, result.Poll.Status)
+	require.NotNil(t, result.Poll.WinnerCandidateID)
+	assert.Equal(t, gukbap, *result.Poll.WinnerCandidateID)
+	require.Len(t, result.Visits, 3, "당선 후보에 투표하지 않은 사람도 함께 방문")
+
+	var visits []models.Visit
+	require.NoError(t, db.Order("visitor").Find(&visits).Error)
+	require.Len(t, visits, 3)
+	for _, visit := range visits {
+		assert.Equal(t, restaurants[0].ID, visit.RestaurantID)
+		assert.Equal(t, "국밥집", visit.RestaurantSnapshot.Name)
+		assert.Equal(t, poll.ID, *visit.PollID)
+		assert.True(t, visit.VisitDate.Equal(fixedNow.Add(time.Hour)))
+	}
+
+	_, err = Close(db, poll.ID, fixedNow.Add(3*time.Hour))
+	assert.ErrorIs(t, err, ErrClosed)
+	_, err = Vote(db, poll.ID, gukbap, "영희", fixedNow)
+	assert.ErrorIs(t, err, ErrClosed)
+}
+
+func TestClose_TieAndNoVotes(t *testing.T) {
+	db := setupDB(t)
+	restaurants := createRestaurants(t, db, "국밥집", "초밥집")
+	input := CreateInput{Deadline: fixedNow.Add(time.Hour), RestaurantIDs: []uint{restaurants[1].ID, restaurants[0].ID}}
+
+	tied, err := Create(db, input, fixedNow)
+	require.NoError(t, err)
+	_, err = Vote(db, tied.ID, tied.Candidates[1].ID, "민수", fixedNow)
+	require.NoError(t, err)
+	_, err = Vote(db, tied.ID, tied.Candidates[0].ID, "지영", fixedNow)
+	require.NoError(t, err)
+	result, err := Close(db, tied.ID, fixedNow)
+	require.NoError(t, err)
+	assert.Equal(t, tied.Candidates[0].ID, *result.Poll.WinnerCandidateID, "동점이면 먼저 등록된 후보")
+
+	empty, err := Create(db, input, fixedNow)
+	require.NoError(t, err)
+	result, err = Close(db, empty.ID, fixedNow)
+	require.NoError(t, err)
+	assert.Nil(t, result.Poll.WinnerCandidateID)
+	assert.Empty(t, result.Visits)
+}
+
+func TestCloseExpired(t *testing.T) {
+	db := setupDB(t)
+	restaurants := createRestaurants(t, db, "국밥집")
+	input := CreateInput{RestaurantIDs: []uint{restaurants[0].ID}}
+
+	input.Deadline = fixedNow.Add(30 * time.Minute)
+	expiring, err := Create(db, input, fixedNow)
+	require.NoError(t, err)
+	_, err = Vote(db, expiring.ID, expiring.Candidates[0].ID, "민수", fixedNow)
+	require.NoError(t, err)
+	input.Deadline = fixedNow.Add(2 * time.Hour)
+	later, err := Create(db, input, fixedNow)
+	require.NoError(t, err)
+
+	closed, err := CloseExpired(db, fixedNow.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, closed, 1)
+	assert.Equal(t, expiring.ID, closed[0].Poll.ID)
+	assert.Len(t, closed[0].Visits, 1)
+
+	expiring, err = Get(db, expiring.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.PollStatusClosed, expiring.Status)
+	later, err = Get(db, later.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.PollStatusOpen, later.Status)
+}
+
+func TestClose_Concurrent(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file:"+t.TempDir()+"/poll.db?_txlock=immediate&_busy_timeout=5000"), &gorm.Config{TranslateError: true})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Restaurant{}, &models.Visit{}, &models.Poll{}, &models.PollCandidate{}, &models.PollVote{}))
+	restaurants := createRestaurants(t, db, "국밥집")
+	poll, err := Create(db, CreateInput{Deadline: fixedNow.Add(time.Hour), RestaurantIDs: []uint{restaurants[0].ID}}, fixedNow)
+	require.NoError(t, err)
+	_, err = Vote(db, poll.ID, poll.Candidates[0].ID, "민수", fixedNow)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = Close(db, poll.ID, fixedNow)
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		} else {
+			assert.ErrorIs(t, err, ErrClosed)
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+	var visits int64
+	db.Model(&models.Visit{}).Count(&visits)
+	assert.Equal(t, int64(1), visits, "방문 기록은 한 번만 남음")
+}
@@ -0,0 +1,98 @@
+package recommend
+
+import (
+	"lunch_app/backend/internal/hours"
+	"lunch_app/backend/internal/models"
+	"math/rand/v2"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DefaultRecentDays 최근 방문으로 보고 추천 순위를 낮추는 기간
+const DefaultRecentDays = 7
+
+// Options 추천 조건
+type Options struct {
+	// Limit 추천할 맛집 수
+	Limit int
+	// ExcludeIDs 추천에서 제외할 맛집 (이미 후보에 있는 맛집 등)
+	ExcludeIDs []uint
+	// RecentDays 이 기간 안에 방문한 맛집은 다른 맛집이 부족할 때만 추천 (0이면 DefaultRecentDays)
+	RecentDays int
+	// Now 기준 시각 (zero면 time.Now)
+	Now time.Time
+	// OpenAt 이 시각에 영업 중인 맛집만 추천 (zero면 영업시간을 보지 않음)
+	OpenAt time.Time
+	// Rand 순서를 섞을 난수 생성기 (nil이면 전역 난수)
+	Rand *rand.Rand
+}
+
+// Restaurants 등록된 맛집 중에서 무작위로 추천
+// 최근 방문하지 않은 맛집을 먼저 고르고, 모자라면 최근 방문한 맛집으로 채움
+func Restaurants(db *gorm.DB, opts Options) ([]models.Restaurant, error) {
+	if opts.Limit <= 0 {
+		return []models.Restaurant{}, nil
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	recentDays := opts.RecentDays
+	if recentDays <= 0 {
+		recentDays = DefaultRecentDays
+	}
+
+	query := db.Order("id")
+	if len(opts.ExcludeIDs) > 0 {
+		query = query.Where("id NOT IN ?", opts.ExcludeIDs)
+	}
+	var restaurants []models.Restaurant
+	if err := query.Find(&restaurants).Error; err != nil {
+		return nil, err
+	}
+	if !opts.OpenAt.IsZero() {
+		var err error
+		if restaurants, err = hours.FilterOpen(db, restaurants, opts.OpenAt); err != nil {
+			return nil, err
+		}
+	}
+
+	var visitedIDs []uint
+	err := db.Model(&models.Visit{}).
+		Where("visit_date >= ?", now.AddDate(0, 0, -recentDays)).
+		Distinct("restaurant_id").Pluck("restaurant_id", &visitedIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	visited := make(map[uint]bool, len(visitedIDs))
+	for _, id := range visitedIDs {
+		visited[id] = true
+	}
+
+	var fresh, recent []models.Restaurant
+	for _, r := range restaurants {
+		if visited[r.ID] {
+			recent = append(recent, r)
+		} else {
+			fresh = append(fresh, r)
+		}
+	}
+	shuffle(opts.Rand, fresh)
+	shuffle(opts.Rand, recent)
+
+	picked := append(fresh, recent...)
+	if len(picked) > opts.Limit {
+		picked = picked[:opts.Limit]
+	}
+	return picked, nil
+}
+
+func shuffle(r *rand.Rand, restaurants []models.Restaurant) {
+	swap := func(i, j int) { restaurants[i], restaurants[j] = restaurants[j], restaurants[i] }
+	if r != nil {
+		r.Shuffle(len(restaurants), swap)
+		return
+	}
+	rand.Shuffle(len(restaurants), swap)
+}
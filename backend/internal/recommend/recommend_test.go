@@ -0,0 +1,51 @@
+package recommend
+
+import (
+	"lunch_app/backend/internal/models"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var fixedNow = time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+
+func TestRestaurants_PrefersNotRecentlyVisited(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Restaurant{}, &models.Visit{}))
+
+	restaurants := []models.Restaurant{{Name: "A"}, {Name: "B"}, {Name: "C"}, {Name: "D"}}
+	require.NoError(t, db.Create(&restaurants).Error)
+	visits := []models.Visit{
+		{RestaurantID: restaurants[0].ID, VisitDate: fixedNow.AddDate(0, 0, -2)},
+		{RestaurantID: restaurants[1].ID, VisitDate: fixedNow.AddDate(0, 0, -30)},
+	}
+	require.NoError(t, db.Create(&visits).Error)
+
+	names := func(rs []models.Restaurant) []string {
+		out := make([]string, 0, len(rs))
+		for _, r := range rs {
+			out = append(out, r.Name)
+		}
+		return out
+	}
+
+	picked, err := Restaurants(db, Options{Limit: 2, ExcludeIDs: []uint{restaurants[2].ID}, Now: fixedNow, Rand: rand.New(rand.NewPCG(1, 1))})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"B", "D"}, names(picked), "A는 최근 방문, C는 제외")
+
+	// 모자라면 최근 방문한 맛집으로 채움
+	picked, err = Restaurants(db, Options{Limit: 10, Now: fixedNow})
+	require.NoError(t, err)
+	require.Len(t, picked, 4)
+	assert.Equal(t, "A", picked[3].Name)
+
+	picked, err = Restaurants(db, Options{})
+	require.NoError(t, err)
+	assert.Empty(t, picked)
+}
@@ -0,0 +1,220 @@
+package retention
+
+import (
+	"context"
+	"log/slog"
+	"lunch_app/backend/internal/audit"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/trash"
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultBatchSize = 500
+
+	// defaultRestaurantDays 휴지통 API의 기본 보관 기간과 같은 값
+	defaultRestaurantDays   = 30
+	defaultDeletedVisitDays = 30
+)
+
+// Actor 보관 정책 삭제의 감사 로그 행위자
+const Actor = "system:retention"
+
+// Clock 현재 시각을 반환 (테스트에서 고정 시각 주입용)
+type Clock func() time.Time
+
+// Policy 테이블별 보관 정책
+// MaxAge가 0 이하이면 해당 정책은 실행하지 않음
+type Policy struct {
+	Name   string
+	MaxAge time.Duration
+	// selectIDs cutoff 이전에 만료된 행의 ID를 최대 limit개 조회
+	selectIDs func(tx *gorm.DB, cutoff time.Time, limit int) ([]uint, error)
+	// purge ID 목록의 행을 완전히 삭제하고 삭제한 행 수 반환
+	purge func(tx *gorm.DB, ids []uint) (int64, error)
+}
+
+// Config 보관 정책 실행 설정
+type Config struct {
+	// RestaurantMaxAge soft delete된 맛집 보관 기간
+	RestaurantMaxAge time.Duration
+	// DeletedVisitMaxAge soft delete된 방문 기록 보관 기간
+	DeletedVisitMaxAge time.Duration
+	// VisitMaxAge 방문 일자 기준 방문 기록 보관 기간 (0이면 오래된 방문 기록을 지우지 않음)
+	VisitMaxAge time.Duration
+	// BatchSize 한 트랜잭션에서 삭제할 최대 행 수
+	BatchSize int
+}
+
+// ConfigFromEnv 환경변수에서 보관 정책 설정을 읽음 (잘못된 값이면 기본값)
+//
+//	TRASH_RETENTION_DAYS          soft delete된 맛집 보관 일 수 (기본 30)
+//	DELETED_VISIT_RETENTION_DAYS  soft delete된 방문 기록 보관 일 수 (기본 30)
+//	VISIT_RETENTION_DAYS          방문 일자 기준 방문 기록 보관 일 수 (기본 0, 무기한)
+//	RETENTION_BATCH_SIZE          배치 크기 (기본 500)
+//
+// 실행 일정은 예약 작업 retention (JOB_RETENTION_SCHEDULE)으로 정함
+func ConfigFromEnv() Config {
+	return Config{
+		RestaurantMaxAge:   days(envInt("TRASH_RETENTION_DAYS", defaultRestaurantDays)),
+		DeletedVisitMaxAge: days(envInt("DELETED_VISIT_RETENTION_DAYS", defaultDeletedVisitDays)),
+		VisitMaxAge:        days(envInt("VISIT_RETENTION_DAYS", 0)),
+		BatchSize:          envInt("RETENTION_BATCH_SIZE", defaultBatchSize),
+	}
+}
+
+// Policies 설정에 따른 테이블별 보관 정책 목록
+func (c Config) Policies() []Policy {
+	return []Policy{
+		{
+			Name:   "restaurants.deleted",
+			MaxAge: c.RestaurantMaxAge,
+			selectIDs: func(tx *gorm.DB, cutoff time.Time, limit int) ([]uint, error) {
+				var ids []uint
+				err := tx.Unscoped().Model(&models.Restaurant{}).
+					Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+					Order("id").Limit(limit).Pluck("id", &ids).Error
+				return ids, err
+			},
+			purge: trash.PurgeRestaurantIDs,
+		},
+		{
+			Name:   "visits.deleted",
+			MaxAge: c.DeletedVisitMaxAge,
+			selectIDs: func(tx *gorm.DB, cutoff time.Time, limit int) ([]uint, error) {
+				var ids []uint
+				err := tx.Unscoped().Model(&models.Visit{}).
+					Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+					Order("id").Limit(limit).Pluck("id", &ids).Error
+				return ids, err
+			},
+			purge: purgeVisits,
+		},
+		{
+			Name:   "visits.old",
+			MaxAge: c.VisitMaxAge,
+			selectIDs: func(tx *gorm.DB, cutoff time.Time, limit int) ([]uint, error) {
+				var ids []uint
+				err := tx.Unscoped().Model(&models.Visit{}).
+					Where("visit_date < ?", cutoff).
+					Order("id").Limit(limit).Pluck("id", &ids).Error
+				return ids, err
+			},
+			purge: purgeVisits,
+		},
+	}
+}
+
+func purgeVisits(tx *gorm.DB, ids []uint) (int64, error) {
+	result := tx.Unscoped().Delete(&models.Visit{}, ids)
+	return result.RowsAffected, result.Error
+}
+
+// Result 정책 하나의 실행 결과
+type Result struct {
+	Policy  string
+	Cutoff  time.Time
+	Deleted int64
+	Batches int
+}
+
+// Runner 보관 정책을 적용해 만료된 행을 배치 단위로 완전히 삭제
+type Runner struct {
+	DB        *gorm.DB
+	Policies  []Policy
+	BatchSize int
+	Now       Clock
+}
+
+// NewRunner 설정으로 Runner 생성
+func NewRunner(db *gorm.DB, cfg Config) *Runner {
+	return &Runner{
+		DB:        db,
+		Policies:  cfg.Policies(),
+		BatchSize: cfg.BatchSize,
+		Now:       time.Now,
+	}
+}
+
+// Run 모든 정책을 한 번 실행하고 요약 로그를 남김
+// 배치마다 별도 트랜잭션으로 삭제하므로 중간에 실패해도 이전 배치의 삭제는 유지됨
+func (r *Runner) Run(ctx context.Context) ([]Result, error) {
+	ctx = audit.WithActor(ctx, audit.Actor{Name: Actor})
+	now := r.Now()
+	batchSize := r.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	results := make([]Result, 0, len(r.Policies))
+	for _, policy := range r.Policies {
+		if policy.MaxAge <= 0 {
+			continue
+		}
+		result, err := r.runPolicy(ctx, policy, now.Add(-policy.MaxAge), batchSize)
+		results = append(results, result)
+		if err != nil {
+			slog.ErrorContext(ctx, "보관 정책 실행 실패", "policy", policy.Name, "deleted", result.Deleted, "error", err)
+			return results, err
+		}
+	}
+
+	var total int64
+	attrs := make([]any, 0, len(results))
+	for _, result := range results {
+		total += result.Deleted
+		attrs = append(attrs, slog.Int64(result.Policy, result.Deleted))
+	}
+	slog.InfoContext(ctx, "보관 정책 실행 완료", "total_deleted", total, slog.Group("deleted", attrs...))
+	return results, nil
+}
+
+func (r *Runner) runPolicy(ctx context.Context, policy Policy, cutoff time.Time, batchSize int) (Result, error) {
+	result := Result{Policy: policy.Name, Cutoff: cutoff}
+	db := r.DB.WithContext(ctx)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		var deleted int64
+		var selected int
+		err := db.Transaction(func(tx *gorm.DB) error {
+			ids, err := policy.selectIDs(tx, cutoff, batchSize)
+			if err != nil {
+				return err
+			}
+			selected = len(ids)
+			deleted, err = policy.purge(tx, ids)
+			return err
+		})
+		if err != nil {
+			return result, err
+		}
+		if selected == 0 {
+			return result, nil
+		}
+
+		result.Deleted += deleted
+		result.Batches++
+		if selected < batchSize {
+			return result, nil
+		}
+	}
+}
+
+func days(n int) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
+
+func envInt(key string, fallback int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil && value >= 0 {
+		return value
+	}
+	return fallback
+}
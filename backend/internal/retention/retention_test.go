@@ -0,0 +1,126 @@
+package retention
+
+import (
+	"context"
+	"lunch_app/backend/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var fixedNow = time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+
+func setupDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Restaurant{}, &models.Visit{}, &models.Review{}, &models.ReviewImage{}, &models.Bookmark{}, &models.OpeningHours{}, &models.RestaurantHoliday{}))
+	return db
+}
+
+func newTestRunner(db *gorm.DB, cfg Config) *Runner {
+	runner := NewRunner(db, cfg)
+	runner.Now = func() time.Time { return fixedNow }
+	return runner
+}
+
+// deletedAt now 기준 daysAgo일 전에 soft delete된 것으로 기록
+func deletedAt(daysAgo int) gorm.DeletedAt {
+	return gorm.DeletedAt{Time: fixedNow.AddDate(0, 0, -daysAgo), Valid: true}
+}
+
+func TestRun_PurgesExpiredRestaurantsOnly(t *testing.T) {
+	db := setupDB(t)
+	expired := models.Restaurant{Name: "오래 전 삭제", Address: "주소1", Model: gorm.Model{DeletedAt: deletedAt(31)}}
+	recent := models.Restaurant{Name: "최근 삭제", Address: "주소2", Model: gorm.Model{DeletedAt: deletedAt(5)}}
+	active := models.Restaurant{Name: "영업 중", Address: "주소3"}
+	require.NoError(t, db.Create(&[]*models.Restaurant{&expired, &recent, &active}).Error)
+
+	visit := models.Visit{RestaurantID: expired.ID, RestaurantSnapshot: expired.Snapshot(), VisitDate: fixedNow}
+	require.NoError(t, db.Create(&visit).Error)
+	require.NoError(t, db.Create(&models.Review{RestaurantID: expired.ID, Content: "맛있음"}).Error)
+
+	results, err := newTestRunner(db, Config{RestaurantMaxAge: days(30)}).Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "restaurants.deleted", results[0].Policy)
+	assert.Equal(t, int64(1), results[0].Deleted)
+
+	var ids []uint
+	db.Unscoped().Model(&models.Restaurant{}).Order("id").Pluck("id", &ids)
+	assert.Equal(t, []uint{recent.ID, active.ID}, ids)
+
+	var reviews int64
+	db.Unscoped().Model(&models.Review{}).Count(&reviews)
+	assert.Zero(t, reviews)
+
+	// 방문 기록은 스냅샷과 함께 남음
+	var kept models.Visit
+	require.NoError(t, db.First(&kept, visit.ID).Error)
+	assert.Equal(t, uint(0), kept.RestaurantID)
+	assert.Equal(t, "오래 전 삭제", kept.RestaurantSnapshot.Name)
+}
+
+func TestRun_DeletesInBatches(t *testing.T) {
+	db := setupDB(t)
+	visits := make([]models.Visit, 7)
+	for i := range visits {
+		visits[i] = models.Visit{VisitDate: fixedNow, DeletedAt: deletedAt(40)}
+	}
+	require.NoError(t, db.Create(&visits).Error)
+
+	runner := newTestRunner(db, Config{DeletedVisitMaxAge: days(30)})
+	runner.BatchSize = 3
+	results, err := runner.Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, int64(7), results[0].Deleted)
+	assert.Equal(t, 3, results[0].Batches)
+
+	var count int64
+	db.Unscoped().Model(&models.Visit{}).Count(&count)
+	assert.Zero(t, count)
+}
+
+func TestRun_OldVisitsUseVisitDate(t *testing.T) {
+	db := setupDB(t)
+	old := models.Visit{VisitDate: fixedNow.AddDate(-2, 0, 0)}
+	recent := models.Visit{VisitDate: fixedNow.AddDate(0, -1, 0)}
+	require.NoError(t, db.Create(&[]*models.Visit{&old, &recent}).Error)
+
+	_, err := newTestRunner(db, Config{VisitMaxAge: days(365)}).Run(context.Background())
+	require.NoError(t, err)
+
+	var ids []uint
+	db.Unscoped().Model(&models.Visit{}).Pluck("id", &ids)
+	assert.Equal(t, []uint{recent.ID}, ids)
+}
+
+func TestRun_SkipsDisabledPolicies(t *testing.T) {
+	db := setupDB(t)
+	require.NoError(t, db.Create(&models.Visit{VisitDate: fixedNow.AddDate(-10, 0, 0)}).Error)
+
+	results, err := newTestRunner(db, Config{}).Run(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	var count int64
+	db.Model(&models.Visit{}).Count(&count)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("TRASH_RETENTION_DAYS", "7")
+	t.Setenv("DELETED_VISIT_RETENTION_DAYS", "invalid")
+	t.Setenv("VISIT_RETENTION_DAYS", "")
+	t.Setenv("RETENTION_BATCH_SIZE", "100")
+
+	cfg := ConfigFromEnv()
+	assert.Equal(t, days(7), cfg.RestaurantMaxAge)
+	assert.Equal(t, days(defaultDeletedVisitDays), cfg.DeletedVisitMaxAge)
+	assert.Zero(t, cfg.VisitMaxAge)
+	assert.Equal(t, 100, cfg.BatchSize)
+}
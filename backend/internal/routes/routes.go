@@ -1,17 +1,38 @@
 package routes
 
 import (
+	"lunch_app/backend/internal/apierror"
 	"lunch_app/backend/internal/handlers"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/metrics"
+	"lunch_app/backend/internal/middleware"
+	"lunch_app/backend/internal/openapi"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
 
 func Setup(router *gin.Engine) {
+	// 등록되지 않은 경로와 메서드도 공통 에러 형식으로 응답
+	router.HandleMethodNotAllowed = true
+	router.NoRoute(func(c *gin.Context) {
+		apierror.Abort(c, http.StatusNotFound, apierror.CodeRouteNotFound, i18n.Message(c, i18n.RouteNotFound))
+	})
+	router.NoMethod(func(c *gin.Context) {
+		apierror.Abort(c, http.StatusMethodNotAllowed, apierror.CodeMethodNotAllowed, i18n.Message(c, i18n.RouteMethodNotAllowed))
+	})
+
 	// Health check endpoint (outside API group for simplicity)
 	router.GET("/health", handlers.HealthCheck)
+	// Prometheus metrics endpoint
+	router.GET("/metrics", metrics.Handler())
 	
 	api := router.Group("/api")
 	{
+		// API 문서 (OpenAPI 3 + Swagger UI)
+		api.GET("/openapi.json", openapi.Handler)
+		api.GET("/docs", openapi.SwaggerUI)
+
 		// Restaurant routes
 		restaurantRoutes := api.Group("/restaurants")
 		{
@@ -19,6 +40,16 @@ func Setup(router *gin.Engine) {
 			restaurantRoutes.GET("/:id", handlers.GetRestaurantByID)
 			restaurantRoutes.POST("/", handlers.CreateRestaurant)
 			restaurantRoutes.DELETE("/:id", handlers.DeleteRestaurant)
+			restaurantRoutes.GET("/duplicates", handlers.FindDuplicateRestaurants)
+			restaurantRoutes.POST("/:id/merge", handlers.MergeRestaurants)
+			restaurantRoutes.GET("/external/:source", handlers.ListRestaurantsByExternalIDs)
+			restaurantRoutes.GET("/external/:source/:externalId", handlers.GetRestaurantByExternalID)
+
+			// 영업시간 - 요일별 영업시간·브레이크타임과 날짜별 예외 (Asia/Seoul)
+			restaurantRoutes.GET("/:id/hours", handlers.GetOpeningHours)
+			restaurantRoutes.PUT("/:id/hours", handlers.UpdateOpeningHours)
+			restaurantRoutes.PUT("/:id/holidays/:date", handlers.SetHoliday)
+			restaurantRoutes.DELETE("/:id/holidays/:date", handlers.DeleteHoliday)
 		}
 
 		// Visit routes - 추가
@@ -29,5 +60,101 @@ func Setup(router *gin.Engine) {
 			visitRoutes.PUT("/:id", handlers.UpdateVisit)
 			visitRoutes.DELETE("/:id", handlers.DeleteVisit)
 		}
+
+		// 휴지통 - soft delete된 맛집 조회/복원
+		trashRoutes := api.Group("/trash")
+		{
+			trashRoutes.GET("/restaurants", handlers.ListTrashedRestaurants)
+			trashRoutes.POST("/restaurants/:id/restore", handlers.RestoreRestaurant)
+		}
+
+		// 관리자 전용 (ADMIN_TOKEN Bearer 인증)
+		adminRoutes := api.Group("/admin", middleware.AdminAuth())
+		{
+			adminRoutes.DELETE("/trash/restaurants", handlers.PurgeTrashedRestaurants)
+
+			// 팀별 아웃고잉 웹훅과 배달 기록
+			adminRoutes.GET("/webhooks", handlers.ListWebhooks)
+			adminRoutes.POST("/webhooks", handlers.CreateWebhook)
+			adminRoutes.GET("/webhooks/:id", handlers.GetWebhook)
+			adminRoutes.PUT("/webhooks/:id", handlers.UpdateWebhook)
+			adminRoutes.DELETE("/webhooks/:id", handlers.DeleteWebhook)
+			adminRoutes.GET("/webhooks/:id/deliveries", handlers.ListWebhookDeliveries)
+			adminRoutes.POST("/webhooks/:id/deliveries/:deliveryId/redeliver", handlers.RedeliverWebhook)
+
+			// 아침 점심 다이제스트 메일 구독과 미리보기·수동 발송
+			adminRoutes.GET("/digest/subscriptions", handlers.ListDigestSubscriptions)
+			adminRoutes.POST("/digest/subscriptions", handlers.SubscribeDigest)
+			adminRoutes.DELETE("/digest/subscriptions/:id", handlers.DeleteDigestSubscription)
+			adminRoutes.GET("/digest/subscriptions/:id/preview", handlers.PreviewDigest)
+			adminRoutes.POST("/digest/subscriptions/:id/send", handlers.SendDigest)
+
+			// API 서버 안의 예약 작업 (cron 일정, 실행 기록, 수동 실행)
+			adminRoutes.GET("/jobs", handlers.ListJobs)
+			adminRoutes.GET("/jobs/:name/runs", handlers.ListJobRuns)
+			adminRoutes.POST("/jobs/:name/run", handlers.RunJob)
+		}
+
+		// 다이제스트 메일의 구독 해지 링크 (POST는 메일 앱의 원클릭 해지)
+		api.GET("/digest/unsubscribe", handlers.UnsubscribeDigest)
+		api.POST("/digest/unsubscribe", handlers.UnsubscribeDigest)
+
+		// 점심 투표 - 마감 시 투표자 방문 기록 자동 생성
+		pollRoutes := api.Group("/polls")
+		{
+			pollRoutes.GET("", handlers.ListPolls)
+			pollRoutes.POST("", handlers.CreatePoll)
+			pollRoutes.GET("/:id", handlers.GetPoll)
+			pollRoutes.POST("/:id/votes", handlers.VotePoll)
+			pollRoutes.POST("/:id/close", handlers.ClosePoll)
+		}
+
+		// 점심 룰렛 - 서버 추첨 기록과 검증, 팀별 하루 다시 뽑기 제한
+		drawRoutes := api.Group("/draws")
+		{
+			drawRoutes.GET("", handlers.ListDraws)
+			drawRoutes.POST("", handlers.CreateDraw)
+			drawRoutes.GET("/:id", handlers.GetDraw)
+			drawRoutes.GET("/:id/verify", handlers.VerifyDraw)
+		}
+
+		// 실시간 변경 이벤트 (Server-Sent Events)
+		api.GET("/events", handlers.StreamEvents)
+
+		// 점심 방 - WebSocket 실시간 투표와 룰렛
+		api.GET("/rooms/:room", handlers.GetRoom)
+		api.GET("/rooms/:room/ws", handlers.JoinRoom)
+
+		// 길찾기 - OSRM 경로 (실패 시 직선 거리 추정)
+		api.GET("/directions", handlers.GetDirections)
+
+		// Slack 슬래시 커맨드와 버튼 인터랙션 (Slack 서명 검증)
+		slackRoutes := api.Group("/slack", middleware.SlackSignature())
+		{
+			slackRoutes.POST("/commands", handlers.SlackCommand)
+			slackRoutes.POST("/interactions", handlers.SlackInteraction)
+		}
+
+		// 카카오톡 챗봇 - 카카오 i 오픈빌더 스킬 (KAKAO_SKILL_TOKEN 헤더 인증)
+		api.POST("/kakao/skill", middleware.KakaoSkill(), handlers.KakaoSkill)
+
+		// 감사 로그 (관리자 전용)
+		api.GET("/audit", middleware.AdminAuth(), handlers.ListAuditLogs)
+
+		// v2 - camelCase DTO 응답 (v1은 기존 프론트엔드 호환을 위해 유지)
+		v2 := api.Group("/v2")
+		{
+			v2.GET("/restaurants", handlers.ListRestaurantsV2)
+			v2.GET("/restaurants/nearby", handlers.NearbyRestaurantsV2)
+			v2.GET("/restaurants/recommendations", handlers.RecommendRestaurantsV2)
+			v2.GET("/restaurants/:id", handlers.GetRestaurantV2)
+			v2.POST("/restaurants", handlers.CreateRestaurantV2)
+			v2.DELETE("/restaurants/:id", handlers.DeleteRestaurantV2)
+
+			v2.GET("/visits", handlers.ListVisitsV2)
+			v2.POST("/visits", handlers.CreateVisitV2)
+			v2.PUT("/visits/:id", handlers.UpdateVisitV2)
+			v2.DELETE("/visits/:id", handlers.DeleteVisitV2)
+		}
 	}
 }
@@ -0,0 +1,118 @@
+package routing
+
+import (
+	"container/list"
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// cachePrecision 캐시 키로 쓸 좌표 소수점 자릿수 (소수 넷째 자리 ≈ 11m)
+const cachePrecision = 4
+
+type cacheKey struct {
+	mode             Mode
+	fromLat, fromLng int64
+	toLat, toLng     int64
+}
+
+type cacheItem struct {
+	key     cacheKey
+	route   Route
+	expires time.Time
+}
+
+// Cache 출발지·도착지 좌표를 반올림한 키로 경로를 캐시하는 Router
+// 에러는 캐시하지 않으며, 가득 차면 가장 오래 사용하지 않은 항목부터 제거 (LRU)
+type Cache struct {
+	next       Router
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[cacheKey]*list.Element
+	order   *list.List
+}
+
+// NewCache next의 결과를 ttl 동안 최대 maxEntries개까지 캐시
+func NewCache(next Router, ttl time.Duration, maxEntries int) *Cache {
+	return &Cache{
+		next:       next,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    map[cacheKey]*list.Element{},
+		order:      list.New(),
+	}
+}
+
+// Route Router 구현
+func (c *Cache) Route(ctx context.Context, mode Mode, from, to Point) (Route, error) {
+	key := newCacheKey(mode, from, to)
+	if route, ok := c.get(key); ok {
+		return route, nil
+	}
+
+	route, err := c.next.Route(ctx, mode, from, to)
+	if err != nil {
+		return route, err
+	}
+	c.set(key, route)
+	return route, nil
+}
+
+// Len 캐시된 항목 수 (만료됐지만 아직 제거되지 않은 항목 포함)
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *Cache) get(key cacheKey) (Route, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return Route{}, false
+	}
+	item := element.Value.(*cacheItem)
+	if !c.now().Before(item.expires) {
+		c.remove(element)
+		return Route{}, false
+	}
+	c.order.MoveToFront(element)
+	return item.route, true
+}
+
+func (c *Cache) set(key cacheKey, route Route) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+	c.entries[key] = c.order.PushFront(&cacheItem{key: key, route: route, expires: c.now().Add(c.ttl)})
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *Cache) remove(element *list.Element) {
+	delete(c.entries, element.Value.(*cacheItem).key)
+	c.order.Remove(element)
+}
+
+func newCacheKey(mode Mode, from, to Point) cacheKey {
+	return cacheKey{
+		mode:    mode,
+		fromLat: round(from.Latitude),
+		fromLng: round(from.Longitude),
+		toLat:   round(to.Latitude),
+		toLng:   round(to.Longitude),
+	}
+}
+
+func round(v float64) int64 {
+	return int64(math.Round(v * math.Pow10(cachePrecision)))
+}
@@ -0,0 +1,35 @@
+package routing
+
+import (
+	"context"
+	"lunch_app/backend/internal/geo"
+	"math"
+)
+
+// 직선 거리로 소요 시간을 추정할 때의 평균 속도 (프론트엔드 RecommendTab과 같은 값)
+const (
+	// WalkingMetersPerMinute 도보 평균 시속 4km
+	WalkingMetersPerMinute = 67.0
+	// DrivingMetersPerMinute 도심 차량 평균 시속 25km
+	DrivingMetersPerMinute = 417.0
+)
+
+// Haversine 직선 거리와 평균 속도로 경로를 추정하는 Router (외부 호출 없음, 최소 1분)
+type Haversine struct{}
+
+// Route Router 구현
+func (Haversine) Route(ctx context.Context, mode Mode, from, to Point) (Route, error) {
+	distance := geo.DistanceMeters(from.Latitude, from.Longitude, to.Latitude, to.Longitude)
+	speed := WalkingMetersPerMinute
+	if mode == ModeDriving {
+		speed = DrivingMetersPerMinute
+	}
+	minutes := math.Max(1, math.Round(distance/speed))
+	return Route{
+		Mode:            mode,
+		DistanceMeters:  distance,
+		DurationSeconds: minutes * 60,
+		Geometry:        []Point{from, to},
+		Source:          SourceEstimate,
+	}, nil
+}
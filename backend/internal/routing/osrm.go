@@ -0,0 +1,118 @@
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultOSRMBaseURL OSRM 공개 데모 서버 (사용량 제한이 있으므로 운영에서는 자체 서버 권장)
+const DefaultOSRMBaseURL = "https://router.project-osrm.org"
+
+const defaultOSRMTimeout = 5 * time.Second
+
+// osrmProfiles 이동 수단별 OSRM 프로필
+var osrmProfiles = map[Mode]string{
+	ModeWalking: "foot",
+	ModeDriving: "driving",
+}
+
+// OSRMClient OSRM route 서비스로 실제 도로 경로를 계산하는 Router
+type OSRMClient struct {
+	// BaseURL OSRM 서버 주소 (로컬 OSRM이나 테스트 서버로 교체 가능)
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewOSRMClient baseURL이 비어 있으면 공개 데모 서버를 쓰는 클라이언트 생성
+func NewOSRMClient(baseURL string) *OSRMClient {
+	if baseURL == "" {
+		baseURL = DefaultOSRMBaseURL
+	}
+	return &OSRMClient{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		HTTPClient: &http.Client{Timeout: defaultOSRMTimeout},
+	}
+}
+
+// APIError OSRM이 실패 응답을 보냄
+type APIError struct {
+	StatusCode int
+	// Code OSRM 응답 코드 (예: InvalidQuery)
+	Code    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("routing: osrm %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// osrmResponse route 서비스 응답 (좌표는 [경도, 위도] 순서)
+type osrmResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Routes  []struct {
+		Distance float64 `json:"distance"`
+		Duration float64 `json:"duration"`
+		Geometry struct {
+			Coordinates [][2]float64 `json:"coordinates"`
+		} `json:"geometry"`
+	} `json:"routes"`
+}
+
+// Route Router 구현 (/route/v1/{profile}/{경도,위도;경도,위도})
+func (o *OSRMClient) Route(ctx context.Context, mode Mode, from, to Point) (Route, error) {
+	profile, ok := osrmProfiles[mode]
+	if !ok {
+		return Route{}, fmt.Errorf("routing: unsupported mode %q", mode)
+	}
+	url := fmt.Sprintf("%s/route/v1/%s/%s;%s?overview=full&geometries=geojson",
+		o.BaseURL, profile, formatPoint(from), formatPoint(to))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Route{}, err
+	}
+
+	client := o.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Route{}, fmt.Errorf("routing: osrm request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// OSRM은 실패해도 {"code","message"} JSON으로 응답하므로 상태 코드와 관계없이 디코딩
+	var body osrmResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return Route{}, fmt.Errorf("routing: decode osrm response (status %d): %w", resp.StatusCode, err)
+	}
+	if body.Code == "NoRoute" || (body.Code == "Ok" && len(body.Routes) == 0) {
+		return Route{}, ErrNoRoute
+	}
+	if resp.StatusCode != http.StatusOK || body.Code != "Ok" {
+		return Route{}, &APIError{StatusCode: resp.StatusCode, Code: body.Code, Message: body.Message}
+	}
+
+	first := body.Routes[0]
+	geometry := make([]Point, 0, len(first.Geometry.Coordinates))
+	for _, coord := range first.Geometry.Coordinates {
+		geometry = append(geometry, Point{Latitude: coord[1], Longitude: coord[0]})
+	}
+	return Route{
+		Mode:            mode,
+		DistanceMeters:  first.Distance,
+		DurationSeconds: first.Duration,
+		Geometry:        geometry,
+		Source:          SourceOSRM,
+	}, nil
+}
+
+func formatPoint(p Point) string {
+	return strconv.FormatFloat(p.Longitude, 'f', -1, 64) + "," + strconv.FormatFloat(p.Latitude, 'f', -1, 64)
+}
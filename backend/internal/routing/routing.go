@@ -0,0 +1,99 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// Mode 이동 수단
+type Mode string
+
+const (
+	ModeWalking Mode = "walking"
+	ModeDriving Mode = "driving"
+)
+
+// Valid 지원하는 이동 수단인지 확인
+func (m Mode) Valid() bool {
+	return m == ModeWalking || m == ModeDriving
+}
+
+// 경로 계산 출처
+const (
+	SourceOSRM     = "osrm"
+	SourceEstimate = "estimate"
+)
+
+// ErrNoRoute 두 지점 사이에 경로가 없음
+var ErrNoRoute = errors.New("routing: no route found")
+
+// Point 위경도 좌표
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Route 경로 계산 결과
+type Route struct {
+	Mode            Mode
+	DistanceMeters  float64
+	DurationSeconds float64
+	// Geometry 경로 좌표 (추정 경로는 출발지와 도착지 두 점)
+	Geometry []Point
+	// Source 계산 출처 (SourceOSRM 또는 SourceEstimate)
+	Source string
+}
+
+// Router 두 지점 사이의 경로를 계산하는 인터페이스
+type Router interface {
+	Route(ctx context.Context, mode Mode, from, to Point) (Route, error)
+}
+
+// Fallback Primary가 실패하면 Secondary로 계산하는 Router
+// 요청이 취소된 경우에는 대체하지 않고 에러를 그대로 반환
+type Fallback struct {
+	Primary   Router
+	Secondary Router
+}
+
+// Route Router 구현
+func (f Fallback) Route(ctx context.Context, mode Mode, from, to Point) (Route, error) {
+	route, err := f.Primary.Route(ctx, mode, from, to)
+	if err == nil || ctx.Err() != nil {
+		return route, err
+	}
+	slog.WarnContext(ctx, "경로 계산 실패, 추정 경로로 대체", "mode", mode, "error", err)
+	return f.Secondary.Route(ctx, mode, from, to)
+}
+
+const (
+	defaultCacheTTL        = time.Hour
+	defaultCacheMaxEntries = 10000
+)
+
+// NewFromEnv 환경변수에 따라 경로 계산기 생성
+// 캐시한 OSRM 결과를 쓰고, OSRM이 실패하면 직선 거리 추정으로 대체
+//
+//	OSRM_BASE_URL    OSRM 서버 주소 (기본 https://router.project-osrm.org, "off"면 추정만 사용)
+//	ROUTE_CACHE_TTL  경로 캐시 유지 시간, Go duration 형식 (기본 1h, 0이면 캐시하지 않음)
+func NewFromEnv() Router {
+	baseURL := os.Getenv("OSRM_BASE_URL")
+	if baseURL == "off" {
+		return Haversine{}
+	}
+
+	var primary Router = NewOSRMClient(baseURL)
+	ttl := defaultCacheTTL
+	if value := os.Getenv("ROUTE_CACHE_TTL"); value != "" {
+		if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+			ttl = d
+		}
+	}
+	if ttl > 0 {
+		primary = NewCache(primary, ttl, defaultCacheMaxEntries)
+	}
+	return Fallback{Primary: primary, Secondary: Haversine{}}
+}
@@ -0,0 +1,177 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	gangnam = Point{Latitude: 37.4979, Longitude: 127.0276}
+	yeoksam = Point{Latitude: 37.5006, Longitude: 127.0364}
+)
+
+// countingRouter 호출 횟수를 세고 고정 결과를 반환하는 Router
+type countingRouter struct {
+	calls int
+	err   error
+}
+
+func (r *countingRouter) Route(ctx context.Context, mode Mode, from, to Point) (Route, error) {
+	r.calls++
+	if r.err != nil {
+		return Route{}, r.err
+	}
+	return Route{Mode: mode, DistanceMeters: 1000, DurationSeconds: 720, Source: SourceOSRM}, nil
+}
+
+func TestOSRMClient_Route(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"code":"Ok","routes":[{"distance":1043.2,"duration":751.5,
+			"geometry":{"type":"LineString","coordinates":[[127.0276,37.4979],[127.03,37.499],[127.0364,37.5006]]}}]}`))
+	}))
+	defer server.Close()
+
+	route, err := NewOSRMClient(server.URL+"/").Route(context.Background(), ModeWalking, gangnam, yeoksam)
+	require.NoError(t, err)
+	assert.Equal(t, "/route/v1/foot/127.0276,37.4979;127.0364,37.5006", path)
+	assert.Equal(t, 1043.2, route.DistanceMeters)
+	assert.Equal(t, 751.5, route.DurationSeconds)
+	assert.Equal(t, SourceOSRM, route.Source)
+	require.Len(t, route.Geometry, 3)
+	assert.Equal(t, gangnam, route.Geometry[0], "OSRM의 [경도, 위도]를 위도·경도로 변환")
+}
+
+func TestOSRMClient_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		check  func(t *testing.T, err error)
+	}{
+		{"no route", http.StatusOK, `{"code":"NoRoute","message":"Impossible route"}`, func(t *testing.T, err error) {
+			assert.ErrorIs(t, err, ErrNoRoute)
+		}},
+		{"invalid query", http.StatusBadRequest, `{"code":"InvalidQuery","message":"Query string malformed"}`, func(t *testing.T, err error) {
+			var apiErr *APIError
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+			assert.Equal(t, "InvalidQuery", apiErr.Code)
+		}},
+		{"not json", http.StatusBadGateway, `<html>bad gateway</html>`, func(t *testing.T, err error) {
+			assert.ErrorContains(t, err, "status 502")
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, err := NewOSRMClient(server.URL).Route(context.Background(), ModeDriving, gangnam, yeoksam)
+			require.Error(t, err)
+			tt.check(t, err)
+		})
+	}
+}
+
+func TestHaversine_Route(t *testing.T) {
+	walking, err := Haversine{}.Route(context.Background(), ModeWalking, gangnam, yeoksam)
+	require.NoError(t, err)
+	driving, err := Haversine{}.Route(context.Background(), ModeDriving, gangnam, yeoksam)
+	require.NoError(t, err)
+
+	assert.Equal(t, SourceEstimate, walking.Source)
+	assert.Equal(t, []Point{gangnam, yeoksam}, walking.Geometry)
+	assert.InDelta(t, 830, walking.DistanceMeters, 20)
+	assert.Equal(t, float64(12*60), walking.DurationSeconds, "830m / 67m/min ≈ 12분")
+	assert.Equal(t, float64(2*60), driving.DurationSeconds, "830m / 417m/min ≈ 2분")
+
+	// 같은 위치여도 최소 1분
+	same, err := Haversine{}.Route(context.Background(), ModeWalking, gangnam, gangnam)
+	require.NoError(t, err)
+	assert.Equal(t, float64(60), same.DurationSeconds)
+}
+
+func TestFallback(t *testing.T) {
+	failing := &countingRouter{err: errors.New("osrm down")}
+	route, err := Fallback{Primary: failing, Secondary: Haversine{}}.Route(context.Background(), ModeWalking, gangnam, yeoksam)
+	require.NoError(t, err)
+	assert.Equal(t, SourceEstimate, route.Source)
+
+	// 취소된 요청은 대체하지 않음
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	failing.err = context.Canceled
+	_, err = Fallback{Primary: failing, Secondary: Haversine{}}.Route(ctx, ModeWalking, gangnam, yeoksam)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestCache(t *testing.T) {
+	next := &countingRouter{}
+	cache := NewCache(next, time.Minute, 2)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, err := cache.Route(ctx, ModeWalking, gangnam, yeoksam)
+	require.NoError(t, err)
+	// 약 1m 떨어진 좌표는 반올림하면 같은 키
+	nearby := Point{Latitude: gangnam.Latitude + 0.00001, Longitude: gangnam.Longitude - 0.00001}
+	_, err = cache.Route(ctx, ModeWalking, nearby, yeoksam)
+	require.NoError(t, err)
+	assert.Equal(t, 1, next.calls)
+
+	// 이동 수단이 다르면 다른 키
+	_, err = cache.Route(ctx, ModeDriving, gangnam, yeoksam)
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.calls)
+
+	// 만료 후 다시 계산
+	now = now.Add(time.Minute)
+	_, err = cache.Route(ctx, ModeWalking, gangnam, yeoksam)
+	require.NoError(t, err)
+	assert.Equal(t, 3, next.calls)
+
+	// 최대 개수를 넘으면 가장 오래 사용하지 않은 항목 제거
+	_, err = cache.Route(ctx, ModeWalking, yeoksam, gangnam)
+	require.NoError(t, err)
+	assert.Equal(t, 2, cache.Len())
+	_, err = cache.Route(ctx, ModeDriving, gangnam, yeoksam)
+	require.NoError(t, err)
+	assert.Equal(t, 5, next.calls, "driving 항목은 LRU로 제거됨")
+
+	// 에러는 캐시하지 않음
+	next.err = errors.New("osrm down")
+	far := Point{Latitude: 35.1796, Longitude: 129.0756}
+	_, err = cache.Route(ctx, ModeWalking, gangnam, far)
+	require.Error(t, err)
+	_, err = cache.Route(ctx, ModeWalking, gangnam, far)
+	require.Error(t, err)
+	assert.Equal(t, 7, next.calls)
+}
+
+func TestNewFromEnv(t *testing.T) {
+	t.Setenv("OSRM_BASE_URL", "off")
+	assert.IsType(t, Haversine{}, NewFromEnv())
+
+	t.Setenv("OSRM_BASE_URL", "http://localhost:5000")
+	t.Setenv("ROUTE_CACHE_TTL", "0")
+	router, ok := NewFromEnv().(Fallback)
+	require.True(t, ok)
+	osrm, ok := router.Primary.(*OSRMClient)
+	require.True(t, ok, "TTL 0이면 캐시 없이 OSRM 직접 호출")
+	assert.True(t, strings.HasPrefix(osrm.BaseURL, "http://localhost:5000"))
+}
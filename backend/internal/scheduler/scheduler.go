@@ -0,0 +1,501 @@
+// Package scheduler API 서버 안에서 도는 cron 예약 작업
+//
+// 작업마다 job_leases에 다음 실행 시각과 잠금을 두고, 조건부 UPDATE로 잠금을 잡은 인스턴스만
+// 실행하므로 여러 인스턴스가 떠 있어도 한 일정에 한 번만 실행됨. 실행 결과는 job_runs에 남김.
+package scheduler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"lunch_app/backend/internal/models"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// Off 예약 실행하지 않는 작업의 일정 (관리자 API로 수동 실행만 가능)
+	Off = "off"
+	// DefaultTimeout 작업 한 번의 기본 최대 실행 시간이자 잠금 유지 시간
+	DefaultTimeout = 10 * time.Minute
+
+	// defaultTick 실행할 작업을 확인하는 기본 주기
+	defaultTick = 15 * time.Second
+	// defaultHistoryLimit 작업마다 남기는 기본 실행 기록 수
+	defaultHistoryLimit = 100
+	maxSummaryLength    = 512
+	maxErrorLength      = 1024
+)
+
+var (
+	// ErrNotFound 등록되지 않은 작업
+	ErrNotFound = errors.New("scheduler: job not found")
+	// ErrRunning 다른 실행(다른 인스턴스 포함)이 잠금을 잡고 있음
+	ErrRunning = errors.New("scheduler: job is running")
+)
+
+// Func 작업 본문 (summary는 실행 기록에 남음)
+type Func func(ctx context.Context) (summary string, err error)
+
+// Job 예약 작업
+type Job struct {
+	// Name 작업 이름 (job_leases 키, 관리자 API 경로에 씀)
+	Name        string
+	Description string
+	// Spec cron 표현식 (Off면 수동 실행만)
+	Spec string
+	// Timeout 최대 실행 시간 (0이면 DefaultTimeout), 넘으면 ctx가 취소되고 잠금이 풀림
+	Timeout time.Duration
+	Run     Func
+
+	schedule *Schedule
+}
+
+// Enabled 예약 실행하는 작업인지
+func (j Job) Enabled() bool {
+	return j.schedule != nil
+}
+
+func (j Job) timeout() time.Duration {
+	if j.Timeout > 0 {
+		return j.Timeout
+	}
+	return DefaultTimeout
+}
+
+// next now 이후 다음 예약 실행 시각 (예약 실행하지 않으면 nil)
+func (j Job) next(now time.Time, loc *time.Location) *time.Time {
+	if j.schedule == nil {
+		return nil
+	}
+	next := j.schedule.Next(now, loc)
+	if next.IsZero() {
+		return nil
+	}
+	next = next.UTC()
+	return &next
+}
+
+// Config 스케줄러 설정
+type Config struct {
+	// Location cron 표현식을 해석하는 시간대
+	Location *time.Location
+	// Tick 실행할 작업을 확인하는 주기 (0이면 예약 실행하지 않고 수동 실행만)
+	Tick time.Duration
+	// HistoryLimit 작업마다 남기는 실행 기록 수
+	HistoryLimit int
+	// Owner 이 인스턴스의 이름 (비우면 호스트 이름과 PID로 만듦)
+	Owner string
+}
+
+// ConfigFromEnv 환경변수에서 스케줄러 설정을 읽음 (잘못된 값이면 기본값)
+//
+//	JOB_TIMEZONE       cron 시간대, IANA 이름 (기본 fallback, 팀 시간대 Asia/Seoul)
+//	JOB_TICK_INTERVAL  작업 확인 주기 (Go duration, 기본 15s, 0이면 예약 실행 비활성)
+//	JOB_HISTORY_LIMIT  작업마다 남기는 실행 기록 수 (기본 100)
+func ConfigFromEnv(fallback *time.Location) Config {
+	cfg := Config{Location: fallback, Tick: defaultTick, HistoryLimit: defaultHistoryLimit}
+	if name := os.Getenv("JOB_TIMEZONE"); name != "" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			cfg.Location = loc
+		} else {
+			slog.Warn("JOB_TIMEZONE 해석 실패, 기본 시간대 사용", "timezone", name, "error", err)
+		}
+	}
+	value := os.Getenv("JOB_TICK_INTERVAL")
+	if value == "0" {
+		cfg.Tick = 0
+	} else if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		cfg.Tick = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("JOB_HISTORY_LIMIT")); err == nil && n > 0 {
+		cfg.HistoryLimit = n
+	}
+	return cfg
+}
+
+// SpecFromEnv JOB_<NAME>_SCHEDULE 환경변수의 일정 (없으면 fallback, 예: poll-close → JOB_POLL_CLOSE_SCHEDULE)
+func SpecFromEnv(name, fallback string) string {
+	key := "JOB_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_SCHEDULE"
+	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// Status 작업과 잠금·최근 실행 상태
+type Status struct {
+	Job Job
+	// NextRunAt 다음 예약 실행 시각
+	NextRunAt *time.Time
+	// RunningOn 실행 중이면 잠금을 잡은 인스턴스 (아니면 빈 문자열)
+	RunningOn string
+	LastRun   *models.JobRun
+}
+
+// Scheduler 예약 작업 실행기
+type Scheduler struct {
+	db           *gorm.DB
+	loc          *time.Location
+	tick         time.Duration
+	historyLimit int
+	owner        string
+	now          func() time.Time
+
+	mu   sync.Mutex
+	jobs []*Job
+	wg   sync.WaitGroup
+}
+
+// New 스케줄러 생성 (작업은 Register로 등록한 뒤 Start)
+func New(db *gorm.DB, cfg Config) *Scheduler {
+	s := &Scheduler{
+		db:           db,
+		loc:          cfg.Location,
+		tick:         cfg.Tick,
+		historyLimit: cfg.HistoryLimit,
+		owner:        cfg.Owner,
+		now:          time.Now,
+	}
+	if s.loc == nil {
+		s.loc = time.UTC
+	}
+	if s.historyLimit <= 0 {
+		s.historyLimit = defaultHistoryLimit
+	}
+	if s.owner == "" {
+		s.owner = instanceName()
+	}
+	return s
+}
+
+// Location cron 표현식을 해석하는 시간대
+func (s *Scheduler) Location() *time.Location {
+	return s.loc
+}
+
+// Register 작업 등록 (cron 표현식이 잘못됐거나 이름이 겹치면 에러)
+func (s *Scheduler) Register(job Job) error {
+	if job.Name == "" || job.Run == nil {
+		return errors.New("scheduler: job needs a name and a run function")
+	}
+	job.Spec = strings.TrimSpace(job.Spec)
+	if !strings.EqualFold(job.Spec, Off) {
+		schedule, err := ParseSchedule(job.Spec)
+		if err != nil {
+			return err
+		}
+		job.schedule = &schedule
+	} else {
+		job.Spec = Off
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.find(job.Name) != nil {
+		return fmt.Errorf("scheduler: job %q already registered", job.Name)
+	}
+	s.jobs = append(s.jobs, &job)
+	return nil
+}
+
+// Jobs 등록한 작업 (등록 순서)
+func (s *Scheduler) Jobs() []Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	jobs := make([]Job, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		jobs = append(jobs, *job)
+	}
+	return jobs
+}
+
+// Start 잠금 행을 만들고 Tick마다 실행할 작업을 확인하는 고루틴 시작 (ctx 취소 시 종료)
+// 서버가 꺼져 있는 동안 지난 일정은 시작 후 한 번만 실행
+func (s *Scheduler) Start(ctx context.Context) {
+	now := s.clock()
+	for _, job := range s.Jobs() {
+		if err := s.ensureLease(ctx, job, now); err != nil {
+			slog.ErrorContext(ctx, "예약 작업 등록 실패", "job", job.Name, "error", err)
+		}
+	}
+	if s.tick <= 0 {
+		slog.Info("예약 작업 자동 실행 비활성화")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.RunDue(ctx)
+			}
+		}
+	}()
+	slog.Info("예약 작업 시작", "jobs", len(s.jobs), "timezone", s.loc.String(), "owner", s.owner)
+}
+
+// RunDue 다음 실행 시각이 지난 작업 중 잠금을 잡은 작업을 백그라운드로 실행하고 시작한 실행 기록을 반환
+func (s *Scheduler) RunDue(ctx context.Context) []models.JobRun {
+	var started []models.JobRun
+	for _, job := range s.Jobs() {
+		if !job.Enabled() {
+			continue
+		}
+		now := s.clock()
+		claimed, err := s.claim(ctx, job, now, true)
+		if err != nil {
+			slog.ErrorContext(ctx, "예약 작업 잠금 실패", "job", job.Name, "error", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		run, err := s.start(ctx, job, models.JobTriggerSchedule, now)
+		if err != nil {
+			slog.ErrorContext(ctx, "예약 작업 시작 실패", "job", job.Name, "error", err)
+			continue
+		}
+		started = append(started, run)
+	}
+	return started
+}
+
+// Trigger 작업을 지금 백그라운드로 실행 (예약 일정은 그대로)
+// 실행 중이면 ErrRunning, 없는 작업이면 ErrNotFound
+func (s *Scheduler) Trigger(ctx context.Context, name string) (models.JobRun, error) {
+	job, ok := s.Job(name)
+	if !ok {
+		return models.JobRun{}, ErrNotFound
+	}
+	now := s.clock()
+	if err := s.ensureLease(ctx, job, now); err != nil {
+		return models.JobRun{}, err
+	}
+	claimed, err := s.claim(ctx, job, now, false)
+	if err != nil {
+		return models.JobRun{}, err
+	}
+	if !claimed {
+		return models.JobRun{}, ErrRunning
+	}
+	// 요청이 끝나도 작업은 계속 실행
+	return s.start(context.WithoutCancel(ctx), job, models.JobTriggerManual, now)
+}
+
+// Job 이름으로 등록한 작업 조회
+func (s *Scheduler) Job(name string) (Job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if job := s.find(name); job != nil {
+		return *job, true
+	}
+	return Job{}, false
+}
+
+// Statuses 등록한 작업마다 다음 실행 시각, 실행 중 여부, 마지막 실행 기록
+func (s *Scheduler) Statuses(ctx context.Context) ([]Status, error) {
+	jobs := s.Jobs()
+	names := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		names = append(names, job.Name)
+	}
+	var leases []models.JobLease
+	if err := s.db.WithContext(ctx).Where("name IN ?", names).Find(&leases).Error; err != nil {
+		return nil, err
+	}
+	leaseByName := make(map[string]models.JobLease, len(leases))
+	for _, lease := range leases {
+		leaseByName[lease.Name] = lease
+	}
+
+	now := s.clock()
+	statuses := make([]Status, 0, len(jobs))
+	for _, job := range jobs {
+		status := Status{Job: job}
+		if lease, ok := leaseByName[job.Name]; ok {
+			status.NextRunAt = lease.NextRunAt
+			if lease.LockedUntil != nil && lease.LockedUntil.After(now) {
+				status.RunningOn = lease.Owner
+			}
+		} else {
+			status.NextRunAt = job.next(now, s.loc)
+		}
+		var last models.JobRun
+		err := s.db.WithContext(ctx).Where("job = ?", job.Name).Order("id DESC").Limit(1).Find(&last).Error
+		if err != nil {
+			return nil, err
+		}
+		if last.ID != 0 {
+			status.LastRun = &last
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Runs 작업의 최근 실행 기록 (최신순, 최대 limit개)
+func (s *Scheduler) Runs(ctx context.Context, name string, limit int) ([]models.JobRun, error) {
+	if _, ok := s.Job(name); !ok {
+		return nil, ErrNotFound
+	}
+	var runs []models.JobRun
+	err := s.db.WithContext(ctx).Where("job = ?", name).Order("id DESC").Limit(limit).Find(&runs).Error
+	return runs, err
+}
+
+// Wait 백그라운드로 실행 중인 작업이 모두 끝날 때까지 기다림
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// clock 현재 시각 (UTC, sqlite에서 문자열로 저장한 시각끼리 비교할 수 있도록 시간대를 맞춤)
+func (s *Scheduler) clock() time.Time {
+	return s.now().UTC()
+}
+
+func (s *Scheduler) find(name string) *Job {
+	for _, job := range s.jobs {
+		if job.Name == name {
+			return job
+		}
+	}
+	return nil
+}
+
+// ensureLease 작업의 잠금 행을 만들고, 일정이 바뀌었으면 다음 실행 시각을 다시 계산
+func (s *Scheduler) ensureLease(ctx context.Context, job Job, now time.Time) error {
+	db := s.db.WithContext(ctx)
+	next := job.next(now, s.loc)
+	lease := models.JobLease{Name: job.Name, Schedule: job.Spec, NextRunAt: next}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&lease).Error; err != nil {
+		return err
+	}
+	return db.Model(&models.JobLease{}).
+		Where("name = ? AND schedule <> ?", job.Name, job.Spec).
+		Updates(map[string]any{"schedule": job.Spec, "next_run_at": next}).Error
+}
+
+// claim 잠금이 비어 있으면 이 인스턴스가 잡음 (scheduled면 다음 실행 시각이 지났을 때만 잡고 다음 실행 시각을 옮김)
+func (s *Scheduler) claim(ctx context.Context, job Job, now time.Time, scheduled bool) (bool, error) {
+	updates := map[string]any{"owner": s.owner, "locked_until": now.Add(job.timeout())}
+	query := s.db.WithContext(ctx).Model(&models.JobLease{}).
+		Where("name = ? AND (locked_until IS NULL OR locked_until <= ?)", job.Name, now)
+	if scheduled {
+		query = query.Where("next_run_at <= ?", now)
+		updates["next_run_at"] = job.next(now, s.loc)
+	}
+	result := query.Updates(updates)
+	return result.RowsAffected == 1, result.Error
+}
+
+// start 잠금을 잡은 작업의 실행 기록을 만들고 백그라운드로 실행
+func (s *Scheduler) start(ctx context.Context, job Job, trigger string, now time.Time) (models.JobRun, error) {
+	db := s.db.WithContext(ctx)
+	// 잠금이 만료될 때까지 끝나지 않은 실행은 인스턴스가 멈춘 것으로 봄
+	err := db.Model(&models.JobRun{}).
+		Where("job = ? AND status = ?", job.Name, models.JobRunRunning).
+		Updates(map[string]any{"status": models.JobRunFailed, "error": "lease expired", "finished_at": now}).Error
+	if err != nil {
+		s.release(ctx, job)
+		return models.JobRun{}, err
+	}
+	run := models.JobRun{Job: job.Name, Trigger: trigger, Status: models.JobRunRunning, Owner: s.owner, StartedAt: now}
+	if err := db.Create(&run).Error; err != nil {
+		s.release(ctx, job)
+		return models.JobRun{}, err
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.execute(ctx, job, run)
+	}()
+	return run, nil
+}
+
+// execute 작업을 실행하고 결과를 기록한 뒤 잠금을 풂
+func (s *Scheduler) execute(ctx context.Context, job Job, run models.JobRun) {
+	runCtx, cancel := context.WithTimeout(ctx, job.timeout())
+	summary, err := runJob(runCtx, job)
+	cancel()
+
+	// ctx가 취소돼도 결과는 남김
+	ctx = context.WithoutCancel(ctx)
+	finished := s.clock()
+	run.FinishedAt = &finished
+	run.Summary = truncate(summary, maxSummaryLength)
+	run.Status = models.JobRunSucceeded
+	if err != nil {
+		run.Status = models.JobRunFailed
+		run.Error = truncate(err.Error(), maxErrorLength)
+		slog.ErrorContext(ctx, "예약 작업 실패", "job", job.Name, "trigger", run.Trigger, "error", err)
+	} else {
+		slog.InfoContext(ctx, "예약 작업 완료", "job", job.Name, "trigger", run.Trigger, "summary", summary, "duration", finished.Sub(run.StartedAt).String())
+	}
+
+	db := s.db.WithContext(ctx)
+	if err := db.Model(&run).Select("status", "summary", "error", "finished_at").Updates(&run).Error; err != nil {
+		slog.ErrorContext(ctx, "예약 작업 기록 실패", "job", job.Name, "error", err)
+	}
+	s.release(ctx, job)
+	// 작업마다 최근 historyLimit개만 남김
+	err = db.Where("job = ? AND id NOT IN (?)", job.Name,
+		db.Model(&models.JobRun{}).Select("id").Where("job = ?", job.Name).Order("id DESC").Limit(s.historyLimit),
+	).Delete(&models.JobRun{}).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "예약 작업 기록 정리 실패", "job", job.Name, "error", err)
+	}
+}
+
+// release 이 인스턴스가 잡은 잠금을 풂
+func (s *Scheduler) release(ctx context.Context, job Job) {
+	err := s.db.WithContext(context.WithoutCancel(ctx)).Model(&models.JobLease{}).
+		Where("name = ? AND owner = ?", job.Name, s.owner).
+		Update("locked_until", nil).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "예약 작업 잠금 해제 실패", "job", job.Name, "error", err)
+	}
+}
+
+// runJob 작업 본문 실행 (panic은 에러로 바꿈)
+func runJob(ctx context.Context, job Job) (summary string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return job.Run(ctx)
+}
+
+// truncate n바이트 안으로 자름 (잘린 UTF-8 글자는 버림)
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return strings.ToValidUTF8(s[:n], "")
+	}
+	return s
+}
+
+// instanceName 호스트 이름, PID, 임의 값으로 만든 인스턴스 이름 (재시작하면 바뀜)
+func instanceName() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	suffix := make([]byte, 3)
+	rand.Read(suffix)
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(suffix))
+}
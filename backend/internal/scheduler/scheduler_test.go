@@ -0,0 +1,242 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"lunch_app/backend/internal/models"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var seoul = time.FixedZone("KST", 9*60*60)
+
+func setupDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{TranslateError: true})
+	require.NoError(t, err)
+	// 백그라운드 실행과 같은 메모리 DB를 쓰도록 연결 하나만 사용
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&models.JobLease{}, &models.JobRun{}))
+	return db
+}
+
+// newScheduler now를 고정한 스케줄러 (같은 DB를 쓰는 인스턴스를 여러 개 만들 수 있음)
+func newScheduler(db *gorm.DB, owner string, now *time.Time) *Scheduler {
+	s := New(db, Config{Location: seoul, Owner: owner, HistoryLimit: 3})
+	s.now = func() time.Time { return *now }
+	return s
+}
+
+func TestParseSchedule_Next(t *testing.T) {
+	// 2024-07-05 (금) 08:40 KST
+	from := time.Date(2024, 7, 5, 8, 40, 0, 0, seoul)
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2024, 7, 5, 8, 41, 0, 0, seoul)},
+		{"*/15 * * * *", time.Date(2024, 7, 5, 8, 45, 0, 0, seoul)},
+		{"30 8 * * 1-5", time.Date(2024, 7, 8, 8, 30, 0, 0, seoul)},
+		{"30 8 * * mon-fri", time.Date(2024, 7, 8, 8, 30, 0, 0, seoul)},
+		{"0 4 * * *", time.Date(2024, 7, 6, 4, 0, 0, 0, seoul)},
+		{"0 12 1 * *", time.Date(2024, 8, 1, 12, 0, 0, 0, seoul)},
+		{"0 9-18/3 * * *", time.Date(2024, 7, 5, 9, 0, 0, 0, seoul)},
+		{"0 0 * * 7", time.Date(2024, 7, 7, 0, 0, 0, 0, seoul)},
+		{"0 0 13 * 5", time.Date(2024, 7, 12, 0, 0, 0, 0, seoul)}, // 일·요일은 OR
+		{"@daily", time.Date(2024, 7, 6, 0, 0, 0, 0, seoul)},
+		{"0 0 29 feb *", time.Date(2028, 2, 29, 0, 0, 0, 0, seoul)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := ParseSchedule(tt.spec)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(s.Next(from, seoul)), "got %s", s.Next(from, seoul))
+		})
+	}
+
+	s, err := ParseSchedule("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, s.Next(from, seoul).IsZero(), "없는 날짜")
+
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "5-1 * * * *", "*/0 * * * *", "* * * xyz *"} {
+		_, err := ParseSchedule(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestRunDue_OncePerSchedule(t *testing.T) {
+	db := setupDB(t)
+	now := time.Date(2024, 7, 5, 8, 29, 30, 0, seoul)
+	var runs atomic.Int32
+	job := Job{Name: "digest", Spec: "30 8 * * 1-5", Run: func(ctx context.Context) (string, error) {
+		runs.Add(1)
+		return "sent 2", nil
+	}}
+	a, b := newScheduler(db, "a", &now), newScheduler(db, "b", &now)
+	for _, s := range []*Scheduler{a, b} {
+		require.NoError(t, s.Register(job))
+		s.Start(context.Background())
+	}
+
+	assert.Empty(t, a.RunDue(context.Background()), "아직 시각 전")
+
+	now = now.Add(time.Minute)
+	started := a.RunDue(context.Background())
+	require.Len(t, started, 1)
+	assert.Empty(t, b.RunDue(context.Background()), "다른 인스턴스는 같은 일정을 다시 실행하지 않음")
+	a.Wait()
+	assert.Empty(t, a.RunDue(context.Background()), "끝난 뒤에도 다음 일정 전에는 실행하지 않음")
+	assert.Equal(t, int32(1), runs.Load())
+
+	var run models.JobRun
+	require.NoError(t, db.First(&run, started[0].ID).Error)
+	assert.Equal(t, models.JobRunSucceeded, run.Status)
+	assert.Equal(t, models.JobTriggerSchedule, run.Trigger)
+	assert.Equal(t, "a", run.Owner)
+	assert.Equal(t, "sent 2", run.Summary)
+	require.NotNil(t, run.FinishedAt)
+
+	statuses, err := b.Statuses(context.Background())
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	assert.True(t, time.Date(2024, 7, 8, 8, 30, 0, 0, seoul).Equal(*statuses[0].NextRunAt), "다음 평일")
+	assert.Empty(t, statuses[0].RunningOn)
+	require.NotNil(t, statuses[0].LastRun)
+	assert.Equal(t, run.ID, statuses[0].LastRun.ID)
+
+	// 서버가 꺼져 있는 동안 지난 일정은 한 번만 실행
+	now = time.Date(2024, 7, 10, 9, 0, 0, 0, seoul)
+	assert.Len(t, b.RunDue(context.Background()), 1)
+	b.Wait()
+	assert.Empty(t, a.RunDue(context.Background()))
+	assert.Equal(t, int32(2), runs.Load())
+}
+
+func TestTrigger(t *testing.T) {
+	db := setupDB(t)
+	now := time.Date(2024, 7, 5, 8, 0, 0, 0, seoul)
+	release := make(chan struct{})
+	a, b := newScheduler(db, "a", &now), newScheduler(db, "b", &now)
+	for _, s := range []*Scheduler{a, b} {
+		require.NoError(t, s.Register(Job{Name: "retention", Spec: Off, Run: func(ctx context.Context) (string, error) {
+			<-release
+			return "", errors.New("boom")
+		}}))
+		s.Start(context.Background())
+	}
+
+	_, err := a.Trigger(context.Background(), "unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	run, err := a.Trigger(context.Background(), "retention")
+	require.NoError(t, err)
+	assert.Equal(t, models.JobRunRunning, run.Status)
+	assert.Equal(t, models.JobTriggerManual, run.Trigger)
+	_, err = b.Trigger(context.Background(), "retention")
+	assert.ErrorIs(t, err, ErrRunning, "다른 인스턴스에서 실행 중")
+
+	statuses, err := b.Statuses(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "a", statuses[0].RunningOn)
+	assert.Nil(t, statuses[0].NextRunAt, "예약 실행하지 않는 작업")
+	assert.Empty(t, a.RunDue(context.Background()))
+
+	close(release)
+	a.Wait()
+	require.NoError(t, db.First(&run, run.ID).Error)
+	assert.Equal(t, models.JobRunFailed, run.Status)
+	assert.Equal(t, "boom", run.Error)
+
+	_, err = b.Trigger(context.Background(), "retention")
+	require.NoError(t, err, "끝나면 잠금이 풀림")
+	b.Wait()
+}
+
+func TestTrigger_ExpiredLease(t *testing.T) {
+	db := setupDB(t)
+	now := time.Date(2024, 7, 5, 8, 0, 0, 0, seoul)
+	a := newScheduler(db, "a", &now)
+	require.NoError(t, a.Register(Job{Name: "poll-close", Spec: "* * * * *", Timeout: time.Minute, Run: func(ctx context.Context) (string, error) {
+		panic("crash")
+	}}))
+	a.Start(context.Background())
+
+	// 실행 중에 멈춘 인스턴스의 잠금과 실행 기록
+	stale := models.JobRun{Job: "poll-close", Trigger: models.JobTriggerSchedule, Status: models.JobRunRunning, Owner: "dead", StartedAt: now.Add(-time.Hour)}
+	require.NoError(t, db.Create(&stale).Error)
+	require.NoError(t, db.Model(&models.JobLease{}).Where("name = ?", "poll-close").
+		Updates(map[string]any{"owner": "dead", "locked_until": now.Add(time.Minute).UTC()}).Error)
+
+	_, err := a.Trigger(context.Background(), "poll-close")
+	assert.ErrorIs(t, err, ErrRunning)
+
+	now = now.Add(2 * time.Minute)
+	run, err := a.Trigger(context.Background(), "poll-close")
+	require.NoError(t, err, "잠금이 만료되면 다시 실행")
+	a.Wait()
+
+	require.NoError(t, db.First(&stale, stale.ID).Error)
+	assert.Equal(t, models.JobRunFailed, stale.Status)
+	assert.Equal(t, "lease expired", stale.Error)
+	require.NoError(t, db.First(&run, run.ID).Error)
+	assert.Equal(t, "panic: crash", run.Error)
+}
+
+func TestHistoryLimit(t *testing.T) {
+	db := setupDB(t)
+	now := time.Date(2024, 7, 5, 8, 0, 0, 0, seoul)
+	s := newScheduler(db, "a", &now)
+	require.NoError(t, s.Register(Job{Name: "poll-close", Spec: "* * * * *", Run: func(ctx context.Context) (string, error) {
+		return "", nil
+	}}))
+	s.Start(context.Background())
+
+	for range 5 {
+		now = now.Add(time.Minute)
+		require.Len(t, s.RunDue(context.Background()), 1)
+		s.Wait()
+	}
+	runs, err := s.Runs(context.Background(), "poll-close", 10)
+	require.NoError(t, err)
+	assert.Len(t, runs, 3, "최근 HistoryLimit개만 남김")
+
+	_, err = s.Runs(context.Background(), "unknown", 10)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestRegister(t *testing.T) {
+	s := New(nil, Config{})
+	noop := func(ctx context.Context) (string, error) { return "", nil }
+	require.NoError(t, s.Register(Job{Name: "a", Spec: " OFF ", Run: noop}))
+	assert.ErrorContains(t, s.Register(Job{Name: "a", Spec: "@hourly", Run: noop}), "already registered")
+	assert.Error(t, s.Register(Job{Name: "b", Spec: "every minute", Run: noop}))
+
+	job, ok := s.Job("a")
+	require.True(t, ok)
+	assert.False(t, job.Enabled())
+	assert.Equal(t, Off, job.Spec)
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("JOB_TIMEZONE", "UTC")
+	t.Setenv("JOB_TICK_INTERVAL", "0")
+	t.Setenv("JOB_HISTORY_LIMIT", "-1")
+	t.Setenv("JOB_POLL_CLOSE_SCHEDULE", "*/5 * * * *")
+
+	cfg := ConfigFromEnv(seoul)
+	assert.Equal(t, time.UTC, cfg.Location)
+	assert.Zero(t, cfg.Tick)
+	assert.Equal(t, defaultHistoryLimit, cfg.HistoryLimit)
+	assert.Equal(t, "*/5 * * * *", SpecFromEnv("poll-close", "* * * * *"))
+	assert.Equal(t, "0 4 * * *", SpecFromEnv("retention", "0 4 * * *"))
+
+	t.Setenv("JOB_TIMEZONE", "Mars/Olympus")
+	assert.Equal(t, seoul, ConfigFromEnv(seoul).Location, "잘못된 시간대면 기본값")
+}
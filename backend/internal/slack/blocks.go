@@ -0,0 +1,96 @@
+package slack
+
+// 응답 표시 범위
+const (
+	// InChannel 채널의 모든 사람에게 표시
+	InChannel = "in_channel"
+	// Ephemeral 명령을 실행한 사람에게만 표시
+	Ephemeral = "ephemeral"
+)
+
+// 버튼 스타일
+const (
+	StylePrimary = "primary"
+	StyleDanger  = "danger"
+)
+
+// Message 슬래시 커맨드 응답 또는 response_url로 보내는 메시지
+// Text는 알림과 Block Kit을 표시하지 못하는 클라이언트용 대체 텍스트
+type Message struct {
+	ResponseType    string  `json:"response_type,omitempty"`
+	ReplaceOriginal bool    `json:"replace_original,omitempty"`
+	Text            string  `json:"text"`
+	Blocks          []Block `json:"blocks,omitempty"`
+}
+
+// Block Block Kit 레이아웃 블록 (section, actions, context, divider)
+type Block struct {
+	Type    string `json:"type"`
+	BlockID string `json:"block_id,omitempty"`
+	Text    *Text  `json:"text,omitempty"`
+	// Accessory section 오른쪽에 붙는 버튼
+	Accessory *Button `json:"accessory,omitempty"`
+	// Elements actions 블록의 버튼 또는 context 블록의 텍스트
+	Elements []any `json:"elements,omitempty"`
+}
+
+// Text Block Kit 텍스트 객체 (plain_text 또는 mrkdwn)
+type Text struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Button Block Kit 버튼
+type Button struct {
+	Type     string `json:"type"`
+	Text     Text   `json:"text"`
+	ActionID string `json:"action_id"`
+	Value    string `json:"value"`
+	Style    string `json:"style,omitempty"`
+}
+
+// Section mrkdwn 텍스트 블록
+func Section(text string) Block {
+	return Block{Type: "section", Text: Markdown(text)}
+}
+
+// SectionWithButton 오른쪽에 버튼이 있는 텍스트 블록
+func SectionWithButton(text string, button Button) Block {
+	block := Section(text)
+	block.Accessory = &button
+	return block
+}
+
+// Actions 버튼 묶음 블록
+func Actions(blockID string, buttons ...Button) Block {
+	elements := make([]any, 0, len(buttons))
+	for _, button := range buttons {
+		elements = append(elements, button)
+	}
+	return Block{Type: "actions", BlockID: blockID, Elements: elements}
+}
+
+// Context 작은 글씨의 보조 설명 블록
+func Context(text string) Block {
+	return Block{Type: "context", Elements: []any{Markdown(text)}}
+}
+
+// Divider 구분선 블록
+func Divider() Block {
+	return Block{Type: "divider"}
+}
+
+// Markdown mrkdwn 텍스트 객체
+func Markdown(text string) *Text {
+	return &Text{Type: "mrkdwn", Text: text}
+}
+
+// NewButton 버튼 생성 (style은 빈 값, StylePrimary, StyleDanger 중 하나)
+func NewButton(label, actionID, value, style string) Button {
+	return Button{Type: "button", Text: Text{Type: "plain_text", Text: label}, ActionID: actionID, Value: value, Style: style}
+}
+
+// EphemeralText 명령을 실행한 사람에게만 보이는 텍스트 메시지
+func EphemeralText(text string) Message {
+	return Message{ResponseType: Ephemeral, Text: text}
+}
@@ -0,0 +1,110 @@
+package slack
+
+import (
+	"fmt"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/kst"
+	"lunch_app/backend/internal/models"
+	"lunch_app/backend/internal/poll"
+	"strconv"
+	"strings"
+)
+
+// 버튼 action_id
+const (
+	// ActionLogVisit 맛집 방문 기록 (value: 맛집 ID)
+	ActionLogVisit = "log_visit"
+	// ActionStartPoll 추천 맛집으로 투표 시작 (value: 쉼표로 구분한 맛집 ID)
+	ActionStartPoll = "start_poll"
+	// ActionVote 투표 (value: 투표ID:후보ID)
+	ActionVote = "poll_vote"
+	// ActionClosePoll 투표 마감 (value: 투표 ID)
+	ActionClosePoll = "poll_close"
+)
+
+// RecommendMessage 추천 맛집 목록 (맛집마다 방문 기록 버튼, 마지막에 투표 시작 버튼)
+func RecommendMessage(lang i18n.Lang, restaurants []models.Restaurant) Message {
+	title := i18n.T(lang, i18n.SlackRecommendTitle)
+	blocks := []Block{Section(title)}
+	ids := make([]string, 0, len(restaurants))
+	names := make([]string, 0, len(restaurants))
+	for _, r := range restaurants {
+		id := strconv.FormatUint(uint64(r.ID), 10)
+		blocks = append(blocks, SectionWithButton(restaurantText(r.Snapshot()), NewButton(i18n.T(lang, i18n.SlackVisitedButton), ActionLogVisit, id, "")))
+		ids = append(ids, id)
+		names = append(names, r.Name)
+	}
+	blocks = append(blocks, Actions("recommend", NewButton(i18n.T(lang, i18n.SlackStartPollButton), ActionStartPoll, strings.Join(ids, ","), StylePrimary)))
+	return Message{ResponseType: Ephemeral, Text: title + " " + strings.Join(names, ", "), Blocks: blocks}
+}
+
+// ChooseRestaurantMessage 이름으로 찾은 맛집이 여러 곳일 때 방문한 곳을 고르는 메시지
+func ChooseRestaurantMessage(lang i18n.Lang, query string, restaurants []models.Restaurant) Message {
+	text := i18n.T(lang, i18n.SlackChooseRestaurant, escape(query))
+	blocks := []Block{Section(text)}
+	for _, r := range restaurants {
+		id := strconv.FormatUint(uint64(r.ID), 10)
+		blocks = append(blocks, SectionWithButton(restaurantText(r.Snapshot()), NewButton(i18n.T(lang, i18n.SlackVisitedButton), ActionLogVisit, id, "")))
+	}
+	return Message{ResponseType: Ephemeral, Text: text, Blocks: blocks}
+}
+
+// PollMessage 채널에 보이는 투표 메시지 (열려 있으면 후보별 투표 버튼과 마감 버튼)
+func PollMessage(lang i18n.Lang, p models.Poll) Message {
+	title := p.Title
+	if title == "" {
+		title = i18n.T(lang, i18n.SlackPollDefaultTitle)
+	}
+	deadline := p.Deadline.In(kst.Location()).Format("15:04")
+	blocks := []Block{
+		Section("*" + escape(title) + "*"),
+		Context(i18n.T(lang, i18n.SlackPollDeadline, deadline, escape(p.CreatedBy))),
+		Divider(),
+	}
+
+	open := p.Status == models.PollStatusOpen
+	pollID := strconv.FormatUint(uint64(p.ID), 10)
+	var winner string
+	for _, tally := range poll.Results(p) {
+		text := restaurantText(tally.Candidate.RestaurantSnapshot) + "\n" + i18n.T(lang, i18n.SlackVotes, tally.Count())
+		if tally.Count() > 0 {
+			text += " · " + escape(strings.Join(tally.Voters, ", "))
+		}
+		if !open {
+			if p.WinnerCandidateID != nil && *p.WinnerCandidateID == tally.Candidate.ID {
+				winner = escape(tally.Candidate.RestaurantSnapshot.Name)
+			}
+			blocks = append(blocks, Section(text))
+			continue
+		}
+		value := fmt.Sprintf("%s:%d", pollID, tally.Candidate.ID)
+		blocks = append(blocks, SectionWithButton(text, NewButton(i18n.T(lang, i18n.SlackVoteButton), ActionVote, value, "")))
+	}
+
+	if open {
+		blocks = append(blocks, Actions("poll", NewButton(i18n.T(lang, i18n.SlackClosePollButton), ActionClosePoll, pollID, StyleDanger)))
+		return Message{ResponseType: InChannel, Text: title, Blocks: blocks}
+	}
+	result := i18n.T(lang, i18n.SlackPollNoVotes)
+	if winner != "" {
+		result = i18n.T(lang, i18n.SlackPollWinner, winner, len(p.Votes))
+	}
+	blocks = append(blocks, Divider(), Section(result))
+	return Message{ResponseType: InChannel, Text: title + " · " + result, Blocks: blocks}
+}
+
+// restaurantText 맛집 이름(굵게)과 카테고리·주소
+func restaurantText(r models.RestaurantSnapshot) string {
+	details := escape(r.Address)
+	if r.Category != "" {
+		details = escape(r.Category) + " · " + details
+	}
+	return "*" + escape(r.Name) + "*\n" + details
+}
+
+// mrkdwnEscaper Slack mrkdwn에서 제어 문자로 쓰이는 &, <, > 이스케이프
+var mrkdwnEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func escape(text string) string {
+	return mrkdwnEscaper.Replace(text)
+}
@@ -0,0 +1,112 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrInvalidPayload 인터랙션 payload를 해석할 수 없음
+var ErrInvalidPayload = errors.New("slack: invalid interaction payload")
+
+// Command 슬래시 커맨드 요청 (application/x-www-form-urlencoded)
+type Command struct {
+	Command     string
+	Text        string
+	TeamID      string
+	TeamDomain  string
+	ChannelID   string
+	UserID      string
+	UserName    string
+	ResponseURL string
+}
+
+// ParseCommand 슬래시 커맨드 폼 본문 해석
+func ParseCommand(form url.Values) Command {
+	return Command{
+		Command:     form.Get("command"),
+		Text:        strings.TrimSpace(form.Get("text")),
+		TeamID:      form.Get("team_id"),
+		TeamDomain:  form.Get("team_domain"),
+		ChannelID:   form.Get("channel_id"),
+		UserID:      form.Get("user_id"),
+		UserName:    form.Get("user_name"),
+		ResponseURL: form.Get("response_url"),
+	}
+}
+
+// Subcommand 커맨드 텍스트의 첫 단어(소문자)와 나머지
+func (c Command) Subcommand() (string, string) {
+	name, rest, _ := strings.Cut(c.Text, " ")
+	return strings.ToLower(name), strings.TrimSpace(rest)
+}
+
+// Interaction 버튼 클릭 등 인터랙션 요청 (폼의 payload 필드에 담긴 JSON)
+type Interaction struct {
+	Type string `json:"type"`
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+		Name     string `json:"name"`
+	} `json:"user"`
+	Team struct {
+		ID     string `json:"id"`
+		Domain string `json:"domain"`
+	} `json:"team"`
+	Channel struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+	ResponseURL string   `json:"response_url"`
+	Actions     []Action `json:"actions"`
+}
+
+// Action 클릭된 버튼
+type Action struct {
+	ActionID string `json:"action_id"`
+	BlockID  string `json:"block_id"`
+	Value    string `json:"value"`
+}
+
+// UserName 인터랙션을 일으킨 사용자 이름 (username이 없으면 name)
+func (i Interaction) UserName() string {
+	if i.User.Username != "" {
+		return i.User.Username
+	}
+	return i.User.Name
+}
+
+// ParseInteraction 인터랙션 폼 본문의 payload 해석
+func ParseInteraction(form url.Values) (Interaction, error) {
+	var interaction Interaction
+	if err := json.Unmarshal([]byte(form.Get("payload")), &interaction); err != nil {
+		return interaction, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	return interaction, nil
+}
+
+// PostResponse response_url로 메시지 전송 (버튼 클릭 결과로 원래 메시지를 바꾸거나 새 메시지를 보낼 때)
+func PostResponse(ctx context.Context, client *http.Client, responseURL string, message Message) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, responseURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack: response_url returned %d", resp.StatusCode)
+	}
+	return nil
+}
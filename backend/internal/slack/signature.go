@@ -0,0 +1,60 @@
+// Package slack Slack 슬래시 커맨드·인터랙션 요청 검증과 Block Kit 메시지
+//
+// Slack API를 직접 호출하지 않으므로 녹화한 요청 본문과 httptest 서버만으로 테스트할 수 있습니다.
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	// TimestampHeader 요청 시각(Unix 초) 헤더
+	TimestampHeader = "X-Slack-Request-Timestamp"
+	// SignatureHeader 요청 서명 헤더 (v0=<hex>)
+	SignatureHeader = "X-Slack-Signature"
+	// MaxClockSkew 재전송 공격을 막기 위해 허용하는 요청 시각 오차
+	MaxClockSkew = 5 * time.Minute
+)
+
+// signatureVersion Slack 서명 형식 버전
+const signatureVersion = "v0"
+
+var (
+	// ErrMissingSignature 서명 또는 시각 헤더가 없음
+	ErrMissingSignature = errors.New("slack: missing signature headers")
+	// ErrStaleTimestamp 요청 시각이 MaxClockSkew보다 오래됐거나 미래임
+	ErrStaleTimestamp = errors.New("slack: stale request timestamp")
+	// ErrInvalidSignature 서명이 맞지 않음
+	ErrInvalidSignature = errors.New("slack: invalid signature")
+)
+
+// Sign 본문의 Slack 서명 계산 (v0=HMAC-SHA256(secret, "v0:<timestamp>:<body>"))
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signatureVersion + ":" + timestamp + ":"))
+	mac.Write(body)
+	return signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify Slack이 보낸 요청인지 서명과 요청 시각으로 확인
+func Verify(secret, timestamp, signature string, body []byte, now time.Time) error {
+	if timestamp == "" || signature == "" {
+		return ErrMissingSignature
+	}
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrStaleTimestamp
+	}
+	if skew := now.Sub(time.Unix(seconds, 0)); skew > MaxClockSkew || skew < -MaxClockSkew {
+		return ErrStaleTimestamp
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
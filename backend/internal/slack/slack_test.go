@@ -0,0 +1,157 @@
+package slack
+
+import (
+	"encoding/json"
+	"lunch_app/backend/internal/i18n"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Slack 문서의 서명 예시 (https://api.slack.com/authentication/verifying-requests-from-slack)
+const (
+	docsSecret    = "8f742231b10e8888abcd99yyyzzz85a5"
+	docsTimestamp = "1531420618"
+	docsSignature = "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503"
+)
+
+func TestVerify(t *testing.T) {
+	body, err := os.ReadFile("testdata/slack_docs_command.txt")
+	require.NoError(t, err)
+	now := time.Unix(1531420618, 0).Add(time.Minute)
+
+	assert.Equal(t, docsSignature, Sign(docsSecret, docsTimestamp, body))
+	assert.NoError(t, Verify(docsSecret, docsTimestamp, docsSignature, body, now))
+
+	assert.ErrorIs(t, Verify("other-secret", docsTimestamp, docsSignature, body, now), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify(docsSecret, docsTimestamp, docsSignature, append(body, '&'), now), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify(docsSecret, docsTimestamp, docsSignature, body, now.Add(MaxClockSkew)), ErrStaleTimestamp, "재전송된 오래된 요청")
+	assert.ErrorIs(t, Verify(docsSecret, "abc", docsSignature, body, now), ErrStaleTimestamp)
+	assert.ErrorIs(t, Verify(docsSecret, "", docsSignature, body, now), ErrMissingSignature)
+}
+
+func TestParseCommandAndInteraction(t *testing.T) {
+	body, err := os.ReadFile("testdata/slack_docs_command.txt")
+	require.NoError(t, err)
+	form, err := url.ParseQuery(string(body))
+	require.NoError(t, err)
+	command := ParseCommand(form)
+	assert.Equal(t, "roadrunner", command.UserName)
+	assert.Equal(t, "T1DC2JH3J", command.TeamID)
+	name, args := command.Subcommand()
+	assert.Empty(t, name)
+	assert.Empty(t, args)
+
+	command.Text = "LOG  국밥 집"
+	name, args = command.Subcommand()
+	assert.Equal(t, "log", name)
+	assert.Equal(t, "국밥 집", args)
+
+	payload, err := os.ReadFile("testdata/block_actions.json")
+	require.NoError(t, err)
+	interaction, err := ParseInteraction(url.Values{"payload": {string(payload)}})
+	require.NoError(t, err)
+	assert.Equal(t, "roadrunner", interaction.UserName())
+	assert.Equal(t, "T1DC2JH3J", interaction.Team.ID)
+	require.Len(t, interaction.Actions, 1)
+	assert.Equal(t, Action{ActionID: ActionVote, BlockID: "kR4xq", Value: "12:34"}, interaction.Actions[0])
+
+	_, err = ParseInteraction(url.Values{"payload": {"{"}})
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+}
+
+func TestPollMessage(t *testing.T) {
+	winner := uint(2)
+	p := models.Poll{
+		ID:        7,
+		Title:     "금요일 <점심>",
+		Deadline:  time.Date(2024, 7, 5, 3, 30, 0, 0, time.UTC),
+		Status:    models.PollStatusOpen,
+		CreatedBy: "minsu",
+		Candidates: []models.PollCandidate{
+			{ID: 1, RestaurantID: 10, RestaurantSnapshot: models.RestaurantSnapshot{Name: "국밥집", Address: "서울시 1", Category: "한식"}},
+			{ID: 2, RestaurantID: 11, RestaurantSnapshot: models.RestaurantSnapshot{Name: "A&B 버거", Address: "서울시 2"}},
+		},
+		Votes: []models.PollVote{{CandidateID: 2, Voter: "jiyoung"}},
+	}
+
+	message := PollMessage(i18n.KO, p)
+	assert.Equal(t, InChannel, message.ResponseType)
+	data, err := json.Marshal(message)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	blocks := decoded["blocks"].([]any)
+	assert.Equal(t, "*금요일 &lt;점심&gt;*", blocks[0].(map[string]any)["text"].(map[string]any)["text"])
+	assert.Equal(t, "12:30 마감 · minsu님이 시작", blocks[1].(map[string]any)["elements"].([]any)[0].(map[string]any)["text"])
+	second := blocks[4].(map[string]any)
+	assert.Equal(t, "*A&amp;B 버거*\n서울시 2\n1표 · jiyoung", second["text"].(map[string]any)["text"])
+	assert.Equal(t, map[string]any{
+		"type":      "button",
+		"text":      map[string]any{"type": "plain_text", "text": "투표"},
+		"action_id": ActionVote,
+		"value":     "7:2",
+	}, second["accessory"])
+	last := blocks[len(blocks)-1].(map[string]any)
+	assert.Equal(t, "actions", last["type"])
+	assert.Equal(t, ActionClosePoll, last["elements"].([]any)[0].(map[string]any)["action_id"])
+
+	p.Status = models.PollStatusClosed
+	p.WinnerCandidateID = &winner
+	closed := PollMessage(i18n.EN, p)
+	last = toMap(t, closed.Blocks[len(closed.Blocks)-1])
+	assert.Equal(t, "Poll closed · Lunch today is *A&amp;B 버거* (visits logged for 1 voters)", last["text"].(map[string]any)["text"])
+	for _, block := range closed.Blocks {
+		assert.Nil(t, block.Accessory, "마감된 투표에는 버튼이 없음")
+		assert.NotEqual(t, "actions", block.Type)
+	}
+}
+
+func TestRecommendMessage(t *testing.T) {
+	restaurants := []models.Restaurant{{Name: "국밥집", Address: "서울시 1"}, {Name: "초밥집", Address: "서울시 2"}}
+	restaurants[0].ID, restaurants[1].ID = 3, 5
+	message := RecommendMessage(i18n.KO, restaurants)
+	assert.Equal(t, Ephemeral, message.ResponseType)
+	require.Len(t, message.Blocks, 4)
+	assert.Equal(t, ActionLogVisit, message.Blocks[1].Accessory.ActionID)
+	assert.Equal(t, "3", message.Blocks[1].Accessory.Value)
+	start := message.Blocks[3].Elements[0].(Button)
+	assert.Equal(t, ActionStartPoll, start.ActionID)
+	assert.Equal(t, "3,5", start.Value)
+}
+
+func TestPostResponse(t *testing.T) {
+	var received Message
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		json.NewDecoder(r.Body).Decode(&received)
+	}))
+	defer server.Close()
+
+	message := EphemeralText("안녕하세요")
+	require.NoError(t, PostResponse(t.Context(), server.Client(), server.URL, message))
+	assert.Equal(t, message, received)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer failing.Close()
+	assert.ErrorContains(t, PostResponse(t.Context(), failing.Client(), failing.URL, message), strconv.Itoa(http.StatusNotFound))
+}
+
+func toMap(t *testing.T, block Block) map[string]any {
+	t.Helper()
+	data, err := json.Marshal(block)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	return decoded
+}
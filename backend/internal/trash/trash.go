@@ -0,0 +1,135 @@
+package trash
+
+import (
+	"errors"
+	"lunch_app/backend/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrNotInTrash 휴지통(soft delete 상태)에 없는 맛집
+var ErrNotInTrash = errors.New("restaurant is not in trash")
+
+// ConflictError 복원하려는 맛집과 이름·주소 또는 외부 장소 ID가 같은 맛집이 이미 등록되어 있음
+type ConflictError struct {
+	ExistingID uint
+}
+
+func (e *ConflictError) Error() string {
+	return "an active restaurant with the same name and address already exists"
+}
+
+// ListRestaurants soft delete된 맛집을 최근 삭제 순으로 조회
+func ListRestaurants(db *gorm.DB) ([]models.Restaurant, error) {
+	var restaurants []models.Restaurant
+	err := db.Unscoped().
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at desc").
+		Find(&restaurants).Error
+	return restaurants, err
+}
+
+// RestoreRestaurant 맛집 복원 후 연결이 끊긴 방문 기록을 다시 연결, 재연결한 방문 수 반환
+// 연결이 끊긴 방문 기록: restaurant_id가 비어 있지만 스냅샷의 이름·주소가 같은 기록
+func RestoreRestaurant(db *gorm.DB, id uint) (models.Restaurant, int64, error) {
+	var restaurant models.Restaurant
+	var relinked int64
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("deleted_at IS NOT NULL").First(&restaurant, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNotInTrash
+			}
+			return err
+		}
+
+		var existing models.Restaurant
+		err := tx.Scopes(models.SameRestaurant(restaurant)).First(&existing).Error
+		if err == nil {
+			return &ConflictError{ExistingID: existing.ID}
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&restaurant).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		restaurant.DeletedAt = gorm.DeletedAt{}
+
+		result := tx.Model(&models.Visit{}).
+			Where("restaurant_id IS NULL OR restaurant_id = 0").
+			Where("restaurant_name = ? AND restaurant_address = ?", restaurant.Name, restaurant.Address).
+			Update("restaurant_id", restaurant.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		relinked = result.RowsAffected
+		return nil
+	})
+	// 충돌 검사 이후 같은 맛집이 동시에 등록된 경우 유니크 인덱스 위반으로 감지
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return restaurant, 0, conflictWith(db, restaurant)
+	}
+	return restaurant, relinked, err
+}
+
+// conflictWith 복원하려는 맛집과 충돌하는 맛집을 찾아 ConflictError로 반환
+func conflictWith(db *gorm.DB, restaurant models.Restaurant) error {
+	var existing models.Restaurant
+	db.Scopes(models.SameRestaurant(restaurant)).First(&existing)
+	return &ConflictError{ExistingID: existing.ID}
+}
+
+// PurgeRestaurants cutoff 이전에 삭제된 맛집을 완전히 삭제, 삭제한 맛집 수 반환
+func PurgeRestaurants(db *gorm.DB, cutoff time.Time) (int64, error) {
+	var purged int64
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var ids []uint
+		if err := tx.Unscoped().Model(&models.Restaurant{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+
+		var err error
+		purged, err = PurgeRestaurantIDs(tx, ids)
+		return err
+	})
+	return purged, err
+}
+
+// PurgeRestaurantIDs 지정한 맛집을 완전히 삭제 (호출하는 쪽의 트랜잭션 안에서 실행)
+// 방문 기록은 스냅샷으로 남기고 restaurant_id만 비우며, 북마크·리뷰·영업시간은 함께 삭제
+func PurgeRestaurantIDs(tx *gorm.DB, ids []uint) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if err := tx.Unscoped().Model(&models.Visit{}).
+		Where("restaurant_id IN ?", ids).
+		Update("restaurant_id", nil).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Unscoped().Where("restaurant_id IN ?", ids).Delete(&models.Bookmark{}).Error; err != nil {
+		return 0, err
+	}
+	reviewIDs := tx.Unscoped().Model(&models.Review{}).Select("id").Where("restaurant_id IN ?", ids)
+	if err := tx.Unscoped().Where("review_id IN (?)", reviewIDs).Delete(&models.ReviewImage{}).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Unscoped().Where("restaurant_id IN ?", ids).Delete(&models.Review{}).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Where("restaurant_id IN ?", ids).Delete(&models.OpeningHours{}).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Where("restaurant_id IN ?", ids).Delete(&models.RestaurantHoliday{}).Error; err != nil {
+		return 0, err
+	}
+
+	result := tx.Unscoped().Delete(&models.Restaurant{}, ids)
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,161 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// MaxAttempts 배달 한 건을 보내 보는 최대 횟수 (첫 시도 포함)
+	MaxAttempts = 8
+	// RetryBaseDelay 첫 실패 뒤 재시도까지 기다리는 시간 (실패할 때마다 두 배)
+	RetryBaseDelay = 30 * time.Second
+	// MaxRetryDelay 재시도 대기 시간 상한
+	MaxRetryDelay = time.Hour
+	// SendTimeout 한 번 보낼 때 응답을 기다리는 시간
+	SendTimeout = 10 * time.Second
+	// DefaultBatchSize 한 번에 처리하는 배달 수
+	DefaultBatchSize = 50
+	// maxErrorLength 배달 기록에 남기는 실패 사유 길이
+	maxErrorLength = 512
+	// userAgent 웹훅 요청의 User-Agent
+	userAgent = "lunch-app-webhook/1"
+)
+
+// Backoff attempts번 실패한 뒤 다음 시도까지 기다릴 시간 (30초부터 두 배씩, 최대 1시간)
+func Backoff(attempts int) time.Duration {
+	delay := RetryBaseDelay
+	for i := 1; i < attempts && delay < MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxRetryDelay)
+}
+
+// ProcessDue 보낼 시각이 된 배달을 최대 limit건 보냄
+// 보내기 전에 시도 횟수를 올리는 조건부 UPDATE로 선점하므로 여러 워커가 같은 배달을 동시에 보내지 않음
+// 한 건에 응답 대기 시간까지 걸릴 수 있으므로 선점·서명·결과 기록은 배달마다 now()로 그때의 시각을 씀
+func ProcessDue(ctx context.Context, db *gorm.DB, client *http.Client, now func() time.Time, limit int) (int, error) {
+	var due []models.WebhookDelivery
+	err := db.Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, now()).
+		Order("next_attempt_at, id").Limit(limit).Find(&due).Error
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, delivery := range due {
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+		claimed, err := claim(db, &delivery, now)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+		if err := attempt(ctx, db, client, delivery, now); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// claim 시도 횟수를 올리고 선점한 때부터 응답 대기 시간 동안 다음 시도 시각을 미룸 (다른 워커가 먼저 가져갔으면 false)
+func claim(db *gorm.DB, delivery *models.WebhookDelivery, now func() time.Time) (bool, error) {
+	result := db.Model(&models.WebhookDelivery{}).
+		Where("id = ? AND status = ? AND attempts = ?", delivery.ID, models.WebhookDeliveryPending, delivery.Attempts).
+		Updates(map[string]any{"attempts": delivery.Attempts + 1, "next_attempt_at": now().Add(2 * SendTimeout)})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	delivery.Attempts++
+	return result.RowsAffected == 1, nil
+}
+
+// attempt 배달을 한 번 보내고 결과를 기록 (보낸 시각으로 서명하고, 응답을 받은 시각부터 재시도 대기)
+func attempt(ctx context.Context, db *gorm.DB, client *http.Client, delivery models.WebhookDelivery, now func() time.Time) error {
+	sentAt := now()
+	updates := map[string]any{"last_attempt_at": sentAt, "response_status": 0}
+
+	var hook models.Webhook
+	err := db.First(&hook, delivery.WebhookID).Error
+	var sendErr error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		sendErr = errors.New("webhook deleted")
+		delivery.Attempts = MaxAttempts
+	case err != nil:
+		return err
+	case !hook.Active:
+		sendErr = errors.New("webhook disabled")
+		delivery.Attempts = MaxAttempts
+	default:
+		var status int
+		status, sendErr = send(ctx, client, hook, delivery, sentAt)
+		updates["response_status"] = status
+	}
+	finishedAt := now()
+
+	switch {
+	case sendErr == nil:
+		updates["status"] = models.WebhookDeliverySucceeded
+		updates["delivered_at"] = finishedAt
+		updates["last_error"] = ""
+	case delivery.Attempts >= MaxAttempts:
+		updates["status"] = models.WebhookDeliveryFailed
+		updates["last_error"] = truncateError(sendErr)
+	default:
+		updates["next_attempt_at"] = finishedAt.Add(Backoff(delivery.Attempts))
+		updates["last_error"] = truncateError(sendErr)
+	}
+	return db.Model(&models.WebhookDelivery{}).Where("id = ?", delivery.ID).Updates(updates).Error
+}
+
+// send 서명한 본문을 POST (2xx가 아니면 에러)
+func send(ctx context.Context, client *http.Client, hook models.Webhook, delivery models.WebhookDelivery, now time.Time) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, SendTimeout)
+	defer cancel()
+
+	body := []byte(delivery.Payload)
+	timestamp := formatUnix(now)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, strconv.FormatUint(uint64(delivery.ID), 10))
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(hook.Secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+func truncateError(err error) string {
+	message := err.Error()
+	if len(message) > maxErrorLength {
+		return message[:maxErrorLength]
+	}
+	return message
+}
@@ -0,0 +1,158 @@
+// Package webhook 점심 이벤트를 팀이 등록한 외부 URL로 보내는 웹훅
+//
+// 이벤트 버스의 이벤트마다 구독하는 웹훅의 배달(models.WebhookDelivery)을 만들고,
+// 백그라운드 워커가 HMAC-SHA256으로 서명한 JSON을 보냄. 실패하면 지수 백오프로 재시도함.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/models"
+	"slices"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 요청 헤더
+const (
+	EventHeader     = "X-Lunch-Event"
+	DeliveryHeader  = "X-Lunch-Delivery"
+	TimestampHeader = "X-Lunch-Timestamp"
+	// SignatureHeader "v1=" + hex(HMAC-SHA256(secret, timestamp + "." + body))
+	SignatureHeader = "X-Lunch-Signature"
+)
+
+// EventTypes 웹훅으로 구독할 수 있는 이벤트 종류
+var EventTypes = []string{
+	events.RestaurantCreated,
+	events.RestaurantDeleted,
+	events.RestaurantRestored,
+	events.RestaurantsMerged,
+	events.VisitCreated,
+	events.VisitUpdated,
+	events.VisitDeleted,
+	events.PollCreated,
+	events.PollVoted,
+	events.PollClosed,
+	events.DrawCreated,
+}
+
+var (
+	// ErrNotFound 웹훅 또는 배달 기록이 없음
+	ErrNotFound = errors.New("webhook: not found")
+	// ErrDeliveryPending 아직 보내는 중인 배달은 재전송할 수 없음
+	ErrDeliveryPending = errors.New("webhook: delivery is still pending")
+)
+
+// IsEventType 구독할 수 있는 이벤트 종류인지 확인
+func IsEventType(eventType string) bool {
+	return slices.Contains(EventTypes, eventType)
+}
+
+// Payload 웹훅 요청 본문
+type Payload struct {
+	Event string `json:"event"`
+	// Team 이벤트의 팀 (맛집·방문 기록 이벤트는 빈 문자열)
+	Team       string          `json:"team"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Sign 요청 본문 서명 ("v1=" + hex HMAC-SHA256)
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret 웹훅 서명 키 생성 (whsec_ + 32바이트 hex)
+func NewSecret() string {
+	secret := make([]byte, 32)
+	rand.Read(secret)
+	return "whsec_" + hex.EncodeToString(secret)
+}
+
+// Enqueue event를 구독하는 활성 웹훅마다 바로 보낼 배달을 만듦
+func Enqueue(db *gorm.DB, event events.Event, now time.Time) ([]models.WebhookDelivery, error) {
+	query := db.Where("active = ?", true)
+	if event.Team != "" {
+		query = query.Where("team = ?", event.Team)
+	}
+	var hooks []models.Webhook
+	if err := query.Order("id").Find(&hooks).Error; err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(Payload{Event: event.Type, Team: event.Team, OccurredAt: event.OccurredAt, Data: event.Data})
+	if err != nil {
+		return nil, err
+	}
+	deliveries := []models.WebhookDelivery{}
+	for _, hook := range hooks {
+		if !hook.Subscribes(event.Team, event.Type) {
+			continue
+		}
+		deliveries = append(deliveries, models.WebhookDelivery{
+			WebhookID:     hook.ID,
+			EventType:     event.Type,
+			Payload:       string(payload),
+			Status:        models.WebhookDeliveryPending,
+			NextAttemptAt: now,
+		})
+	}
+	if len(deliveries) == 0 {
+		return deliveries, nil
+	}
+	return deliveries, db.Create(&deliveries).Error
+}
+
+// Redeliver 끝난 배달과 같은 본문으로 새 배달을 만듦 (원래 기록은 그대로 남김)
+func Redeliver(db *gorm.DB, webhookID, deliveryID uint, now time.Time) (models.WebhookDelivery, error) {
+	var original models.WebhookDelivery
+	err := db.Where("webhook_id = ?", webhookID).First(&original, deliveryID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.WebhookDelivery{}, ErrNotFound
+	}
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	if original.Status == models.WebhookDeliveryPending {
+		return models.WebhookDelivery{}, ErrDeliveryPending
+	}
+
+	redelivery := models.WebhookDelivery{
+		WebhookID:     original.WebhookID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		Status:        models.WebhookDeliveryPending,
+		NextAttemptAt: now,
+		RedeliveryOf:  &original.ID,
+	}
+	return redelivery, db.Create(&redelivery).Error
+}
+
+// DeleteWebhook 웹훅과 배달 기록 삭제
+func DeleteWebhook(db *gorm.DB, id uint) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.Webhook{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		return tx.Where("webhook_id = ?", id).Delete(&models.WebhookDelivery{}).Error
+	})
+}
+
+func formatUnix(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
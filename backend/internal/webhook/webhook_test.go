@@ -0,0 +1,322 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"lunch_app/backend/internal/events"
+	"lunch_app/backend/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// 2024-07-05 11:30 KST
+var fixedNow = time.Date(2024, 7, 5, 2, 30, 0, 0, time.UTC)
+
+// clockAt 항상 t를 돌려주는 시계
+func clockAt(t time.Time) func() time.Time {
+	return func() time.Time { return t }
+}
+
+func setupDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{TranslateError: true})
+	require.NoError(t, err)
+	// 워커 고루틴도 같은 메모리 DB를 쓰도록 연결 하나만 사용
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&models.Webhook{}, &models.WebhookDelivery{}))
+	return db
+}
+
+func createWebhook(t *testing.T, db *gorm.DB, team, url string, active bool, eventTypes ...string) models.Webhook {
+	hook := models.Webhook{Team: team, URL: url, Secret: "test-secret", Active: active}
+	hook.SetEvents(eventTypes)
+	require.NoError(t, db.Create(&hook).Error)
+	return hook
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event":"visit.created"}`)
+	mac := hmac.New(sha256.New, []byte("test-secret"))
+	mac.Write([]byte("1720146600." + string(body)))
+	assert.Equal(t, "v1="+hex.EncodeToString(mac.Sum(nil)), Sign("test-secret", "1720146600", body))
+	assert.NotEqual(t, Sign("test-secret", "1720146600", body), Sign("test-secret", "1720146601", body), "타임스탬프도 서명에 포함")
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, Backoff(1))
+	assert.Equal(t, time.Minute, Backoff(2))
+	assert.Equal(t, 32*time.Minute, Backoff(7))
+	assert.Equal(t, MaxRetryDelay, Backoff(8))
+	assert.Equal(t, MaxRetryDelay, Backoff(100))
+}
+
+func TestEnqueue(t *testing.T) {
+	db := setupDB(t)
+	alpha := createWebhook(t, db, "alpha", "https://alpha.example.com/hook", true, events.VisitCreated, events.PollClosed)
+	beta := createWebhook(t, db, "beta", "https://beta.example.com/hook", true, events.VisitCreated)
+	createWebhook(t, db, "alpha", "https://disabled.example.com/hook", false, events.VisitCreated, events.PollClosed)
+	createWebhook(t, db, "gamma", "https://gamma.example.com/hook", true, events.PollClosed)
+
+	visit := events.Event{ID: 1, Type: events.VisitCreated, Data: json.RawMessage(`{"id":3}`), OccurredAt: fixedNow}
+	deliveries, err := Enqueue(db, visit, fixedNow)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2, "팀이 없는 이벤트는 모든 팀의 구독 웹훅으로")
+	assert.Equal(t, alpha.ID, deliveries[0].WebhookID)
+	assert.Equal(t, beta.ID, deliveries[1].WebhookID)
+	assert.Equal(t, models.WebhookDeliveryPending, deliveries[0].Status)
+	assert.Equal(t, fixedNow, deliveries[0].NextAttemptAt)
+	var payload Payload
+	require.NoError(t, json.Unmarshal([]byte(deliveries[0].Payload), &payload))
+	assert.Equal(t, Payload{Event: events.VisitCreated, OccurredAt: fixedNow, Data: json.RawMessage(`{"id":3}`)}, payload)
+
+	closed := events.Event{ID: 2, Type: events.PollClosed, Team: "alpha", Data: json.RawMessage(`{}`), OccurredAt: fixedNow}
+	deliveries, err = Enqueue(db, closed, fixedNow)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1, "팀 이벤트는 그 팀 웹훅으로만")
+	assert.Equal(t, alpha.ID, deliveries[0].WebhookID)
+
+	deliveries, err = Enqueue(db, events.Event{Type: events.DrawCreated, Team: "alpha"}, fixedNow)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries, "구독하지 않은 이벤트")
+}
+
+func TestProcessDue_RetryThenSucceed(t *testing.T) {
+	db := setupDB(t)
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, events.VisitCreated, r.Header.Get(EventHeader))
+		assert.Equal(t, Sign("test-secret", r.Header.Get(TimestampHeader), body), r.Header.Get(SignatureHeader))
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	createWebhook(t, db, "alpha", server.URL, true, events.VisitCreated)
+	deliveries, err := Enqueue(db, events.Event{Type: events.VisitCreated, Data: json.RawMessage(`{}`)}, fixedNow)
+	require.NoError(t, err)
+
+	sent, err := ProcessDue(context.Background(), db, server.Client(), clockAt(fixedNow), DefaultBatchSize)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	var delivery models.WebhookDelivery
+	require.NoError(t, db.First(&delivery, deliveries[0].ID).Error)
+	assert.Equal(t, models.WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusServiceUnavailable, delivery.ResponseStatus)
+	assert.Equal(t, "unexpected status 503", delivery.LastError)
+	assert.True(t, fixedNow.Add(RetryBaseDelay).Equal(delivery.NextAttemptAt))
+
+	sent, err = ProcessDue(context.Background(), db, server.Client(), clockAt(fixedNow.Add(10*time.Second)), DefaultBatchSize)
+	require.NoError(t, err)
+	assert.Zero(t, sent, "재시도 시각 전")
+
+	later := fixedNow.Add(RetryBaseDelay)
+	sent, err = ProcessDue(context.Background(), db, server.Client(), clockAt(later), DefaultBatchSize)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	require.NoError(t, db.First(&delivery, deliveries[0].ID).Error)
+	assert.Equal(t, models.WebhookDeliverySucceeded, delivery.Status)
+	assert.Equal(t, 2, delivery.Attempts)
+	assert.Equal(t, http.StatusOK, delivery.ResponseStatus)
+	assert.Empty(t, delivery.LastError)
+	require.NotNil(t, delivery.DeliveredAt)
+	assert.True(t, later.Equal(*delivery.DeliveredAt))
+}
+
+func TestProcessDue_GivesUp(t *testing.T) {
+	db := setupDB(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	hook := createWebhook(t, db, "alpha", server.URL, true, events.PollClosed)
+	deliveries, err := Enqueue(db, events.Event{Type: events.PollClosed, Team: "alpha"}, fixedNow)
+	require.NoError(t, err)
+
+	now := fixedNow
+	for attempt := 1; attempt <= MaxAttempts; attempt++ {
+		sent, err := ProcessDue(context.Background(), db, server.Client(), clockAt(now), DefaultBatchSize)
+		require.NoError(t, err)
+		require.Equal(t, 1, sent, "시도 %d", attempt)
+		now = now.Add(Backoff(attempt))
+	}
+	var delivery models.WebhookDelivery
+	require.NoError(t, db.First(&delivery, deliveries[0].ID).Error)
+	assert.Equal(t, models.WebhookDeliveryFailed, delivery.Status)
+	assert.Equal(t, MaxAttempts, delivery.Attempts)
+
+	// 비활성화된 웹훅의 배달은 보내지 않고 실패 처리
+	require.NoError(t, db.Model(&hook).Update("active", false).Error)
+	redelivery, err := Redeliver(db, hook.ID, delivery.ID, now)
+	require.NoError(t, err)
+	_, err = ProcessDue(context.Background(), db, server.Client(), clockAt(now), DefaultBatchSize)
+	require.NoError(t, err)
+	var disabled models.WebhookDelivery
+	require.NoError(t, db.First(&disabled, redelivery.ID).Error)
+	assert.Equal(t, models.WebhookDeliveryFailed, disabled.Status)
+	assert.Equal(t, "webhook disabled", disabled.LastError)
+}
+
+func TestClaim(t *testing.T) {
+	db := setupDB(t)
+	hook := createWebhook(t, db, "alpha", "https://alpha.example.com/hook", true, events.VisitCreated)
+	delivery := models.WebhookDelivery{WebhookID: hook.ID, Status: models.WebhookDeliveryPending, NextAttemptAt: fixedNow}
+	require.NoError(t, db.Create(&delivery).Error)
+
+	first, second := delivery, delivery
+	claimed, err := claim(db, &first, clockAt(fixedNow))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	claimed, err = claim(db, &second, clockAt(fixedNow))
+	require.NoError(t, err)
+	assert.False(t, claimed, "다른 워커가 먼저 가져간 배달")
+}
+
+func TestRedeliver(t *testing.T) {
+	db := setupDB(t)
+	hook := createWebhook(t, db, "alpha", "https://alpha.example.com/hook", true, events.VisitCreated)
+	original := models.WebhookDelivery{WebhookID: hook.ID, EventType: events.VisitCreated, Payload: `{"event":"visit.created"}`, Status: models.WebhookDeliverySucceeded, Attempts: 1}
+	pending := models.WebhookDelivery{WebhookID: hook.ID, Status: models.WebhookDeliveryPending}
+	require.NoError(t, db.Create(&[]*models.WebhookDelivery{&original, &pending}).Error)
+
+	redelivery, err := Redeliver(db, hook.ID, original.ID, fixedNow)
+	require.NoError(t, err)
+	assert.NotEqual(t, original.ID, redelivery.ID)
+	assert.Equal(t, original.Payload, redelivery.Payload)
+	assert.Equal(t, models.WebhookDeliveryPending, redelivery.Status)
+	assert.Zero(t, redelivery.Attempts)
+	assert.Equal(t, &original.ID, redelivery.RedeliveryOf)
+
+	_, err = Redeliver(db, hook.ID, pending.ID, fixedNow)
+	assert.ErrorIs(t, err, ErrDeliveryPending)
+	_, err = Redeliver(db, hook.ID+1, original.ID, fixedNow)
+	assert.ErrorIs(t, err, ErrNotFound, "다른 웹훅의 배달")
+
+	require.NoError(t, DeleteWebhook(db, hook.ID))
+	var count int64
+	db.Model(&models.WebhookDelivery{}).Count(&count)
+	assert.Zero(t, count, "배달 기록도 함께 삭제")
+	assert.ErrorIs(t, DeleteWebhook(db, hook.ID), ErrNotFound)
+}
+
+func TestWorker(t *testing.T) {
+	db := setupDB(t)
+	received := make(chan *http.Request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r
+	}))
+	defer server.Close()
+	createWebhook(t, db, "alpha", server.URL, true, events.PollClosed)
+
+	bus := events.NewBus(10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// 재시도 예약 작업을 기다리지 않고 새 배달은 바로 보냄
+	NewWorker(db, bus).Start(ctx)
+	require.Eventually(t, func() bool { return bus.Subscribers() == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	bus.Publish("alpha", events.PollClosed, map[string]any{"id": 7})
+	select {
+	case r := <-received:
+		assert.Equal(t, events.PollClosed, r.Header.Get(EventHeader))
+		assert.NotEmpty(t, r.Header.Get(DeliveryHeader))
+	case <-time.After(2 * time.Second):
+		t.Fatal("웹훅이 오지 않음")
+	}
+}
+
+func TestWorker_RetryDue(t *testing.T) {
+	db := setupDB(t)
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+	createWebhook(t, db, "alpha", server.URL, true, events.VisitCreated)
+	for range 3 {
+		_, err := Enqueue(db, events.Event{Type: events.VisitCreated, Data: json.RawMessage(`{}`)}, fixedNow)
+		require.NoError(t, err)
+	}
+
+	w := NewWorker(db, events.NewBus(10))
+	w.batchSize = 2
+	w.now = func() time.Time { return fixedNow }
+	sent, err := w.RetryDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, sent, "한 번에 다 못 보냈으면 이어서 보냄")
+	assert.Equal(t, int32(3), calls.Load())
+
+	sent, err = w.RetryDue(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+}
+
+func TestProcessDue_FreshClockPerDelivery(t *testing.T) {
+	db := setupDB(t)
+	var mu sync.Mutex
+	current := fixedNow
+	clock := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return current
+	}
+
+	var (
+		received   []string
+		timestamps []string
+		concurrent = -1
+	)
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(DeliveryHeader))
+		timestamps = append(timestamps, r.Header.Get(TimestampHeader))
+		// 응답이 느린 웹훅: 한 건에 15초
+		mu.Lock()
+		current = current.Add(15 * time.Second)
+		mu.Unlock()
+		// 두 번째 배달을 보내는 중에 다른 워커(예약 작업)가 돎
+		if len(received) == 2 {
+			sent, err := ProcessDue(context.Background(), db, server.Client(), clock, DefaultBatchSize)
+			assert.NoError(t, err)
+			concurrent = sent
+		}
+	}))
+	defer server.Close()
+	createWebhook(t, db, "alpha", server.URL, true, events.VisitCreated)
+	first, err := Enqueue(db, events.Event{Type: events.VisitCreated, Data: json.RawMessage(`{}`)}, fixedNow)
+	require.NoError(t, err)
+	second, err := Enqueue(db, events.Event{Type: events.VisitCreated, Data: json.RawMessage(`{}`)}, fixedNow)
+	require.NoError(t, err)
+
+	sent, err := ProcessDue(context.Background(), db, server.Client(), clock, DefaultBatchSize)
+	require.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.Zero(t, concurrent, "보내는 중인 배달은 선점한 때부터 응답 대기 시간 동안 다시 보내지 않음")
+	assert.Equal(t, []string{
+		strconv.FormatUint(uint64(first[0].ID), 10),
+		strconv.FormatUint(uint64(second[0].ID), 10),
+	}, received)
+	assert.Equal(t, []string{formatUnix(fixedNow), formatUnix(fixedNow.Add(15 * time.Second))}, timestamps, "보내는 시각으로 서명")
+
+	var delivery models.WebhookDelivery
+	require.NoError(t, db.First(&delivery, second[0].ID).Error)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.True(t, fixedNow.Add(15*time.Second).Equal(*delivery.LastAttemptAt))
+	assert.True(t, fixedNow.Add(30*time.Second).Equal(*delivery.DeliveredAt))
+}
@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"lunch_app/backend/internal/events"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// EnabledFromEnv WEBHOOK_ENABLED 환경변수로 웹훅 발송 여부 결정 ("false"나 "0"이면 비활성화, 기본 활성)
+func EnabledFromEnv() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("WEBHOOK_ENABLED"))
+	return err != nil || enabled
+}
+
+// Worker 이벤트 버스의 모든 이벤트로 배달을 만들고 바로 보내는 백그라운드 작업
+// 핸들러는 이벤트를 발행하기만 하므로 웹훅 응답을 기다리지 않음
+// 실패한 배달의 재시도는 예약 작업 webhook-retry가 RetryDue로 처리함
+type Worker struct {
+	db        *gorm.DB
+	bus       *events.Bus
+	client    *http.Client
+	batchSize int
+	wake      chan struct{}
+	now       func() time.Time
+}
+
+// NewWorker 웹훅 발송 워커 생성
+func NewWorker(db *gorm.DB, bus *events.Bus) *Worker {
+	return &Worker{
+		db:        db,
+		bus:       bus,
+		client:    &http.Client{Timeout: SendTimeout},
+		batchSize: DefaultBatchSize,
+		wake:      make(chan struct{}, 1),
+		now:       time.Now,
+	}
+}
+
+// Start 구독·발송 고루틴 시작 (ctx 취소 시 종료)
+func (w *Worker) Start(ctx context.Context) {
+	go w.subscribe(ctx)
+	go w.deliver(ctx)
+	slog.Info("웹훅 발송 시작")
+}
+
+// Wake 다음 주기를 기다리지 않고 바로 보낼 배달 확인 (수동 재전송 등)
+func (w *Worker) Wake() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// subscribe 이벤트마다 배달을 만듦
+// 버퍼가 넘쳐 구독이 끊기면 마지막으로 받은 이벤트 이후부터 다시 구독해 놓친 이벤트를 재전송받음
+func (w *Worker) subscribe(ctx context.Context) {
+	var lastID uint64
+	for {
+		sub, replay := w.bus.SubscribeAll(lastID)
+		if lastID == 0 {
+			lastID = replay.LastID
+		} else if !replay.Complete {
+			slog.WarnContext(ctx, "웹훅 이벤트 일부 누락", "after", lastID, "last", replay.LastID)
+			lastID = replay.LastID
+		}
+		for _, event := range replay.Events {
+			w.enqueue(ctx, event)
+			lastID = event.ID
+		}
+
+		for open := true; open; {
+			select {
+			case <-ctx.Done():
+				sub.Close()
+				return
+			case event, ok := <-sub.Events():
+				if !ok {
+					open = false
+					break
+				}
+				w.enqueue(ctx, event)
+				lastID = event.ID
+			}
+		}
+	}
+}
+
+func (w *Worker) enqueue(ctx context.Context, event events.Event) {
+	deliveries, err := Enqueue(w.db.WithContext(ctx), event, w.now())
+	if err != nil {
+		slog.ErrorContext(ctx, "웹훅 배달 생성 실패", "event", event.Type, "error", err)
+		return
+	}
+	if len(deliveries) > 0 {
+		w.Wake()
+	}
+}
+
+// deliver 새 배달이 생기거나 Wake가 불리면 보낼 시각이 된 배달을 보냄
+func (w *Worker) deliver(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.wake:
+		}
+		if _, err := w.RetryDue(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "웹훅 발송 실패", "error", err)
+		}
+	}
+}
+
+// RetryDue 보낼 시각이 된 배달(재시도 포함)을 모두 보내고 보낸 수를 반환 (예약 작업 webhook-retry의 본문)
+func (w *Worker) RetryDue(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		sent, err := ProcessDue(ctx, w.db.WithContext(ctx), w.client, w.now, w.batchSize)
+		total += sent
+		// 한 번에 다 못 보냈으면 이어서 보냄
+		if err != nil || sent < w.batchSize {
+			return total, err
+		}
+	}
+}
@@ -9,6 +9,7 @@ import MyRestaurantsTab from './components/MyRestaurantsTab';
 import VisitsTab from './components/VisitsTab';
 import PopupModal from './components/PopupModal';
 import HealthIndicator from './components/HealthIndicator';
+import { useLiveUpdates } from './hooks/useLiveUpdates';
 
 function classNames(...classes: string[]) {
   return classes.filter(Boolean).join(' ');
@@ -27,6 +28,9 @@ const App: React.FC = () => {
   const [modalOpen, setModalOpen] = useState(false);
   const [modalMessage, setModalMessage] = useState('');
 
+  // 다른 사람이 등록·삭제한 맛집과 방문 기록을 실시간 반영
+  useLiveUpdates();
+
   // 맛집 등록 핸들러
   const handleAddRestaurant = async (place: any) => {
     try {
@@ -38,7 +42,8 @@ const App: React.FC = () => {
         Latitude: parseFloat(place.y) || 0,
         Longitude: parseFloat(place.x) || 0,
         Category: place.category_group_name || place.category_name || '음식점',
-        Phone: place.phone || '전화번호 없음'
+        Phone: place.phone || '전화번호 없음',
+        ...(place.id ? { ExternalSource: 'kakao', ExternalID: String(place.id) } : {})
       };
 
       console.log('Restaurant data to send:', restaurantData); // 디버깅용 로그
@@ -42,7 +42,11 @@ export const addRestaurant = async (place: any) => {
     Latitude: place.Latitude || parseFloat(place.y) || 0,
     Longitude: place.Longitude || parseFloat(place.x) || 0,
     Category: place.Category || place.category_group_name || place.category_name || '음식점',
-    Phone: place.Phone || place.phone || '전화번호 없음'
+    Phone: place.Phone || place.phone || '전화번호 없음',
+    // 카카오 검색 결과의 장소 ID를 함께 보내 같은 장소 중복 등록을 막음
+    ...(place.ExternalID || place.id
+      ? { ExternalSource: place.ExternalSource || 'kakao', ExternalID: String(place.ExternalID || place.id) }
+      : {})
   };
   
   console.log("Sending restaurant data:", body);
@@ -67,6 +71,66 @@ export const addRestaurant = async (place: any) => {
   }
 };
 
+// 이미 등록된 외부 장소 ID 조회 (지도 검색 결과의 "저장됨" 표시용)
+export const fetchSavedPlaceIds = async (source: string, ids: string[]): Promise<Set<string>> => {
+  if (ids.length === 0) {
+    return new Set();
+  }
+  try {
+    const url = `${API_BASE_URL}/api/restaurants/external/${source}`;
+    const res = await axios.get(url, { params: { ids: ids.join(',') } });
+    return new Set((res.data?.items || []).map((item: any) => item.externalId));
+  } catch (error) {
+    console.error('저장된 장소 조회 에러:', error);
+    return new Set();
+  }
+};
+
+// 길찾기 API - 서버가 OSRM 경로를 캐시하고, 실패하면 직선 거리로 추정한 경로를 반환
+export const fetchDirections = async (
+  mode: 'walking' | 'driving',
+  from: { lat: number; lng: number },
+  to: { lat: number; lng: number }
+) => {
+  const url = `${API_BASE_URL}/api/directions`;
+  const res = await axios.get(url, {
+    params: { mode, fromLat: from.lat, fromLng: from.lng, toLat: to.lat, toLng: to.lng },
+  });
+  return res.data as {
+    mode: string;
+    distanceMeters: number;
+    durationSeconds: number;
+    durationMinutes: number;
+    source: 'osrm' | 'estimate';
+    geometry: { latitude: number; longitude: number }[];
+  };
+};
+
+// 점심 룰렛 API - 서버가 추첨하고 시드·후보·결과를 기록 (팀별 하루 다시 뽑기 횟수 제한)
+// restaurantIds가 비어 있으면 등록된 모든 맛집 중에서 추첨
+export const drawRestaurant = async (restaurantIds: number[] = [], team?: string) => {
+  try {
+    const url = `${API_BASE_URL}/api/draws`;
+    const res = await axios.post(url, {
+      team,
+      candidates: restaurantIds.map(restaurantId => ({ restaurantId })),
+    });
+    return res.data as {
+      id: number;
+      sequence: number;
+      seed: string;
+      remainingRerolls: number;
+      result: { restaurantId: number; name: string };
+    };
+  } catch (error) {
+    console.error("추첨 에러:", error);
+    if (axios.isAxiosError(error)) {
+      throw new Error(error.response?.data?.error || "추첨에 실패했습니다.");
+    }
+    throw new Error("추첨에 실패했습니다.");
+  }
+};
+
 // 맛집 삭제 API 함수 수정
 export const deleteRestaurant = async (id: number) => {
   try {
@@ -2,6 +2,7 @@ import React, { useEffect, useRef, useState } from "react";
 import { loadKakaoMapScript } from "../utils/kakaoMapLoader";
 import PopupModal from "./PopupModal";
 import MapErrorFallback from "./MapErrorFallback";
+import { fetchSavedPlaceIds } from "../api";
 
 declare global {
   interface Window {
@@ -21,6 +22,7 @@ const KakaoMap: React.FC<{ onAddRestaurant?: (place: any) => void }> = ({ onAddR
   const [sdkLoaded, setSdkLoaded] = useState(false);
   const [myLocation, setMyLocation] = useState<{ lat: number; lng: number } | null>(null);
   const [searchResults, setSearchResults] = useState<any[]>([]);
+  const [savedPlaceIds, setSavedPlaceIds] = useState<Set<string>>(new Set()); // 이미 등록된 카카오 장소 ID
   const [zoomLevel, setZoomLevel] = useState(4); // 기본 줌 레벨
   const [isSearching, setIsSearching] = useState(false); // 검색 중 상태
   const [isModalOpen, setIsModalOpen] = useState(false); // 모달 오픈 상태
@@ -168,6 +170,7 @@ const KakaoMap: React.FC<{ onAddRestaurant?: (place: any) => void }> = ({ onAddR
             // 마커 상태 업데이트
             setMarkers(newMarkers);
             setSearchResults(data);
+            fetchSavedPlaceIds("kakao", data.map((place: any) => String(place.id))).then(setSavedPlaceIds);
             
             // 검색 결과가 있으면 지도 중심 이동 및 줌 레벨 조정
             if (data.length > 0) {
@@ -364,7 +367,9 @@ const KakaoMap: React.FC<{ onAddRestaurant?: (place: any) => void }> = ({ onAddR
                   </a>
                 </div>
               </div>
-              {onAddRestaurant && (
+              {savedPlaceIds.has(String(place.id)) ? (
+                <span className="ml-4 px-3 py-1 bg-gray-200 text-gray-600 rounded text-sm">저장됨</span>
+              ) : onAddRestaurant && (
                 <button
                   className="ml-4 px-3 py-1 bg-green-500 text-white rounded hover:bg-green-600"
                   onClick={(e) => {
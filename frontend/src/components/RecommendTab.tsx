@@ -1,6 +1,6 @@
 import React, { useEffect, useRef, useState, useCallback } from "react";
 import { useQuery } from "@tanstack/react-query";
-import { fetchRestaurants } from "../api";
+import { fetchRestaurants, fetchDirections, drawRestaurant } from "../api";
 import { loadKakaoMapScript } from "../utils/kakaoMapLoader";
 
 // eslint-disable-next-line @typescript-eslint/no-explicit-any
@@ -76,7 +76,7 @@ const RecommendTab: React.FC = () => {
   const [directionsRenderer, setDirectionsRenderer] = useState<any>(null);  // 길찾기 렌더러 상태 추가
   
   // [주석] 맛집 데이터 가져오기 (React Query)
-  const { data: restaurants, isLoading } = useQuery({
+  const { data: restaurants, isLoading, refetch: refetchRestaurants } = useQuery({
     queryKey: ["restaurants"],
     queryFn: fetchRestaurants,
   });
@@ -169,8 +169,8 @@ const RecommendTab: React.FC = () => {
     }
   }, [directionsRenderer]);
 
-  // OSRM API를 사용한 길찾기 함수 (무료)
-  const getRouteFromOSRM = async (
+  // 서버 길찾기 API 호출 (서버가 OSRM 결과를 캐시하고 실패 시 직선 거리로 추정)
+  const getRoute = async (
     startLat: number, 
     startLng: number, 
     endLat: number, 
@@ -178,19 +178,10 @@ const RecommendTab: React.FC = () => {
     travelMode: 'WALKING' | 'DRIVING'
   ) => {
     try {
-      const profile = travelMode === 'WALKING' ? 'foot' : 'driving';
-      const apiUrl = `https://router.project-osrm.org/route/v1/${profile}/${startLng},${startLat};${endLng},${endLat}?overview=full&geometries=geojson`;
-      
-      const response = await fetch(apiUrl);
-      
-      if (!response.ok) {
-        throw new Error(`HTTP error! status: ${response.status}`);
-      }
-      
-      const data = await response.json();
-      return data;
+      const mode = travelMode === 'WALKING' ? 'walking' : 'driving';
+      return await fetchDirections(mode, { lat: startLat, lng: startLng }, { lat: endLat, lng: endLng });
     } catch (error) {
-      console.error('OSRM API 호출 실패:', error);
+      console.error('길찾기 API 호출 실패:', error);
       return null;
     }
   };
@@ -232,8 +223,8 @@ const RecommendTab: React.FC = () => {
     const startLatLng = new window.kakao.maps.LatLng(currentLocation.lat, currentLocation.lng);
     const endLatLng = new window.kakao.maps.LatLng(recommendedRestaurant.Latitude, recommendedRestaurant.Longitude);
 
-    // OSRM API 호출하여 실제 도로 경로 가져오기
-    const routeData = await getRouteFromOSRM(
+    // 서버 길찾기 API 호출하여 도로 경로 가져오기
+    const routeData = await getRoute(
       currentLocation.lat, 
       currentLocation.lng, 
       recommendedRestaurant.Latitude, 
@@ -244,24 +235,19 @@ const RecommendTab: React.FC = () => {
     let routePath: any[] = [];
     let routeInfo = { distance: 0, duration: 0 };
     
-    if (routeData && routeData.routes && routeData.routes.length > 0) {
-      // OSRM API에서 받은 실제 경로 좌표들을 사용
-      const route = routeData.routes[0];
-      const coordinates = route.geometry.coordinates;
-      
-      // OSRM은 [lng, lat] 형태로 반환하므로 변환
-      routePath = coordinates.map((coord: [number, number]) => 
-        new window.kakao.maps.LatLng(coord[1], coord[0])
+    if (routeData && routeData.geometry.length > 0) {
+      routePath = routeData.geometry.map((point) =>
+        new window.kakao.maps.LatLng(point.latitude, point.longitude)
       );
       
       // 거리와 시간 정보 저장
-      routeInfo.distance = route.distance; // 미터
-      routeInfo.duration = route.duration; // 초
+      routeInfo.distance = routeData.distanceMeters; // 미터
+      routeInfo.duration = routeData.durationSeconds; // 초
     }
     
     // API 호출 실패시 직선 경로로 대체
     if (routePath.length === 0) {
-      console.warn('OSRM API 실패, 직선 경로로 대체');
+      console.warn('길찾기 API 실패, 직선 경로로 대체');
       routePath = [startLatLng, endLatLng];
     }
 
@@ -517,7 +503,7 @@ const RecommendTab: React.FC = () => {
   }, [directionsRenderer]);
 
   // 내 맛집 중에서 추천
-  const recommendFromMyList = () => {
+  const recommendFromMyList = async () => {
     if (!restaurants || restaurants.length === 0) {
       alert("저장된 맛집이 없습니다.");
       return;
@@ -544,8 +530,24 @@ const RecommendTab: React.FC = () => {
       directionsRenderer.setMap(null);
     }
 
-    const randomIndex = Math.floor(Math.random() * restaurants.length);
-    const selected = restaurants[randomIndex];
+    // 서버가 등록된 모든 맛집 중에서 추첨해 기록을 남김 (추첨 ID로 결과 검증 가능)
+    let drawn: { restaurantId: number; name: string };
+    try {
+      drawn = (await drawRestaurant()).result;
+    } catch (error) {
+      alert(error instanceof Error ? error.message : "추첨에 실패했습니다.");
+      return;
+    }
+    let selected: Restaurant | undefined = restaurants.find((r: Restaurant) => r.ID === drawn.restaurantId);
+    // 다른 사람이 방금 등록한 맛집이 뽑히면 목록을 다시 불러와서 찾음
+    if (!selected) {
+      const { data: latest } = await refetchRestaurants();
+      selected = latest?.find((r: Restaurant) => r.ID === drawn.restaurantId);
+    }
+    if (!selected) {
+      alert(`오늘의 추천은 "${drawn.name}"입니다. (맛집 목록에서 찾을 수 없어 지도에 표시하지 못했습니다)`);
+      return;
+    }
     
     // 거리와 소요시간 계산
     const distance = getDistanceFromLatLonInMeters(
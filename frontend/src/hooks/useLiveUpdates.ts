@@ -0,0 +1,44 @@
+import { useEffect } from 'react';
+import { useQueryClient } from '@tanstack/react-query';
+
+const API_BASE_URL = process.env.REACT_APP_API_BASE_URL || '';
+
+// 이벤트 종류별로 다시 불러올 쿼리
+const invalidations: Record<string, string[][]> = {
+  'restaurant.created': [['restaurants']],
+  'restaurant.deleted': [['restaurants']],
+  'restaurant.restored': [['restaurants'], ['visits']],
+  'restaurant.merged': [['restaurants'], ['visits']],
+  'visit.created': [['visits']],
+  'visit.updated': [['visits']],
+  'visit.deleted': [['visits']],
+  'poll.closed': [['visits']],
+  // 놓친 이벤트를 받을 수 없으면 전체 새로고침
+  reset: [['restaurants'], ['visits']],
+};
+
+/**
+ * 서버의 실시간 변경 이벤트(SSE)를 구독해 다른 사람이 바꾼 맛집·방문 기록을 바로 반영
+ * 연결이 끊기면 EventSource가 Last-Event-ID로 재연결하여 놓친 이벤트를 받음
+ */
+export const useLiveUpdates = (team: string = 'default') => {
+  const queryClient = useQueryClient();
+
+  useEffect(() => {
+    if (typeof EventSource === 'undefined') {
+      return;
+    }
+
+    const source = new EventSource(`${API_BASE_URL}/api/events?team=${encodeURIComponent(team)}`);
+    const listeners = Object.entries(invalidations).map(([type, queryKeys]) => {
+      const listener = () => queryKeys.forEach((queryKey) => queryClient.invalidateQueries(queryKey));
+      source.addEventListener(type, listener);
+      return [type, listener] as const;
+    });
+
+    return () => {
+      listeners.forEach(([type, listener]) => source.removeEventListener(type, listener));
+      source.close();
+    };
+  }, [queryClient, team]);
+};